@@ -2,11 +2,18 @@ package azurerm
 
 import (
 	"context"
+	"crypto/rsa"
+	"crypto/x509"
 	"fmt"
+	"io"
+	"io/ioutil"
 	"log"
+	"math"
+	"math/rand"
 	"net/http"
-	"net/http/httputil"
 	"os"
+	"strconv"
+	"strings"
 	"sync"
 	"time"
 
@@ -63,7 +70,9 @@ import (
 	uuid "github.com/hashicorp/go-uuid"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/authentication"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/logging"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+	"golang.org/x/crypto/pkcs12"
 )
 
 // ArmClient contains the handles to all the specific Azure Resource Manager
@@ -75,6 +84,28 @@ type ArmClient struct {
 	usingServicePrincipal    bool
 	environment              azure.Environment
 	skipProviderRegistration bool
+	lazyProviderRegistration bool
+	maxRetries               int
+	retryMaxWait             time.Duration
+
+	// resourceProviderRegistrar is used to register Resource Providers on-demand, when enabled
+	resourceProviderRegistrar *resourceProviderRegistrar
+
+	// tagsConfig contains the Default Tags and the Tags to ignore, which apply to every resource
+	tagsConfig tagsConfig
+
+	// the endpoints, authorizers and sender used to build the clients - which are retained
+	// so that clients for other Subscriptions can be built on-demand (see `forSubscription`)
+	endpoint      string
+	graphEndpoint string
+	auth          autorest.Authorizer
+	auxiliaryAuth autorest.Authorizer
+	graphAuth     autorest.Authorizer
+	keyVaultAuth  autorest.Authorizer
+	sender        autorest.Sender
+
+	subscriptionClientsLock sync.Mutex
+	subscriptionClients     map[string]*ArmClient
 
 	StopContext context.Context
 
@@ -126,6 +157,8 @@ type ArmClient struct {
 	// Compute
 	availSetClient         compute.AvailabilitySetsClient
 	diskClient             compute.DisksClient
+	galleriesClient        compute.GalleriesClient
+	galleryImagesClient    compute.GalleryImagesClient
 	imageClient            compute.ImagesClient
 	snapshotsClient        compute.SnapshotsClient
 	usageOpsClient         compute.UsageClient
@@ -135,6 +168,14 @@ type ArmClient struct {
 	vmImageClient          compute.VirtualMachineImagesClient
 	vmClient               compute.VirtualMachinesClient
 
+	galleryImageVersionsClient      compute.GalleryImageVersionsClient
+	resourceSkusClient              compute.ResourceSkusClient
+	vmRunCommandsClient             compute.VirtualMachineRunCommandsClient
+	vmScaleSetExtensionsClient      compute.VirtualMachineScaleSetExtensionsClient
+	vmScaleSetRollingUpgradesClient compute.VirtualMachineScaleSetRollingUpgradesClient
+	vmScaleSetVMsClient             compute.VirtualMachineScaleSetVMsClient
+	vmSizesClient                   compute.VirtualMachineSizesClient
+
 	// Devices
 	iothubResourceClient devices.IotHubResourceClient
 
@@ -192,6 +233,7 @@ type ArmClient struct {
 	applicationGatewayClient        network.ApplicationGatewaysClient
 	applicationSecurityGroupsClient network.ApplicationSecurityGroupsClient
 	azureFirewallsClient            network.AzureFirewallsClient
+	ddosProtectionPlanClient        network.DdosProtectionPlansClient
 	expressRouteAuthsClient         network.ExpressRouteCircuitAuthorizationsClient
 	expressRouteCircuitClient       network.ExpressRouteCircuitsClient
 	expressRoutePeeringsClient      network.ExpressRouteCircuitPeeringsClient
@@ -210,6 +252,10 @@ type ArmClient struct {
 	vnetGatewayClient               network.VirtualNetworkGatewaysClient
 	vnetClient                      network.VirtualNetworksClient
 	vnetPeeringsClient              network.VirtualNetworkPeeringsClient
+	virtualHubClient                network.VirtualHubsClient
+	virtualWanClient                network.VirtualWANsClient
+	vpnGatewayClient                network.VpnGatewaysClient
+	vpnSiteClient                   network.VpnSitesClient
 	watcherClient                   network.WatchersClient
 
 	// Notification Hubs
@@ -289,39 +335,179 @@ func (c *ArmClient) configureClient(client *autorest.Client, auth autorest.Autho
 	setUserAgent(client)
 	client.Authorizer = auth
 	//client.RequestInspector = azure.WithClientID(clientRequestID())
-	client.Sender = autorest.CreateSender(withRequestLogging())
-	client.SkipResourceProviderRegistration = c.skipProviderRegistration
-	client.PollingDuration = 60 * time.Minute
+	client.Sender = autorest.CreateSender(withRequestLogging(), withRetries(c.maxRetries, c.retryMaxWait), c.withResourceProviderRegistration())
+
+	// when Resource Providers are registered on-demand this is handled by `withResourceProviderRegistration`
+	// (which honours the list of Resource Providers which can be registered), rather than by the SDK
+	client.SkipResourceProviderRegistration = c.skipProviderRegistration || c.lazyProviderRegistration
+
+	// the deadline on the Context passed into each request (derived from the resource's timeouts)
+	// takes precedence - as such this is an upper bound for long-running operations
+	client.PollingDuration = 180 * time.Minute
 }
 
 func withRequestLogging() autorest.SendDecorator {
 	return func(s autorest.Sender) autorest.Sender {
 		return autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+			// sensitive values (e.g. the Authorization header, passwords & keys) are redacted
+			// from these dumps unless `ARM_LOG_UNREDACTED` is set
+			requestUrl := logging.URL(r.URL)
+
 			// dump request to wire format
-			if dump, err := httputil.DumpRequestOut(r, true); err == nil {
+			if dump, err := logging.DumpRequest(r); err == nil {
 				log.Printf("[DEBUG] AzureRM Request: \n%s\n", dump)
 			} else {
 				// fallback to basic message
-				log.Printf("[DEBUG] AzureRM Request: %s to %s\n", r.Method, r.URL)
+				log.Printf("[DEBUG] AzureRM Request: %s to %s\n", r.Method, requestUrl)
 			}
-
 			resp, err := s.Do(r)
 			if resp != nil {
 				// dump response to wire format
-				if dump, err := httputil.DumpResponse(resp, true); err == nil {
-					log.Printf("[DEBUG] AzureRM Response for %s: \n%s\n", r.URL, dump)
+				if dump, err := logging.DumpResponse(resp); err == nil {
+					log.Printf("[DEBUG] AzureRM Response for %s: \n%s\n", requestUrl, dump)
 				} else {
 					// fallback to basic message
-					log.Printf("[DEBUG] AzureRM Response: %s for %s\n", resp.Status, r.URL)
+					log.Printf("[DEBUG] AzureRM Response: %s for %s\n", resp.Status, requestUrl)
 				}
 			} else {
-				log.Printf("[DEBUG] Request to %s completed with no response", r.URL)
+				log.Printf("[DEBUG] Request to %s completed with no response", requestUrl)
 			}
 			return resp, err
 		})
 	}
 }
 
+// withRetries returns a SendDecorator which retries requests which were throttled (HTTP 429) or which failed
+// due to a transient server-side error (HTTP 5xx) up to `maxRetries` times. The delay between attempts is taken
+// from the `Retry-After` header when present, otherwise an exponential backoff with jitter is used - in both
+// cases the delay is capped at `maxWait`.
+func withRetries(maxRetries int, maxWait time.Duration) autorest.SendDecorator {
+	return func(s autorest.Sender) autorest.Sender {
+		return autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+			rr := autorest.NewRetriableRequest(r)
+			for attempt := 0; ; attempt++ {
+				if err := rr.Prepare(); err != nil {
+					return nil, err
+				}
+
+				resp, err := s.Do(rr.Request())
+				logRateLimitRemaining(r, resp)
+
+				if attempt >= maxRetries || !requestIsRetryable(resp, err) {
+					return resp, err
+				}
+
+				delay := retryDelay(resp, attempt, maxWait)
+				if resp != nil {
+					log.Printf("[DEBUG] AzureRM Request to %s returned %s (attempt %d of %d) - retrying in %s", r.URL, resp.Status, attempt+1, maxRetries+1, delay)
+
+					// the response is being discarded, so drain the body to allow the connection to be reused
+					io.Copy(ioutil.Discard, resp.Body)
+					resp.Body.Close()
+				} else {
+					log.Printf("[DEBUG] AzureRM Request to %s failed (attempt %d of %d) - retrying in %s: %+v", r.URL, attempt+1, maxRetries+1, delay, err)
+				}
+
+				select {
+				case <-time.After(delay):
+				case <-r.Context().Done():
+					return nil, r.Context().Err()
+				}
+			}
+		})
+	}
+}
+
+func requestIsRetryable(resp *http.Response, err error) bool {
+	if resp != nil {
+		return utils.ResponseStatusCodeIsRetryable(resp.StatusCode)
+	}
+
+	return utils.ResponseErrorIsRetryable(err)
+}
+
+// retryDelay returns how long to wait before retrying a request - this is taken from the `Retry-After` header
+// when it's present, otherwise this is an exponential backoff with jitter - capped at `maxWait`
+func retryDelay(resp *http.Response, attempt int, maxWait time.Duration) time.Duration {
+	if resp != nil {
+		if retryAfter, ok := parseRetryAfter(resp.Header.Get("Retry-After")); ok {
+			if retryAfter > maxWait {
+				return maxWait
+			}
+
+			return retryAfter
+		}
+	}
+
+	backoff := time.Duration(math.Pow(2, float64(attempt))) * time.Second
+	if backoff <= 0 || backoff > maxWait {
+		backoff = maxWait
+	}
+
+	// use a random delay between half and the full backoff, so that parallel requests don't retry in lockstep
+	half := int64(backoff / 2)
+	if half <= 0 {
+		return backoff
+	}
+
+	return time.Duration(half + rand.Int63n(half+1))
+}
+
+// parseRetryAfter parses the value of a `Retry-After` header, which can either be a number of seconds
+// or a HTTP Date
+func parseRetryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+		delay := time.Until(date)
+		if delay < 0 {
+			delay = 0
+		}
+
+		return delay, true
+	}
+
+	return 0, false
+}
+
+// rateLimitLowWatermark is the number of remaining requests below which we warn about the impending throttling
+const rateLimitLowWatermark = 100
+
+// logRateLimitRemaining logs the remaining number of requests available from the `x-ms-ratelimit-remaining-*`
+// headers, warning when we're close to being throttled
+func logRateLimitRemaining(r *http.Request, resp *http.Response) {
+	if resp == nil {
+		return
+	}
+
+	for header, values := range resp.Header {
+		if !strings.HasPrefix(strings.ToLower(header), "x-ms-ratelimit-remaining-") || len(values) == 0 {
+			continue
+		}
+
+		remaining, err := strconv.Atoi(values[0])
+		if err != nil {
+			continue
+		}
+
+		if remaining < rateLimitLowWatermark {
+			log.Printf("[WARN] AzureRM Request to %s: only %d requests remaining before throttling (%s)", r.URL, remaining, header)
+		} else {
+			log.Printf("[TRACE] AzureRM Request to %s: %d requests remaining before throttling (%s)", r.URL, remaining, header)
+		}
+	}
+}
+
 func setUserAgent(client *autorest.Client) {
 	tfVersion := fmt.Sprintf("HashiCorp-Terraform-v%s", terraform.VersionString())
 
@@ -341,6 +527,16 @@ func setUserAgent(client *autorest.Client) {
 }
 
 func getAuthorizationToken(c *authentication.Config, oauthConfig *adal.OAuthConfig, endpoint string) (*autorest.BearerAuthorizer, error) {
+	spt, err := getServicePrincipalToken(c, oauthConfig, endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	auth := autorest.NewBearerAuthorizer(spt)
+	return auth, nil
+}
+
+func getServicePrincipalToken(c *authentication.Config, oauthConfig *adal.OAuthConfig, endpoint string) (*adal.ServicePrincipalToken, error) {
 	useServicePrincipal := c.ClientSecret != ""
 
 	if useServicePrincipal {
@@ -349,8 +545,26 @@ func getAuthorizationToken(c *authentication.Config, oauthConfig *adal.OAuthConf
 			return nil, err
 		}
 
-		auth := autorest.NewBearerAuthorizer(spt)
-		return auth, nil
+		return spt, nil
+	}
+
+	if c.ClientCertPath != "" {
+		certificateData, err := ioutil.ReadFile(c.ClientCertPath)
+		if err != nil {
+			return nil, fmt.Errorf("Error reading Client Certificate %q: %v", c.ClientCertPath, err)
+		}
+
+		certificate, rsaPrivateKey, err := decodePkcs12(certificateData, c.ClientCertPassword)
+		if err != nil {
+			return nil, fmt.Errorf("Error decoding Client Certificate %q: %v", c.ClientCertPath, err)
+		}
+
+		spt, err := adal.NewServicePrincipalTokenFromCertificate(*oauthConfig, c.ClientID, certificate, rsaPrivateKey, endpoint)
+		if err != nil {
+			return nil, err
+		}
+
+		return spt, nil
 	}
 
 	if c.UseMsi {
@@ -358,8 +572,7 @@ func getAuthorizationToken(c *authentication.Config, oauthConfig *adal.OAuthConf
 		if err != nil {
 			return nil, err
 		}
-		auth := autorest.NewBearerAuthorizer(spt)
-		return auth, nil
+		return spt, nil
 	}
 
 	if c.IsCloudShell {
@@ -381,22 +594,90 @@ func getAuthorizationToken(c *authentication.Config, oauthConfig *adal.OAuthConf
 		return nil, fmt.Errorf("Error refreshing Service Principal Token: %+v", err)
 	}
 
-	auth := autorest.NewBearerAuthorizer(spt)
-	return auth, nil
+	return spt, nil
+}
+
+// auxiliaryTenantAuthorizer sends a token for each of the Auxiliary Tenants in the `x-ms-authorization-auxiliary`
+// header, in addition to the token for the primary Tenant - which allows resources (such as Virtual Network Peerings)
+// to reference resources within the Auxiliary Tenants
+type auxiliaryTenantAuthorizer struct {
+	primary   autorest.Authorizer
+	auxiliary []*adal.ServicePrincipalToken
+}
+
+func getAuxiliaryTenantAuthorizer(c *authentication.Config, env *azure.Environment, tokenAudience string, primary autorest.Authorizer) (autorest.Authorizer, error) {
+	tokens := make([]*adal.ServicePrincipalToken, 0)
+	for _, tenantId := range c.AuxiliaryTenantIDs {
+		oauthConfig, err := adal.NewOAuthConfig(env.ActiveDirectoryEndpoint, tenantId)
+		if err != nil {
+			return nil, fmt.Errorf("Error building the OAuth Config for Auxiliary Tenant %q: %+v", tenantId, err)
+		}
+
+		if oauthConfig == nil {
+			return nil, fmt.Errorf("Unable to configure OAuthConfig for Auxiliary Tenant %s", tenantId)
+		}
+
+		spt, err := getServicePrincipalToken(c, oauthConfig, tokenAudience)
+		if err != nil {
+			return nil, fmt.Errorf("Error obtaining a token for Auxiliary Tenant %q: %+v", tenantId, err)
+		}
+
+		tokens = append(tokens, spt)
+	}
+
+	return auxiliaryTenantAuthorizer{
+		primary:   primary,
+		auxiliary: tokens,
+	}, nil
+}
+
+func (a auxiliaryTenantAuthorizer) WithAuthorization() autorest.PrepareDecorator {
+	return func(p autorest.Preparer) autorest.Preparer {
+		return autorest.PreparerFunc(func(r *http.Request) (*http.Request, error) {
+			r, err := a.primary.WithAuthorization()(p).Prepare(r)
+			if err != nil {
+				return r, err
+			}
+
+			values := make([]string, 0)
+			for _, token := range a.auxiliary {
+				if err := token.EnsureFreshWithContext(r.Context()); err != nil {
+					return r, fmt.Errorf("Error refreshing the token for an Auxiliary Tenant: %+v", err)
+				}
+
+				values = append(values, fmt.Sprintf("Bearer %s", token.OAuthToken()))
+			}
+
+			return autorest.Prepare(r, autorest.WithHeader("x-ms-authorization-auxiliary", strings.Join(values, ", ")))
+		})
+	}
+}
+
+// decodePkcs12 decodes a PKCS#12 client certificate, returning the certificate
+// and the RSA private key it contains
+func decodePkcs12(pkcs []byte, password string) (*x509.Certificate, *rsa.PrivateKey, error) {
+	privateKey, certificate, err := pkcs12.Decode(pkcs, password)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rsaPrivateKey, isRsaKey := privateKey.(*rsa.PrivateKey)
+	if !isRsaKey {
+		return nil, nil, fmt.Errorf("PKCS#12 certificate must contain an RSA private key")
+	}
+
+	return certificate, rsaPrivateKey, nil
 }
 
 // getArmClient is a helper method which returns a fully instantiated
 // *ArmClient based on the Config's current settings.
 func getArmClient(c *authentication.Config) (*ArmClient, error) {
-	// detect cloud from environment
-	env, envErr := azure.EnvironmentFromName(c.Environment)
-	if envErr != nil {
-		// try again with wrapped value to support readable values like german instead of AZUREGERMANCLOUD
-		wrapped := fmt.Sprintf("AZURE%sCLOUD", c.Environment)
-		var innerErr error
-		if env, innerErr = azure.EnvironmentFromName(wrapped); innerErr != nil {
-			return nil, envErr
-		}
+	sender := autorest.CreateSender(withRequestLogging(), withRetries(c.MaxRetries, c.RetryMaxWait))
+
+	// detect cloud from environment - or build it from the metadata endpoint / file when specified
+	env, err := c.LoadEnvironment(sender)
+	if err != nil {
+		return nil, err
 	}
 
 	// client declarations:
@@ -404,9 +685,12 @@ func getArmClient(c *authentication.Config) (*ArmClient, error) {
 		clientId:                 c.ClientID,
 		tenantId:                 c.TenantID,
 		subscriptionId:           c.SubscriptionID,
-		environment:              env,
-		usingServicePrincipal:    c.ClientSecret != "",
+		environment:              *env,
+		usingServicePrincipal:    c.ClientSecret != "" || c.ClientCertPath != "",
 		skipProviderRegistration: c.SkipProviderRegistration,
+		lazyProviderRegistration: c.LazyProviderRegistration,
+		maxRetries:               c.MaxRetries,
+		retryMaxWait:             c.RetryMaxWait,
 	}
 
 	oauthConfig, err := adal.NewOAuthConfig(env.ActiveDirectoryEndpoint, c.TenantID)
@@ -419,11 +703,15 @@ func getArmClient(c *authentication.Config) (*ArmClient, error) {
 		return nil, fmt.Errorf("Unable to configure OAuthConfig for tenant %s", c.TenantID)
 	}
 
-	sender := autorest.CreateSender(withRequestLogging())
-
 	// Resource Manager endpoints
 	endpoint := env.ResourceManagerEndpoint
-	auth, err := getAuthorizationToken(c, oauthConfig, endpoint)
+
+	// custom clouds (such as Azure Stack) can require a token for a different audience to the endpoint
+	tokenAudience := env.TokenAudience
+	if tokenAudience == "" {
+		tokenAudience = endpoint
+	}
+	auth, err := getAuthorizationToken(c, oauthConfig, tokenAudience)
 	if err != nil {
 		return nil, err
 	}
@@ -445,45 +733,118 @@ func getArmClient(c *authentication.Config) (*ArmClient, error) {
 		return keyVaultSpt, nil
 	})
 
-	client.registerAppInsightsClients(endpoint, c.SubscriptionID, auth, sender)
-	client.registerAutomationClients(endpoint, c.SubscriptionID, auth, sender)
-	client.registerAuthentication(endpoint, graphEndpoint, c.SubscriptionID, c.TenantID, auth, graphAuth, sender)
-	client.registerCDNClients(endpoint, c.SubscriptionID, auth, sender)
-	client.registerComputeClients(endpoint, c.SubscriptionID, auth, sender)
-	client.registerContainerInstanceClients(endpoint, c.SubscriptionID, auth, sender)
-	client.registerContainerRegistryClients(endpoint, c.SubscriptionID, auth, sender)
-	client.registerContainerServicesClients(endpoint, c.SubscriptionID, auth)
-	client.registerCosmosDBClients(endpoint, c.SubscriptionID, auth, sender)
-	client.registerDatabases(endpoint, c.SubscriptionID, auth, sender)
-	client.registerDataLakeStoreClients(endpoint, c.SubscriptionID, auth, sender)
-	client.registerDeviceClients(endpoint, c.SubscriptionID, auth, sender)
-	client.registerDevTestClients(endpoint, c.SubscriptionID, auth)
-	client.registerDNSClients(endpoint, c.SubscriptionID, auth, sender)
-	client.registerEventGridClients(endpoint, c.SubscriptionID, auth, sender)
-	client.registerEventHubClients(endpoint, c.SubscriptionID, auth, sender)
-	client.registerKeyVaultClients(endpoint, c.SubscriptionID, auth, keyVaultAuth, sender)
-	client.registerLogicClients(endpoint, c.SubscriptionID, auth, sender)
-	client.registerMonitorClients(endpoint, c.SubscriptionID, auth, sender)
-	client.registerNetworkingClients(endpoint, c.SubscriptionID, auth, sender)
-	client.registerNotificationHubsClient(endpoint, c.SubscriptionID, auth, sender)
-	client.registerOperationalInsightsClients(endpoint, c.SubscriptionID, auth, sender)
-	client.registerRecoveryServiceClients(endpoint, c.SubscriptionID, auth)
-	client.registerPolicyClients(endpoint, c.SubscriptionID, auth)
-	client.registerManagementGroupClients(endpoint, auth)
-	client.registerRedisClients(endpoint, c.SubscriptionID, auth, sender)
-	client.registerRelayClients(endpoint, c.SubscriptionID, auth, sender)
-	client.registerResourcesClients(endpoint, c.SubscriptionID, auth)
-	client.registerSearchClients(endpoint, c.SubscriptionID, auth)
-	client.registerServiceBusClients(endpoint, c.SubscriptionID, auth)
-	client.registerServiceFabricClients(endpoint, c.SubscriptionID, auth)
-	client.registerSchedulerClients(endpoint, c.SubscriptionID, auth)
-	client.registerStorageClients(endpoint, c.SubscriptionID, auth)
-	client.registerTrafficManagerClients(endpoint, c.SubscriptionID, auth)
-	client.registerWebClients(endpoint, c.SubscriptionID, auth)
+	client.endpoint = endpoint
+	client.graphEndpoint = graphEndpoint
+	client.auth = auth
+	client.auxiliaryAuth = auth
+	client.graphAuth = graphAuth
+	client.keyVaultAuth = keyVaultAuth
+	client.sender = sender
+
+	// Auxiliary Tenants
+	if len(c.AuxiliaryTenantIDs) > 0 {
+		auxiliaryAuth, err := getAuxiliaryTenantAuthorizer(c, env, tokenAudience, auth)
+		if err != nil {
+			return nil, err
+		}
+		client.auxiliaryAuth = auxiliaryAuth
+	}
+
+	client.registerClients(c.SubscriptionID)
 
 	return &client, nil
 }
 
+// forSubscription returns an ArmClient whose clients are bound to the specified Subscription, which is built
+// on-demand the first time it's required (and then cached) - when no Subscription is specified (or it's the
+// Subscription the Provider is configured for) this ArmClient is returned
+func (c *ArmClient) forSubscription(subscriptionId string) *ArmClient {
+	if subscriptionId == "" || strings.EqualFold(subscriptionId, c.subscriptionId) {
+		return c
+	}
+
+	c.subscriptionClientsLock.Lock()
+	defer c.subscriptionClientsLock.Unlock()
+
+	key := strings.ToLower(subscriptionId)
+	if client, ok := c.subscriptionClients[key]; ok {
+		return client
+	}
+
+	log.Printf("[DEBUG] Building the clients for Subscription %q", subscriptionId)
+	client := &ArmClient{
+		clientId:                 c.clientId,
+		tenantId:                 c.tenantId,
+		subscriptionId:           subscriptionId,
+		usingServicePrincipal:    c.usingServicePrincipal,
+		environment:              c.environment,
+		skipProviderRegistration: c.skipProviderRegistration,
+		lazyProviderRegistration: c.lazyProviderRegistration,
+		maxRetries:               c.maxRetries,
+		retryMaxWait:             c.retryMaxWait,
+		tagsConfig:               c.tagsConfig,
+		StopContext:              c.StopContext,
+
+		endpoint:      c.endpoint,
+		graphEndpoint: c.graphEndpoint,
+		auth:          c.auth,
+		auxiliaryAuth: c.auxiliaryAuth,
+		graphAuth:     c.graphAuth,
+		keyVaultAuth:  c.keyVaultAuth,
+		sender:        c.sender,
+	}
+	client.registerClients(subscriptionId)
+
+	if c.resourceProviderRegistrar != nil {
+		client.resourceProviderRegistrar = c.resourceProviderRegistrar.withClient(client.providersClient)
+	}
+
+	if c.subscriptionClients == nil {
+		c.subscriptionClients = make(map[string]*ArmClient)
+	}
+	c.subscriptionClients[key] = client
+	return client
+}
+
+// registerClients (re-)creates each of the SDK clients, bound to the specified Subscription
+func (c *ArmClient) registerClients(subscriptionId string) {
+	c.registerAppInsightsClients(c.endpoint, subscriptionId, c.auth, c.sender)
+	c.registerAutomationClients(c.endpoint, subscriptionId, c.auth, c.sender)
+	c.registerAuthentication(c.endpoint, c.graphEndpoint, subscriptionId, c.tenantId, c.auth, c.graphAuth, c.sender)
+	c.registerCDNClients(c.endpoint, subscriptionId, c.auth, c.sender)
+	c.registerComputeClients(c.endpoint, subscriptionId, c.auth, c.sender)
+	c.registerContainerInstanceClients(c.endpoint, subscriptionId, c.auth, c.sender)
+	c.registerContainerRegistryClients(c.endpoint, subscriptionId, c.auth, c.sender)
+	c.registerContainerServicesClients(c.endpoint, subscriptionId, c.auth)
+	c.registerCosmosDBClients(c.endpoint, subscriptionId, c.auth, c.sender)
+	c.registerDatabases(c.endpoint, subscriptionId, c.auth, c.sender)
+	c.registerDataLakeStoreClients(c.endpoint, subscriptionId, c.auth, c.sender)
+	c.registerDeviceClients(c.endpoint, subscriptionId, c.auth, c.sender)
+	c.registerDevTestClients(c.endpoint, subscriptionId, c.auth)
+	c.registerDNSClients(c.endpoint, subscriptionId, c.auth, c.sender)
+	c.registerEventGridClients(c.endpoint, subscriptionId, c.auth, c.sender)
+	c.registerEventHubClients(c.endpoint, subscriptionId, c.auth, c.sender)
+	c.registerKeyVaultClients(c.endpoint, subscriptionId, c.auth, c.keyVaultAuth, c.sender)
+	c.registerLogicClients(c.endpoint, subscriptionId, c.auth, c.sender)
+	c.registerMonitorClients(c.endpoint, subscriptionId, c.auth, c.sender)
+	c.registerNetworkingClients(c.endpoint, subscriptionId, c.auth, c.auxiliaryAuth, c.sender)
+	c.registerNotificationHubsClient(c.endpoint, subscriptionId, c.auth, c.sender)
+	c.registerOperationalInsightsClients(c.endpoint, subscriptionId, c.auth, c.sender)
+	c.registerRecoveryServiceClients(c.endpoint, subscriptionId, c.auth)
+	c.registerPolicyClients(c.endpoint, subscriptionId, c.auth)
+	c.registerManagementGroupClients(c.endpoint, c.auth)
+	c.registerRedisClients(c.endpoint, subscriptionId, c.auth, c.sender)
+	c.registerRelayClients(c.endpoint, subscriptionId, c.auth, c.sender)
+	c.registerResourcesClients(c.endpoint, subscriptionId, c.auth)
+	c.registerSearchClients(c.endpoint, subscriptionId, c.auth)
+	c.registerServiceBusClients(c.endpoint, subscriptionId, c.auth)
+	c.registerServiceFabricClients(c.endpoint, subscriptionId, c.auth)
+	c.registerSchedulerClients(c.endpoint, subscriptionId, c.auth)
+	c.registerStorageClients(c.endpoint, subscriptionId, c.auth)
+	c.registerTrafficManagerClients(c.endpoint, subscriptionId, c.auth)
+	c.registerWebClients(c.endpoint, subscriptionId, c.auth)
+}
+
 func (c *ArmClient) registerAppInsightsClients(endpoint, subscriptionId string, auth autorest.Authorizer, sender autorest.Sender) {
 	ai := appinsights.NewComponentsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&ai.Client, auth)
@@ -563,6 +924,10 @@ func (c *ArmClient) registerComputeClients(endpoint, subscriptionId string, auth
 	c.configureClient(&imagesClient.Client, auth)
 	c.imageClient = imagesClient
 
+	resourceSkusClient := compute.NewResourceSkusClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&resourceSkusClient.Client, auth)
+	c.resourceSkusClient = resourceSkusClient
+
 	snapshotsClient := compute.NewSnapshotsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&snapshotsClient.Client, auth)
 	c.snapshotsClient = snapshotsClient
@@ -571,6 +936,18 @@ func (c *ArmClient) registerComputeClients(endpoint, subscriptionId string, auth
 	c.configureClient(&usageClient.Client, auth)
 	c.usageOpsClient = usageClient
 
+	galleriesClient := compute.NewGalleriesClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&galleriesClient.Client, auth)
+	c.galleriesClient = galleriesClient
+
+	galleryImagesClient := compute.NewGalleryImagesClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&galleryImagesClient.Client, auth)
+	c.galleryImagesClient = galleryImagesClient
+
+	galleryImageVersionsClient := compute.NewGalleryImageVersionsClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&galleryImageVersionsClient.Client, auth)
+	c.galleryImageVersionsClient = galleryImageVersionsClient
+
 	extensionImagesClient := compute.NewVirtualMachineExtensionImagesClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&extensionImagesClient.Client, auth)
 	c.vmExtensionImageClient = extensionImagesClient
@@ -587,9 +964,29 @@ func (c *ArmClient) registerComputeClients(endpoint, subscriptionId string, auth
 	c.configureClient(&scaleSetsClient.Client, auth)
 	c.vmScaleSetClient = scaleSetsClient
 
+	scaleSetExtensionsClient := compute.NewVirtualMachineScaleSetExtensionsClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&scaleSetExtensionsClient.Client, auth)
+	c.vmScaleSetExtensionsClient = scaleSetExtensionsClient
+
+	scaleSetRollingUpgradesClient := compute.NewVirtualMachineScaleSetRollingUpgradesClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&scaleSetRollingUpgradesClient.Client, auth)
+	c.vmScaleSetRollingUpgradesClient = scaleSetRollingUpgradesClient
+
+	scaleSetVMsClient := compute.NewVirtualMachineScaleSetVMsClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&scaleSetVMsClient.Client, auth)
+	c.vmScaleSetVMsClient = scaleSetVMsClient
+
 	virtualMachinesClient := compute.NewVirtualMachinesClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&virtualMachinesClient.Client, auth)
 	c.vmClient = virtualMachinesClient
+
+	virtualMachineRunCommandsClient := compute.NewVirtualMachineRunCommandsClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&virtualMachineRunCommandsClient.Client, auth)
+	c.vmRunCommandsClient = virtualMachineRunCommandsClient
+
+	virtualMachineSizesClient := compute.NewVirtualMachineSizesClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&virtualMachineSizesClient.Client, auth)
+	c.vmSizesClient = virtualMachineSizesClient
 }
 
 func (c *ArmClient) registerContainerInstanceClients(endpoint, subscriptionId string, auth autorest.Authorizer, sender autorest.Sender) {
@@ -665,10 +1062,7 @@ func (c *ArmClient) registerDatabases(endpoint, subscriptionId string, auth auto
 	c.sqlDatabasesClient = sqlDBClient
 
 	sqlDTDPClient := sql.NewDatabaseThreatDetectionPoliciesClientWithBaseURI(endpoint, subscriptionId)
-	setUserAgent(&sqlDTDPClient.Client)
-	sqlDTDPClient.Authorizer = auth
-	sqlDTDPClient.Sender = sender
-	sqlDTDPClient.SkipResourceProviderRegistration = c.skipProviderRegistration
+	c.configureClient(&sqlDTDPClient.Client, auth)
 	c.sqlDatabaseThreatDetectionPoliciesClient = sqlDTDPClient
 
 	sqlFWClient := sql.NewFirewallRulesClientWithBaseURI(endpoint, subscriptionId)
@@ -790,7 +1184,7 @@ func (c *ArmClient) registerMonitorClients(endpoint, subscriptionId string, auth
 	c.autoscaleSettingsClient = autoscaleSettingsClient
 }
 
-func (c *ArmClient) registerNetworkingClients(endpoint, subscriptionId string, auth autorest.Authorizer, sender autorest.Sender) {
+func (c *ArmClient) registerNetworkingClients(endpoint, subscriptionId string, auth autorest.Authorizer, auxiliaryAuth autorest.Authorizer, sender autorest.Sender) {
 	applicationGatewaysClient := network.NewApplicationGatewaysClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&applicationGatewaysClient.Client, auth)
 	c.applicationGatewayClient = applicationGatewaysClient
@@ -803,6 +1197,10 @@ func (c *ArmClient) registerNetworkingClients(endpoint, subscriptionId string, a
 	c.configureClient(&azureFirewallsClient.Client, auth)
 	c.azureFirewallsClient = azureFirewallsClient
 
+	ddosProtectionPlansClient := network.NewDdosProtectionPlansClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&ddosProtectionPlansClient.Client, auth)
+	c.ddosProtectionPlanClient = ddosProtectionPlansClient
+
 	expressRouteAuthsClient := network.NewExpressRouteCircuitAuthorizationsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&expressRouteAuthsClient.Client, auth)
 	c.expressRouteAuthsClient = expressRouteAuthsClient
@@ -832,7 +1230,7 @@ func (c *ArmClient) registerNetworkingClients(endpoint, subscriptionId string, a
 	c.vnetGatewayClient = gatewaysClient
 
 	gatewayConnectionsClient := network.NewVirtualNetworkGatewayConnectionsClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&gatewayConnectionsClient.Client, auth)
+	c.configureClient(&gatewayConnectionsClient.Client, auxiliaryAuth)
 	c.vnetGatewayConnectionsClient = gatewayConnectionsClient
 
 	networksClient := network.NewVirtualNetworksClientWithBaseURI(endpoint, subscriptionId)
@@ -843,8 +1241,9 @@ func (c *ArmClient) registerNetworkingClients(endpoint, subscriptionId string, a
 	c.configureClient(&packetCapturesClient.Client, auth)
 	c.packetCapturesClient = packetCapturesClient
 
+	// Peerings & Gateway Connections can reference resources in the Auxiliary Tenants
 	peeringsClient := network.NewVirtualNetworkPeeringsClientWithBaseURI(endpoint, subscriptionId)
-	c.configureClient(&peeringsClient.Client, auth)
+	c.configureClient(&peeringsClient.Client, auxiliaryAuth)
 	c.vnetPeeringsClient = peeringsClient
 
 	publicIPAddressesClient := network.NewPublicIPAddressesClientWithBaseURI(endpoint, subscriptionId)
@@ -875,12 +1274,28 @@ func (c *ArmClient) registerNetworkingClients(endpoint, subscriptionId string, a
 	c.configureClient(&userAssignedIdentitiesClient.Client, auth)
 	c.userAssignedIdentitiesClient = userAssignedIdentitiesClient
 
+	virtualHubsClient := network.NewVirtualHubsClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&virtualHubsClient.Client, auth)
+	c.virtualHubClient = virtualHubsClient
+
+	virtualWansClient := network.NewVirtualWANsClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&virtualWansClient.Client, auth)
+	c.virtualWanClient = virtualWansClient
+
+	vpnGatewaysClient := network.NewVpnGatewaysClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&vpnGatewaysClient.Client, auth)
+	c.vpnGatewayClient = vpnGatewaysClient
+
+	vpnSitesClient := network.NewVpnSitesClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&vpnSitesClient.Client, auth)
+	c.vpnSiteClient = vpnSitesClient
+
 	watchersClient := network.NewWatchersClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&watchersClient.Client, auth)
 	c.watcherClient = watchersClient
 }
 
-func (c *ArmClient) registerNotificationHubsClient(endpoint, subscriptionId string, auth *autorest.BearerAuthorizer, sender autorest.Sender) {
+func (c *ArmClient) registerNotificationHubsClient(endpoint, subscriptionId string, auth autorest.Authorizer, sender autorest.Sender) {
 	namespacesClient := notificationhubs.NewNamespacesClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&namespacesClient.Client, auth)
 	c.notificationNamespacesClient = namespacesClient
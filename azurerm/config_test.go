@@ -1,6 +1,18 @@
 package azurerm
 
-import "testing"
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/adal"
+)
 
 func TestClientRequestID(t *testing.T) {
 	first := clientRequestID()
@@ -14,3 +26,284 @@ func TestClientRequestID(t *testing.T) {
 		t.Fatal("subsequent request ID not the same as the first")
 	}
 }
+
+func TestDecodePkcs12(t *testing.T) {
+	data, err := ioutil.ReadFile("testdata/application_gateway_test.pfx")
+	if err != nil {
+		t.Fatalf("Error reading the test certificate: %+v", err)
+	}
+
+	cases := []struct {
+		Description string
+		Password    string
+		ExpectError bool
+	}{
+		{
+			Description: "Incorrect Password",
+			Password:    "incorrect",
+			ExpectError: true,
+		},
+		{
+			Description: "Correct Password",
+			Password:    "terraform",
+			ExpectError: false,
+		},
+	}
+
+	for _, v := range cases {
+		certificate, privateKey, err := decodePkcs12(data, v.Password)
+
+		if v.ExpectError {
+			if err == nil {
+				t.Fatalf("Expected an error for %q: didn't get one", v.Description)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Fatalf("Expected there to be no error for %q - but got: %v", v.Description, err)
+		}
+
+		if certificate == nil || privateKey == nil {
+			t.Fatalf("Expected a Certificate and Private Key for %q but didn't get them", v.Description)
+		}
+	}
+}
+
+func TestWithRetries(t *testing.T) {
+	cases := []struct {
+		Description      string
+		StatusCodes      []int
+		MaxRetries       int
+		ExpectedAttempts int
+		ExpectedStatus   int
+	}{
+		{
+			Description:      "Success",
+			StatusCodes:      []int{http.StatusOK},
+			MaxRetries:       3,
+			ExpectedAttempts: 1,
+			ExpectedStatus:   http.StatusOK,
+		},
+		{
+			Description:      "Client Error isn't retried",
+			StatusCodes:      []int{http.StatusBadRequest},
+			MaxRetries:       3,
+			ExpectedAttempts: 1,
+			ExpectedStatus:   http.StatusBadRequest,
+		},
+		{
+			Description:      "Throttled then Success",
+			StatusCodes:      []int{http.StatusTooManyRequests, http.StatusTooManyRequests, http.StatusOK},
+			MaxRetries:       3,
+			ExpectedAttempts: 3,
+			ExpectedStatus:   http.StatusOK,
+		},
+		{
+			Description:      "Server Error then Success",
+			StatusCodes:      []int{http.StatusServiceUnavailable, http.StatusOK},
+			MaxRetries:       3,
+			ExpectedAttempts: 2,
+			ExpectedStatus:   http.StatusOK,
+		},
+		{
+			Description:      "Retries Exhausted",
+			StatusCodes:      []int{http.StatusInternalServerError, http.StatusBadGateway, http.StatusGatewayTimeout},
+			MaxRetries:       2,
+			ExpectedAttempts: 3,
+			ExpectedStatus:   http.StatusGatewayTimeout,
+		},
+		{
+			Description:      "Retries Disabled",
+			StatusCodes:      []int{http.StatusTooManyRequests, http.StatusOK},
+			MaxRetries:       0,
+			ExpectedAttempts: 1,
+			ExpectedStatus:   http.StatusTooManyRequests,
+		},
+	}
+
+	for _, v := range cases {
+		attempts := 0
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			body, _ := ioutil.ReadAll(r.Body)
+			if string(body) != "hello" {
+				t.Errorf("Expected the request body to be sent on every attempt for %q but got %q", v.Description, string(body))
+			}
+
+			statusCode := v.StatusCodes[attempts]
+			attempts++
+
+			if statusCode == http.StatusTooManyRequests {
+				w.Header().Set("Retry-After", "1")
+			}
+			w.WriteHeader(statusCode)
+		}))
+
+		sender := autorest.CreateSender(withRetries(v.MaxRetries, 10*time.Millisecond))
+		req, _ := http.NewRequest(http.MethodPut, server.URL, strings.NewReader("hello"))
+		resp, err := sender.Do(req)
+		server.Close()
+
+		if err != nil {
+			t.Fatalf("Expected no error for %q but got: %+v", v.Description, err)
+		}
+
+		if resp.StatusCode != v.ExpectedStatus {
+			t.Fatalf("Expected the Status Code to be %d for %q but got %d", v.ExpectedStatus, v.Description, resp.StatusCode)
+		}
+
+		if attempts != v.ExpectedAttempts {
+			t.Fatalf("Expected %d attempts for %q but got %d", v.ExpectedAttempts, v.Description, attempts)
+		}
+	}
+}
+
+func TestRetryDelay(t *testing.T) {
+	maxWait := 30 * time.Second
+
+	cases := []struct {
+		Description string
+		RetryAfter  string
+		Attempt     int
+		Minimum     time.Duration
+		Maximum     time.Duration
+	}{
+		{
+			Description: "Retry-After in seconds",
+			RetryAfter:  "17",
+			Attempt:     0,
+			Minimum:     17 * time.Second,
+			Maximum:     17 * time.Second,
+		},
+		{
+			Description: "Retry-After greater than the maximum wait",
+			RetryAfter:  "300",
+			Attempt:     0,
+			Minimum:     maxWait,
+			Maximum:     maxWait,
+		},
+		{
+			Description: "Retry-After as a HTTP Date in the past",
+			RetryAfter:  "Wed, 21 Oct 2015 07:28:00 GMT",
+			Attempt:     0,
+			Minimum:     0,
+			Maximum:     0,
+		},
+		{
+			Description: "Backoff on the first attempt",
+			Attempt:     0,
+			Minimum:     500 * time.Millisecond,
+			Maximum:     time.Second,
+		},
+		{
+			Description: "Backoff on the third attempt",
+			Attempt:     2,
+			Minimum:     2 * time.Second,
+			Maximum:     4 * time.Second,
+		},
+		{
+			Description: "Backoff greater than the maximum wait",
+			Attempt:     10,
+			Minimum:     maxWait / 2,
+			Maximum:     maxWait,
+		},
+		{
+			Description: "Invalid Retry-After falls back to Backoff",
+			RetryAfter:  "soon",
+			Attempt:     1,
+			Minimum:     time.Second,
+			Maximum:     2 * time.Second,
+		},
+	}
+
+	for _, v := range cases {
+		resp := &http.Response{
+			Header: http.Header{},
+		}
+		if v.RetryAfter != "" {
+			resp.Header.Set("Retry-After", v.RetryAfter)
+		}
+
+		delay := retryDelay(resp, v.Attempt, maxWait)
+		if delay < v.Minimum || delay > v.Maximum {
+			t.Fatalf("Expected the delay for %q to be between %s and %s but got %s", v.Description, v.Minimum, v.Maximum, delay)
+		}
+	}
+}
+
+func TestArmClientForSubscription(t *testing.T) {
+	client := &ArmClient{
+		subscriptionId: "00000000-0000-0000-0000-000000000000",
+		endpoint:       "https://management.azure.com/",
+		auth:           autorest.NullAuthorizer{},
+		auxiliaryAuth:  autorest.NullAuthorizer{},
+		graphAuth:      autorest.NullAuthorizer{},
+		keyVaultAuth:   autorest.NullAuthorizer{},
+		sender:         http.DefaultClient,
+	}
+
+	if actual := client.forSubscription(""); actual != client {
+		t.Fatalf("Expected the same client when no Subscription was specified")
+	}
+
+	if actual := client.forSubscription("00000000-0000-0000-0000-000000000000"); actual != client {
+		t.Fatalf("Expected the same client for the configured Subscription")
+	}
+
+	other := client.forSubscription("11111111-1111-1111-1111-111111111111")
+	if other == client {
+		t.Fatalf("Expected a different client for another Subscription")
+	}
+
+	if other.subscriptionId != "11111111-1111-1111-1111-111111111111" {
+		t.Fatalf("Expected the Subscription ID to be %q but got %q", "11111111-1111-1111-1111-111111111111", other.subscriptionId)
+	}
+
+	if other.vnetClient.SubscriptionID != "11111111-1111-1111-1111-111111111111" {
+		t.Fatalf("Expected the Virtual Network client to be bound to %q but got %q", "11111111-1111-1111-1111-111111111111", other.vnetClient.SubscriptionID)
+	}
+
+	if again := client.forSubscription("11111111-1111-1111-1111-111111111111"); again != other {
+		t.Fatalf("Expected the clients for a Subscription to be cached")
+	}
+}
+
+func TestAuxiliaryTenantAuthorizer(t *testing.T) {
+	tokens := make([]*adal.ServicePrincipalToken, 0)
+	for _, tenantId := range []string{"11111111-1111-1111-1111-111111111111", "22222222-2222-2222-2222-222222222222"} {
+		oauthConfig, err := adal.NewOAuthConfig("https://login.microsoftonline.com/", tenantId)
+		if err != nil {
+			t.Fatalf("Error building the OAuth Config: %+v", err)
+		}
+
+		token := adal.Token{
+			AccessToken: fmt.Sprintf("token-%s", tenantId),
+			ExpiresOn:   strconv.FormatInt(time.Now().Add(time.Hour).Unix(), 10),
+			Type:        "Bearer",
+		}
+		spt, err := adal.NewServicePrincipalTokenFromManualToken(*oauthConfig, "clientId", "https://management.azure.com/", token)
+		if err != nil {
+			t.Fatalf("Error building the Token: %+v", err)
+		}
+		tokens = append(tokens, spt)
+	}
+
+	authorizer := auxiliaryTenantAuthorizer{
+		primary:   autorest.NewAPIKeyAuthorizerWithHeaders(map[string]interface{}{"Authorization": "Bearer primary"}),
+		auxiliary: tokens,
+	}
+
+	req, err := autorest.Prepare(&http.Request{}, autorest.WithBaseURL("https://management.azure.com/"), authorizer.WithAuthorization())
+	if err != nil {
+		t.Fatalf("Error preparing the request: %+v", err)
+	}
+
+	if v := req.Header.Get("Authorization"); v != "Bearer primary" {
+		t.Fatalf("Expected the Authorization header to be %q but got %q", "Bearer primary", v)
+	}
+
+	expected := "Bearer token-11111111-1111-1111-1111-111111111111, Bearer token-22222222-2222-2222-2222-222222222222"
+	if v := req.Header.Get("x-ms-authorization-auxiliary"); v != expected {
+		t.Fatalf("Expected the Auxiliary Authorization header to be %q but got %q", expected, v)
+	}
+}
@@ -34,9 +34,11 @@ func dataSourceArmResourceGroupRead(d *schema.ResourceData, meta interface{}) er
 
 	d.SetId(*resp.ID)
 
-	if err := resourceArmResourceGroupRead(d, meta); err != nil {
-		return err
+	d.Set("name", resp.Name)
+	if location := resp.Location; location != nil {
+		d.Set("location", azureRMNormalizeLocation(*location))
 	}
+	flattenAndSetTags(d, resp.Tags)
 
 	return nil
 }
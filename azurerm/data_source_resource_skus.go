@@ -0,0 +1,353 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2018-06-01/compute"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func dataSourceArmResourceSkus() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceArmResourceSkusRead,
+
+		Schema: map[string]*schema.Schema{
+			"location": locationSchema(),
+
+			"resource_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"name_prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"zone": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"minimum_vcpus": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+
+			"minimum_memory_gb": {
+				Type:     schema.TypeFloat,
+				Optional: true,
+			},
+
+			"accelerated_networking_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+
+			"premium_io_supported": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+
+			"names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"skus": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"resource_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"tier": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"size": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"family": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"zones": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"capabilities": {
+							Type:     schema.TypeMap,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceArmResourceSkusRead(d *schema.ResourceData, meta interface{}) error {
+	location := azureRMNormalizeLocation(d.Get("location").(string))
+	resourceType := d.Get("resource_type").(string)
+
+	log.Printf("[DEBUG] Reading Resource SKUs available in %q", location)
+	skus, err := listAvailableAzureRmResourceSkus(meta, location, resourceType)
+	if err != nil {
+		return err
+	}
+
+	filteredSkus := make([]compute.ResourceSku, 0)
+	for _, sku := range skus {
+		shouldInclude := true
+
+		if v, ok := d.GetOkExists("name_prefix"); ok {
+			if prefix := v.(string); prefix != "" {
+				if !strings.HasPrefix(*sku.Name, prefix) {
+					shouldInclude = false
+				}
+			}
+		}
+
+		if v, ok := d.GetOkExists("zone"); ok {
+			if zone := v.(string); zone != "" {
+				if !sliceContainsValue(availableAzureRmResourceSkuZones(sku, location), zone) {
+					shouldInclude = false
+				}
+			}
+		}
+
+		capabilities := flattenAzureRmResourceSkuCapabilities(sku.Capabilities)
+
+		if v, ok := d.GetOkExists("minimum_vcpus"); ok {
+			vCPUs, err := strconv.Atoi(capabilities["vCPUs"])
+			if err != nil || vCPUs < v.(int) {
+				shouldInclude = false
+			}
+		}
+
+		if v, ok := d.GetOkExists("minimum_memory_gb"); ok {
+			memory, err := strconv.ParseFloat(capabilities["MemoryGB"], 64)
+			if err != nil || memory < v.(float64) {
+				shouldInclude = false
+			}
+		}
+
+		if v, ok := d.GetOkExists("accelerated_networking_enabled"); ok {
+			enabled, _ := strconv.ParseBool(capabilities["AcceleratedNetworkingEnabled"])
+			if enabled != v.(bool) {
+				shouldInclude = false
+			}
+		}
+
+		if v, ok := d.GetOkExists("premium_io_supported"); ok {
+			supported, _ := strconv.ParseBool(capabilities["PremiumIO"])
+			if supported != v.(bool) {
+				shouldInclude = false
+			}
+		}
+
+		if shouldInclude {
+			filteredSkus = append(filteredSkus, sku)
+		}
+	}
+
+	d.SetId(time.Now().UTC().String())
+
+	names := make([]string, 0)
+	for _, sku := range filteredSkus {
+		names = append(names, *sku.Name)
+	}
+	if err := d.Set("names", names); err != nil {
+		return fmt.Errorf("Error setting `names`: %+v", err)
+	}
+
+	if err := d.Set("skus", flattenDataSourceResourceSkus(filteredSkus, location)); err != nil {
+		return fmt.Errorf("Error setting `skus`: %+v", err)
+	}
+
+	return nil
+}
+
+// listAvailableAzureRmResourceSkus returns the Resource SKUs which are available to this Subscription in the
+// specified location, optionally limited to a single Resource Type (e.g. `virtualMachines`). SKUs which are
+// restricted in the location (for example as they're not available for this Subscription) are excluded.
+func listAvailableAzureRmResourceSkus(meta interface{}, location, resourceType string) ([]compute.ResourceSku, error) {
+	client := meta.(*ArmClient).resourceSkusClient
+	ctx := meta.(*ArmClient).StopContext
+
+	iterator, err := client.ListComplete(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("Error listing Resource SKUs: %+v", err)
+	}
+
+	results := make([]compute.ResourceSku, 0)
+	for iterator.NotDone() {
+		sku := iterator.Value()
+
+		if err := iterator.Next(); err != nil {
+			return nil, fmt.Errorf("Error listing Resource SKUs: %+v", err)
+		}
+
+		if sku.Name == nil || sku.ResourceType == nil {
+			continue
+		}
+
+		if resourceType != "" && !strings.EqualFold(*sku.ResourceType, resourceType) {
+			continue
+		}
+
+		if !azureRmResourceSkuIsAvailableInLocation(sku, location) {
+			continue
+		}
+
+		results = append(results, sku)
+	}
+
+	return results, nil
+}
+
+func azureRmResourceSkuIsAvailableInLocation(sku compute.ResourceSku, location string) bool {
+	available := false
+	if sku.Locations != nil {
+		for _, v := range *sku.Locations {
+			if azureRMNormalizeLocation(v) == location {
+				available = true
+				break
+			}
+		}
+	}
+
+	if !available {
+		return false
+	}
+
+	if sku.Restrictions != nil {
+		for _, restriction := range *sku.Restrictions {
+			if restriction.Type != compute.Location {
+				continue
+			}
+
+			locations := make([]string, 0)
+			if restriction.Values != nil {
+				locations = append(locations, *restriction.Values...)
+			}
+			if info := restriction.RestrictionInfo; info != nil && info.Locations != nil {
+				locations = append(locations, *info.Locations...)
+			}
+
+			for _, v := range locations {
+				if azureRMNormalizeLocation(v) == location {
+					return false
+				}
+			}
+		}
+	}
+
+	return true
+}
+
+// availableAzureRmResourceSkuZones returns the Availability Zones the SKU is offered in within the specified
+// location, excluding any zones which are restricted for this Subscription
+func availableAzureRmResourceSkuZones(sku compute.ResourceSku, location string) []string {
+	restrictedZones := make([]string, 0)
+	if sku.Restrictions != nil {
+		for _, restriction := range *sku.Restrictions {
+			if restriction.Type != compute.Zone {
+				continue
+			}
+
+			if info := restriction.RestrictionInfo; info != nil && info.Zones != nil {
+				restrictedZones = append(restrictedZones, *info.Zones...)
+			}
+		}
+	}
+
+	zones := make([]string, 0)
+	if sku.LocationInfo != nil {
+		for _, info := range *sku.LocationInfo {
+			if info.Location == nil || azureRMNormalizeLocation(*info.Location) != location || info.Zones == nil {
+				continue
+			}
+
+			for _, zone := range *info.Zones {
+				if !sliceContainsValue(restrictedZones, zone) {
+					zones = append(zones, zone)
+				}
+			}
+		}
+	}
+
+	return zones
+}
+
+func flattenAzureRmResourceSkuCapabilities(input *[]compute.ResourceSkuCapabilities) map[string]string {
+	output := make(map[string]string)
+
+	if input != nil {
+		for _, v := range *input {
+			if v.Name != nil && v.Value != nil {
+				output[*v.Name] = *v.Value
+			}
+		}
+	}
+
+	return output
+}
+
+func flattenDataSourceResourceSkus(input []compute.ResourceSku, location string) []interface{} {
+	results := make([]interface{}, 0)
+
+	for _, sku := range input {
+		output := make(map[string]interface{})
+
+		if sku.Name != nil {
+			output["name"] = *sku.Name
+		}
+
+		if sku.ResourceType != nil {
+			output["resource_type"] = *sku.ResourceType
+		}
+
+		if sku.Tier != nil {
+			output["tier"] = *sku.Tier
+		}
+
+		if sku.Size != nil {
+			output["size"] = *sku.Size
+		}
+
+		if sku.Family != nil {
+			output["family"] = *sku.Family
+		}
+
+		output["zones"] = availableAzureRmResourceSkuZones(sku, location)
+
+		capabilities := make(map[string]interface{})
+		for k, v := range flattenAzureRmResourceSkuCapabilities(sku.Capabilities) {
+			capabilities[k] = v
+		}
+		output["capabilities"] = capabilities
+
+		results = append(results, output)
+	}
+
+	return results
+}
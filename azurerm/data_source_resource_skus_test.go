@@ -0,0 +1,155 @@
+package azurerm
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2018-06-01/compute"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccDataSourceAzureRMResourceSkus_virtualMachines(t *testing.T) {
+	dataSourceName := "data.azurerm_resource_skus.test"
+	config := testAccDataSourceAzureRMResourceSkus_virtualMachines(testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "names.#"),
+					resource.TestCheckResourceAttr(dataSourceName, "skus.0.resource_type", "virtualMachines"),
+					resource.TestCheckResourceAttr(dataSourceName, "skus.0.capabilities.PremiumIO", "True"),
+				),
+			},
+		},
+	})
+}
+
+func TestAzureRMResourceSkuIsAvailableInLocation(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Sku      compute.ResourceSku
+		Expected bool
+	}{
+		{
+			Name: "Not Offered",
+			Sku: compute.ResourceSku{
+				Locations: &[]string{"westus"},
+			},
+			Expected: false,
+		},
+		{
+			Name: "Offered",
+			Sku: compute.ResourceSku{
+				Locations: &[]string{"WestEurope"},
+			},
+			Expected: true,
+		},
+		{
+			Name: "Restricted in another Location",
+			Sku: compute.ResourceSku{
+				Locations: &[]string{"westeurope"},
+				Restrictions: &[]compute.ResourceSkuRestrictions{
+					{
+						Type:       compute.Location,
+						Values:     &[]string{"westus"},
+						ReasonCode: compute.NotAvailableForSubscription,
+					},
+				},
+			},
+			Expected: true,
+		},
+		{
+			Name: "Restricted in this Location",
+			Sku: compute.ResourceSku{
+				Locations: &[]string{"westeurope"},
+				Restrictions: &[]compute.ResourceSkuRestrictions{
+					{
+						Type:       compute.Location,
+						Values:     &[]string{"westeurope"},
+						ReasonCode: compute.NotAvailableForSubscription,
+					},
+				},
+			},
+			Expected: false,
+		},
+		{
+			Name: "Zone Restriction",
+			Sku: compute.ResourceSku{
+				Locations: &[]string{"westeurope"},
+				Restrictions: &[]compute.ResourceSkuRestrictions{
+					{
+						Type:   compute.Zone,
+						Values: &[]string{"westeurope"},
+						RestrictionInfo: &compute.ResourceSkuRestrictionInfo{
+							Locations: &[]string{"westeurope"},
+							Zones:     &[]string{"1"},
+						},
+						ReasonCode: compute.NotAvailableForSubscription,
+					},
+				},
+			},
+			Expected: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		actual := azureRmResourceSkuIsAvailableInLocation(v.Sku, "westeurope")
+		if actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
+func TestAzureRMResourceSkuAvailableZones(t *testing.T) {
+	sku := compute.ResourceSku{
+		Locations: &[]string{"westeurope", "westus"},
+		LocationInfo: &[]compute.ResourceSkuLocationInfo{
+			{
+				Location: utils.String("westeurope"),
+				Zones:    &[]string{"1", "2", "3"},
+			},
+			{
+				Location: utils.String("westus"),
+			},
+		},
+		Restrictions: &[]compute.ResourceSkuRestrictions{
+			{
+				Type:   compute.Zone,
+				Values: &[]string{"westeurope"},
+				RestrictionInfo: &compute.ResourceSkuRestrictionInfo{
+					Locations: &[]string{"westeurope"},
+					Zones:     &[]string{"2"},
+				},
+				ReasonCode: compute.NotAvailableForSubscription,
+			},
+		},
+	}
+
+	expected := []string{"1", "3"}
+	if actual := availableAzureRmResourceSkuZones(sku, "westeurope"); !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+
+	if actual := availableAzureRmResourceSkuZones(sku, "westus"); len(actual) != 0 {
+		t.Fatalf("Expected no zones but got %+v", actual)
+	}
+}
+
+func testAccDataSourceAzureRMResourceSkus_virtualMachines(location string) string {
+	return fmt.Sprintf(`
+data "azurerm_resource_skus" "test" {
+  location             = "%s"
+  resource_type        = "virtualMachines"
+  minimum_vcpus        = 2
+  premium_io_supported = true
+}
+`, location)
+}
@@ -0,0 +1,124 @@
+package azurerm
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func dataSourceArmSharedImage() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceArmSharedImageRead,
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: azure.ValidateSharedImageName(),
+			},
+
+			"gallery_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: azure.ValidateSharedImageGalleryName(),
+			},
+
+			"resource_group_name": resourceGroupNameForDataSourceSchema(),
+
+			"location": locationForDataSourceSchema(),
+
+			"os_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"identifier": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"publisher": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"offer": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"sku": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"eula": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"privacy_statement_uri": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"release_note_uri": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"tags": tagsForDataSourceSchema(),
+		},
+	}
+}
+
+func dataSourceArmSharedImageRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).galleryImagesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	name := d.Get("name").(string)
+	galleryName := d.Get("gallery_name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+
+	resp, err := client.Get(ctx, resourceGroup, galleryName, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return fmt.Errorf("Error: Shared Image %q (Gallery %q / Resource Group %q) was not found", name, galleryName, resourceGroup)
+		}
+		return fmt.Errorf("Error making Read request on Shared Image %q (Gallery %q / Resource Group %q): %+v", name, galleryName, resourceGroup, err)
+	}
+
+	d.SetId(*resp.ID)
+
+	d.Set("name", resp.Name)
+	d.Set("gallery_name", galleryName)
+	d.Set("resource_group_name", resourceGroup)
+	if location := resp.Location; location != nil {
+		d.Set("location", azureRMNormalizeLocation(*location))
+	}
+
+	if props := resp.GalleryImageProperties; props != nil {
+		d.Set("description", props.Description)
+		d.Set("eula", props.Eula)
+		d.Set("os_type", string(props.OsType))
+		d.Set("privacy_statement_uri", props.PrivacyStatementURI)
+		d.Set("release_note_uri", props.ReleaseNoteURI)
+
+		if err := d.Set("identifier", flattenAzureRmSharedImageIdentifier(props.Identifier)); err != nil {
+			return fmt.Errorf("Error setting `identifier`: %+v", err)
+		}
+	}
+
+	flattenAndSetTags(d, resp.Tags)
+
+	return nil
+}
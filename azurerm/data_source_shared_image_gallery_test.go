@@ -0,0 +1,42 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceAzureRMSharedImageGallery_basic(t *testing.T) {
+	dataSourceName := "data.azurerm_shared_image_gallery.test"
+	ri := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceSharedImageGallery_basic(ri, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "location"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "unique_name"),
+					resource.TestCheckResourceAttr(dataSourceName, "description", "Shared images and things."),
+					resource.TestCheckResourceAttr(dataSourceName, "tags.%", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceSharedImageGallery_basic(rInt int, location string) string {
+	template := testAccAzureRMSharedImageGallery_complete(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_shared_image_gallery" "test" {
+  name                = "${azurerm_shared_image_gallery.test.name}"
+  resource_group_name = "${azurerm_shared_image_gallery.test.resource_group_name}"
+}
+`, template)
+}
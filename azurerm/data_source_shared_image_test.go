@@ -0,0 +1,43 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceAzureRMSharedImage_basic(t *testing.T) {
+	dataSourceName := "data.azurerm_shared_image.test"
+	ri := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceSharedImage_basic(ri, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "location"),
+					resource.TestCheckResourceAttr(dataSourceName, "os_type", "Linux"),
+					resource.TestCheckResourceAttr(dataSourceName, "identifier.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "identifier.0.publisher", fmt.Sprintf("AccTesPublisher%d", ri)),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceSharedImage_basic(rInt int, location string) string {
+	template := testAccAzureRMSharedImage_basic(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_shared_image" "test" {
+  name                = "${azurerm_shared_image.test.name}"
+  gallery_name        = "${azurerm_shared_image.test.gallery_name}"
+  resource_group_name = "${azurerm_shared_image.test.resource_group_name}"
+}
+`, template)
+}
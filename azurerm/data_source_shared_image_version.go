@@ -0,0 +1,198 @@
+package azurerm
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2018-06-01/compute"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func dataSourceArmSharedImageVersion() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceArmSharedImageVersionRead,
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateSharedImageVersionDataSourceName,
+			},
+
+			"image_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: azure.ValidateSharedImageName(),
+			},
+
+			"gallery_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: azure.ValidateSharedImageGalleryName(),
+			},
+
+			"resource_group_name": resourceGroupNameForDataSourceSchema(),
+
+			"location": locationForDataSourceSchema(),
+
+			"managed_image_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"target_region": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"regional_replica_count": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"exclude_from_latest": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			"tags": tagsForDataSourceSchema(),
+		},
+	}
+}
+
+func dataSourceArmSharedImageVersionRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).galleryImageVersionsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	name := d.Get("name").(string)
+	imageName := d.Get("image_name").(string)
+	galleryName := d.Get("gallery_name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+
+	if name == "latest" {
+		latest, err := findLatestSharedImageVersion(meta, resourceGroup, galleryName, imageName)
+		if err != nil {
+			return err
+		}
+
+		name = latest
+	}
+
+	resp, err := client.Get(ctx, resourceGroup, galleryName, imageName, name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return fmt.Errorf("Error: Shared Image Version %q (Image %q / Gallery %q / Resource Group %q) was not found", name, imageName, galleryName, resourceGroup)
+		}
+		return fmt.Errorf("Error making Read request on Shared Image Version %q (Image %q / Gallery %q / Resource Group %q): %+v", name, imageName, galleryName, resourceGroup, err)
+	}
+
+	d.SetId(*resp.ID)
+
+	// the name is intentionally not set, so that `latest` doesn't show a diff
+	d.Set("image_name", imageName)
+	d.Set("gallery_name", galleryName)
+	d.Set("resource_group_name", resourceGroup)
+	if location := resp.Location; location != nil {
+		d.Set("location", azureRMNormalizeLocation(*location))
+	}
+
+	if props := resp.GalleryImageVersionProperties; props != nil {
+		if profile := props.PublishingProfile; profile != nil {
+			d.Set("exclude_from_latest", profile.ExcludeFromLatest)
+
+			if source := profile.Source; source != nil && source.ManagedImage != nil {
+				d.Set("managed_image_id", source.ManagedImage.ID)
+			}
+
+			if err := d.Set("target_region", flattenAzureRmSharedImageVersionTargetRegions(profile.TargetRegions)); err != nil {
+				return fmt.Errorf("Error setting `target_region`: %+v", err)
+			}
+		}
+	}
+
+	flattenAndSetTags(d, resp.Tags)
+
+	return nil
+}
+
+// validateSharedImageVersionDataSourceName validates the name of a Shared Image Version, additionally allowing
+// `latest` to be specified
+func validateSharedImageVersionDataSourceName(i interface{}, k string) ([]string, []error) {
+	if v, ok := i.(string); ok && v == "latest" {
+		return nil, nil
+	}
+
+	return azure.ValidateSharedImageVersionName()(i, k)
+}
+
+// findLatestSharedImageVersion returns the name of the highest Version of the Shared Image which isn't excluded
+// from `latest` - matching the version Azure uses when a Virtual Machine is provisioned from the Shared Image
+func findLatestSharedImageVersion(meta interface{}, resourceGroup, galleryName, imageName string) (string, error) {
+	client := meta.(*ArmClient).galleryImageVersionsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	iterator, err := client.ListByGalleryImageComplete(ctx, resourceGroup, galleryName, imageName)
+	if err != nil {
+		return "", fmt.Errorf("Error listing the Versions of Shared Image %q (Gallery %q / Resource Group %q): %+v", imageName, galleryName, resourceGroup, err)
+	}
+
+	versions := make([]compute.GalleryImageVersion, 0)
+	for iterator.NotDone() {
+		versions = append(versions, iterator.Value())
+
+		if err := iterator.Next(); err != nil {
+			return "", fmt.Errorf("Error listing the Versions of Shared Image %q (Gallery %q / Resource Group %q): %+v", imageName, galleryName, resourceGroup, err)
+		}
+	}
+
+	latest := ""
+	for _, version := range versions {
+		if version.Name == nil {
+			continue
+		}
+
+		if props := version.GalleryImageVersionProperties; props != nil && props.PublishingProfile != nil {
+			if exclude := props.PublishingProfile.ExcludeFromLatest; exclude != nil && *exclude {
+				continue
+			}
+		}
+
+		if latest == "" || compareSharedImageVersions(*version.Name, latest) > 0 {
+			latest = *version.Name
+		}
+	}
+
+	if latest == "" {
+		return "", fmt.Errorf("Error: no Versions of Shared Image %q (Gallery %q / Resource Group %q) are available as the latest version", imageName, galleryName, resourceGroup)
+	}
+
+	return latest, nil
+}
+
+// compareSharedImageVersions compares two versions in the format `Major.Minor.Patch`, returning a negative number
+// when `a` is lower than `b`, zero when they're equal and a positive number when `a` is higher than `b`
+func compareSharedImageVersions(a, b string) int {
+	aSegments := strings.Split(a, ".")
+	bSegments := strings.Split(b, ".")
+
+	for i := 0; i < len(aSegments) && i < len(bSegments); i++ {
+		aValue, _ := strconv.Atoi(aSegments[i])
+		bValue, _ := strconv.Atoi(bSegments[i])
+
+		if aValue != bValue {
+			return aValue - bValue
+		}
+	}
+
+	return len(aSegments) - len(bSegments)
+}
@@ -0,0 +1,87 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceAzureRMSharedImageVersion_basic(t *testing.T) {
+	dataSourceName := "data.azurerm_shared_image_version.test"
+	ri := acctest.RandInt()
+	resourceGroup := fmt.Sprintf("acctestRG-%d", ri)
+	userName := "testadmin"
+	password := "Password1234!"
+	hostName := fmt.Sprintf("tftestcustomimagesrc%d", ri)
+	sshPort := "22"
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				// need to create a vm and then generalize it before creating the image
+				Config:  testAccAzureRMImage_standaloneImage_setup(ri, userName, password, hostName, location),
+				Destroy: false,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureVMExists("azurerm_virtual_machine.testsource", true),
+					testGeneralizeVMImage(resourceGroup, "testsource", userName, password, hostName, sshPort, location),
+				),
+			},
+			{
+				Config: testAccDataSourceSharedImageVersion_basic(ri, userName, password, hostName, location, "0.0.1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "managed_image_id"),
+					resource.TestCheckResourceAttr(dataSourceName, "target_region.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "exclude_from_latest", "false"),
+				),
+			},
+			{
+				Config: testAccDataSourceSharedImageVersion_basic(ri, userName, password, hostName, location, "latest"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "id", "azurerm_shared_image_version.test", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceSharedImageVersion_basic(rInt int, userName string, password string, hostName string, location string, name string) string {
+	template := testAccAzureRMSharedImageVersion_basic(rInt, userName, password, hostName, location)
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_shared_image_version" "test" {
+  name                = "%s"
+  image_name          = "${azurerm_shared_image_version.test.image_name}"
+  gallery_name        = "${azurerm_shared_image_version.test.gallery_name}"
+  resource_group_name = "${azurerm_shared_image_version.test.resource_group_name}"
+}
+`, template, name)
+}
+
+func TestCompareSharedImageVersions(t *testing.T) {
+	cases := []struct {
+		A        string
+		B        string
+		Expected int
+	}{
+		{A: "1.0.0", B: "1.0.0", Expected: 0},
+		{A: "1.0.1", B: "1.0.0", Expected: 1},
+		{A: "1.0.0", B: "1.1.0", Expected: -1},
+		{A: "1.10.0", B: "1.9.0", Expected: 1},
+		{A: "2.0.0", B: "10.0.0", Expected: -1},
+	}
+
+	for _, tc := range cases {
+		result := compareSharedImageVersions(tc.A, tc.B)
+
+		// only the sign of the result is significant
+		if (result > 0) != (tc.Expected > 0) || (result < 0) != (tc.Expected < 0) {
+			t.Fatalf("Expected comparing %q to %q to return %d but got %d", tc.A, tc.B, tc.Expected, result)
+		}
+	}
+}
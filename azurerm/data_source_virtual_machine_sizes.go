@@ -0,0 +1,245 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2018-06-01/compute"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func dataSourceArmVirtualMachineSizes() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceArmVirtualMachineSizesRead,
+
+		Schema: map[string]*schema.Schema{
+			"location": locationSchema(),
+
+			"name_prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"zone": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"minimum_number_of_cores": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+
+			"minimum_memory_in_mb": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+
+			"accelerated_networking_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+
+			"premium_io_supported": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+
+			"names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"sizes": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"number_of_cores": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"memory_in_mb": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"max_data_disk_count": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"os_disk_size_in_mb": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"resource_disk_size_in_mb": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"zones": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceArmVirtualMachineSizesRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).vmSizesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	location := azureRMNormalizeLocation(d.Get("location").(string))
+
+	log.Printf("[DEBUG] Reading Virtual Machine Sizes available in %q", location)
+	resp, err := client.List(ctx, location)
+	if err != nil {
+		return fmt.Errorf("Error listing Virtual Machine Sizes in %q: %+v", location, err)
+	}
+
+	// the Virtual Machine Sizes API doesn't take the Subscription's restrictions into account, as such
+	// we cross-reference the sizes with the Resource SKUs to only return sizes which can be provisioned
+	skus, err := listAvailableAzureRmResourceSkus(meta, location, "virtualMachines")
+	if err != nil {
+		return err
+	}
+
+	availableSkus := make(map[string]compute.ResourceSku)
+	for _, sku := range skus {
+		availableSkus[strings.ToLower(*sku.Name)] = sku
+	}
+
+	filteredSizes := make([]compute.VirtualMachineSize, 0)
+	zones := make(map[string][]string)
+	if resp.Value != nil {
+		for _, size := range *resp.Value {
+			if size.Name == nil {
+				continue
+			}
+
+			sku, ok := availableSkus[strings.ToLower(*size.Name)]
+			if !ok {
+				log.Printf("[DEBUG] Virtual Machine Size %q is restricted in %q - excluding", *size.Name, location)
+				continue
+			}
+
+			shouldInclude := true
+			sizeZones := availableAzureRmResourceSkuZones(sku, location)
+			capabilities := flattenAzureRmResourceSkuCapabilities(sku.Capabilities)
+
+			if v, ok := d.GetOkExists("name_prefix"); ok {
+				if prefix := v.(string); prefix != "" {
+					if !strings.HasPrefix(*size.Name, prefix) {
+						shouldInclude = false
+					}
+				}
+			}
+
+			if v, ok := d.GetOkExists("zone"); ok {
+				if zone := v.(string); zone != "" {
+					if !sliceContainsValue(sizeZones, zone) {
+						shouldInclude = false
+					}
+				}
+			}
+
+			if v, ok := d.GetOkExists("minimum_number_of_cores"); ok {
+				if size.NumberOfCores == nil || int(*size.NumberOfCores) < v.(int) {
+					shouldInclude = false
+				}
+			}
+
+			if v, ok := d.GetOkExists("minimum_memory_in_mb"); ok {
+				if size.MemoryInMB == nil || int(*size.MemoryInMB) < v.(int) {
+					shouldInclude = false
+				}
+			}
+
+			if v, ok := d.GetOkExists("accelerated_networking_enabled"); ok {
+				enabled, _ := strconv.ParseBool(capabilities["AcceleratedNetworkingEnabled"])
+				if enabled != v.(bool) {
+					shouldInclude = false
+				}
+			}
+
+			if v, ok := d.GetOkExists("premium_io_supported"); ok {
+				supported, _ := strconv.ParseBool(capabilities["PremiumIO"])
+				if supported != v.(bool) {
+					shouldInclude = false
+				}
+			}
+
+			if shouldInclude {
+				filteredSizes = append(filteredSizes, size)
+				zones[*size.Name] = sizeZones
+			}
+		}
+	}
+
+	d.SetId(time.Now().UTC().String())
+
+	names := make([]string, 0)
+	for _, size := range filteredSizes {
+		names = append(names, *size.Name)
+	}
+	if err := d.Set("names", names); err != nil {
+		return fmt.Errorf("Error setting `names`: %+v", err)
+	}
+
+	if err := d.Set("sizes", flattenDataSourceVirtualMachineSizes(filteredSizes, zones)); err != nil {
+		return fmt.Errorf("Error setting `sizes`: %+v", err)
+	}
+
+	return nil
+}
+
+func flattenDataSourceVirtualMachineSizes(input []compute.VirtualMachineSize, zones map[string][]string) []interface{} {
+	results := make([]interface{}, 0)
+
+	for _, size := range input {
+		output := make(map[string]interface{})
+
+		if size.Name != nil {
+			output["name"] = *size.Name
+			output["zones"] = zones[*size.Name]
+		}
+
+		if size.NumberOfCores != nil {
+			output["number_of_cores"] = int(*size.NumberOfCores)
+		}
+
+		if size.MemoryInMB != nil {
+			output["memory_in_mb"] = int(*size.MemoryInMB)
+		}
+
+		if size.MaxDataDiskCount != nil {
+			output["max_data_disk_count"] = int(*size.MaxDataDiskCount)
+		}
+
+		if size.OsDiskSizeInMB != nil {
+			output["os_disk_size_in_mb"] = int(*size.OsDiskSizeInMB)
+		}
+
+		if size.ResourceDiskSizeInMB != nil {
+			output["resource_disk_size_in_mb"] = int(*size.ResourceDiskSizeInMB)
+		}
+
+		results = append(results, output)
+	}
+
+	return results
+}
@@ -0,0 +1,38 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceAzureRMVirtualMachineSizes_basic(t *testing.T) {
+	dataSourceName := "data.azurerm_virtual_machine_sizes.test"
+	config := testAccDataSourceAzureRMVirtualMachineSizes_basic(testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "names.#"),
+					resource.TestCheckResourceAttr(dataSourceName, "sizes.0.number_of_cores", "2"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "sizes.0.memory_in_mb"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAzureRMVirtualMachineSizes_basic(location string) string {
+	return fmt.Sprintf(`
+data "azurerm_virtual_machine_sizes" "test" {
+  location                = "%s"
+  name_prefix             = "Standard_F2"
+  minimum_number_of_cores = 2
+}
+`, location)
+}
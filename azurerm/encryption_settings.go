@@ -98,6 +98,12 @@ func expandManagedDiskEncryptionSettings(settings map[string]interface{}) *compu
 	return config
 }
 
+// managedDiskEncryptionSettingsAreDisabled returns whether encryption is disabled without any keys being specified
+func managedDiskEncryptionSettingsAreDisabled(settings *compute.EncryptionSettings) bool {
+	enabled := settings.Enabled != nil && *settings.Enabled
+	return !enabled && settings.DiskEncryptionKey == nil && settings.KeyEncryptionKey == nil
+}
+
 func flattenManagedDiskEncryptionSettings(encryptionSettings *compute.EncryptionSettings) []interface{} {
 	value := map[string]interface{}{
 		"enabled": *encryptionSettings.Enabled,
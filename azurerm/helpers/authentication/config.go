@@ -2,8 +2,8 @@ package authentication
 
 import (
 	"fmt"
-
 	"log"
+	"time"
 
 	"github.com/Azure/go-autorest/autorest/adal"
 	"github.com/Azure/go-autorest/autorest/azure/cli"
@@ -12,8 +12,6 @@ import (
 // Config is the configuration structure used to instantiate a
 // new Azure management client.
 type Config struct {
-	ManagementURL string
-
 	// Core
 	ClientID                  string
 	SubscriptionID            string
@@ -22,9 +20,28 @@ type Config struct {
 	SkipCredentialsValidation bool
 	SkipProviderRegistration  bool
 
+	// Resource Provider Registration
+	LazyProviderRegistration    bool
+	ResourceProvidersToRegister []string
+
+	// Custom Environments
+	MetadataURL     string
+	EnvironmentFile string
+
+	// Retries
+	MaxRetries   int
+	RetryMaxWait time.Duration
+
 	// Service Principal Auth
 	ClientSecret string
 
+	// Auxiliary Tenants (only supported when authenticating using a Service Principal)
+	AuxiliaryTenantIDs []string
+
+	// Service Principal (Client Certificate) Auth
+	ClientCertPath     string
+	ClientCertPassword string
+
 	// Bearer Auth
 	AccessToken  *adal.Token
 	IsCloudShell bool
@@ -1,6 +1,17 @@
 package authentication
 
-import "strings"
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	multierror "github.com/hashicorp/go-multierror"
+)
 
 func normalizeEnvironmentName(input string) string {
 	// Environment is stored as `Azure{Environment}Cloud`
@@ -14,3 +25,219 @@ func normalizeEnvironmentName(input string) string {
 	}
 	return output
 }
+
+// metadataEndpointsPath is the path of the document describing the endpoints of an Azure Cloud,
+// which is exposed by the Resource Manager endpoint (for example on Azure Stack)
+const metadataEndpointsPath = "/metadata/endpoints"
+
+// metadataEnvironment represents the document returned from the `/metadata/endpoints` API.
+// Older versions of this API (e.g. those exposed by Azure Stack) only return the Graph, Gallery
+// and Authentication endpoints - newer versions also return the Resource Manager endpoint and
+// the DNS Suffixes used by each service.
+type metadataEnvironment struct {
+	Name            string `json:"name"`
+	GalleryEndpoint string `json:"galleryEndpoint"`
+	GraphEndpoint   string `json:"graphEndpoint"`
+	PortalEndpoint  string `json:"portalEndpoint"`
+	Gallery         string `json:"gallery"`
+	Graph           string `json:"graph"`
+	Portal          string `json:"portal"`
+	ResourceManager string `json:"resourceManager"`
+	Authentication  struct {
+		LoginEndpoint string   `json:"loginEndpoint"`
+		Audiences     []string `json:"audiences"`
+	} `json:"authentication"`
+	Suffixes struct {
+		AcrLoginServer    string `json:"acrLoginServer"`
+		KeyVaultDNS       string `json:"keyVaultDns"`
+		SqlServerHostname string `json:"sqlServerHostname"`
+		Storage           string `json:"storage"`
+	} `json:"suffixes"`
+}
+
+// LoadEnvironment returns the Azure Environment which should be used - which is either built from the
+// Metadata URL, loaded from the Environment File or is one of the built-in Environments (in that order)
+func (c Config) LoadEnvironment(sender autorest.Sender) (*azure.Environment, error) {
+	if c.MetadataURL != "" {
+		return EnvironmentFromMetadataURL(c.MetadataURL, sender)
+	}
+
+	if c.EnvironmentFile != "" {
+		return EnvironmentFromFile(c.EnvironmentFile)
+	}
+
+	return EnvironmentFromName(c.Environment)
+}
+
+// EnvironmentFromName returns the built-in Azure Environment matching the specified name, which can be either
+// the full name (e.g. `AzureGermanCloud`) or the readable name (e.g. `german`)
+func EnvironmentFromName(name string) (*azure.Environment, error) {
+	env, envErr := azure.EnvironmentFromName(name)
+	if envErr != nil {
+		// try again with wrapped value to support readable values like german instead of AZUREGERMANCLOUD
+		wrapped := fmt.Sprintf("AZURE%sCLOUD", name)
+		var innerErr error
+		if env, innerErr = azure.EnvironmentFromName(wrapped); innerErr != nil {
+			return nil, envErr
+		}
+	}
+
+	return &env, nil
+}
+
+// EnvironmentFromFile loads an Azure Environment from the JSON file at the specified path,
+// which uses the same format as the `AZURE_ENVIRONMENT_FILEPATH` file used by the Azure SDK
+func EnvironmentFromFile(path string) (*azure.Environment, error) {
+	env, err := azure.EnvironmentFromFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("Error loading the Environment from %q: %+v", path, err)
+	}
+
+	if err := validateEnvironment(env); err != nil {
+		return nil, fmt.Errorf("Error validating the Environment loaded from %q: %+v", path, err)
+	}
+
+	return &env, nil
+}
+
+// EnvironmentFromMetadataURL builds an Azure Environment from the `/metadata/endpoints` document exposed by the
+// Resource Manager endpoint at the specified URL. Where the document doesn't contain the DNS Suffixes for Storage
+// and Key Vault (such as on Azure Stack), these are derived from the host name of the Resource Manager endpoint.
+func EnvironmentFromMetadataURL(metadataUrl string, sender autorest.Sender) (*azure.Environment, error) {
+	u, err := url.Parse(metadataUrl)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing Metadata URL %q: %+v", metadataUrl, err)
+	}
+	if u.Scheme == "" || u.Host == "" {
+		return nil, fmt.Errorf("Metadata URL %q must be an absolute URL, such as `https://management.local.azurestack.external/`", metadataUrl)
+	}
+
+	// the Resource Manager endpoint can be specified either with or without the path to the metadata document
+	documentUrl := *u
+	if !strings.HasSuffix(strings.TrimSuffix(u.Path, "/"), metadataEndpointsPath) {
+		documentUrl.Path = strings.TrimSuffix(u.Path, "/") + metadataEndpointsPath
+	}
+	if documentUrl.Query().Get("api-version") == "" {
+		query := documentUrl.Query()
+		query.Set("api-version", "1.0")
+		documentUrl.RawQuery = query.Encode()
+	}
+
+	req, err := http.NewRequest(http.MethodGet, documentUrl.String(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("Error building the request for the Metadata Endpoints %q: %+v", documentUrl.String(), err)
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := sender.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("Error retrieving the Metadata Endpoints from %q: %+v", documentUrl.String(), err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("Error retrieving the Metadata Endpoints from %q: expected a 200 but got a %d", documentUrl.String(), resp.StatusCode)
+	}
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("Error reading the Metadata Endpoints from %q: %+v", documentUrl.String(), err)
+	}
+
+	var metadata metadataEnvironment
+	if err := json.Unmarshal(body, &metadata); err != nil {
+		return nil, fmt.Errorf("Error parsing the Metadata Endpoints from %q: %+v", documentUrl.String(), err)
+	}
+
+	resourceManagerEndpoint := fmt.Sprintf("%s://%s/", u.Scheme, u.Host)
+	env := buildEnvironmentFromMetadata(resourceManagerEndpoint, metadata)
+	if err := validateEnvironment(env); err != nil {
+		return nil, fmt.Errorf("Error validating the Environment built from %q: %+v", documentUrl.String(), err)
+	}
+
+	return &env, nil
+}
+
+func buildEnvironmentFromMetadata(resourceManagerEndpoint string, metadata metadataEnvironment) azure.Environment {
+	env := azure.Environment{
+		Name:                       metadata.Name,
+		ResourceManagerEndpoint:    firstNonEmpty(metadata.ResourceManager, resourceManagerEndpoint),
+		ActiveDirectoryEndpoint:    metadata.Authentication.LoginEndpoint,
+		GalleryEndpoint:            firstNonEmpty(metadata.Gallery, metadata.GalleryEndpoint),
+		GraphEndpoint:              firstNonEmpty(metadata.Graph, metadata.GraphEndpoint),
+		ManagementPortalURL:        firstNonEmpty(metadata.Portal, metadata.PortalEndpoint),
+		StorageEndpointSuffix:      metadata.Suffixes.Storage,
+		KeyVaultDNSSuffix:          metadata.Suffixes.KeyVaultDNS,
+		SQLDatabaseDNSSuffix:       metadata.Suffixes.SqlServerHostname,
+		ContainerRegistryDNSSuffix: metadata.Suffixes.AcrLoginServer,
+	}
+
+	if env.Name == "" {
+		env.Name = "HybridEnvironment"
+	}
+
+	if len(metadata.Authentication.Audiences) > 0 {
+		env.TokenAudience = metadata.Authentication.Audiences[0]
+	}
+
+	// Azure Stack doesn't return the DNS Suffixes - however these are the Resource Manager host name
+	// without the first segment, e.g. `management.local.azurestack.external` -> `local.azurestack.external`
+	stampDNSSuffix := ""
+	if u, err := url.Parse(env.ResourceManagerEndpoint); err == nil {
+		if segments := strings.SplitN(u.Hostname(), ".", 2); len(segments) == 2 {
+			stampDNSSuffix = segments[1]
+		}
+	}
+
+	if env.StorageEndpointSuffix == "" {
+		env.StorageEndpointSuffix = stampDNSSuffix
+	}
+
+	if env.KeyVaultDNSSuffix == "" && stampDNSSuffix != "" {
+		env.KeyVaultDNSSuffix = fmt.Sprintf("vault.%s", stampDNSSuffix)
+	}
+	env.KeyVaultDNSSuffix = strings.TrimPrefix(env.KeyVaultDNSSuffix, ".")
+
+	if env.KeyVaultDNSSuffix != "" {
+		env.KeyVaultEndpoint = fmt.Sprintf("https://%s/", env.KeyVaultDNSSuffix)
+	}
+
+	return env
+}
+
+// validateEnvironment ensures that the endpoints required to configure the Provider are present
+func validateEnvironment(env azure.Environment) error {
+	var err *multierror.Error
+
+	if env.ResourceManagerEndpoint == "" {
+		err = multierror.Append(err, fmt.Errorf("The Resource Manager Endpoint must be specified"))
+	}
+
+	if env.ActiveDirectoryEndpoint == "" {
+		err = multierror.Append(err, fmt.Errorf("The Active Directory Endpoint must be specified"))
+	}
+
+	if env.GraphEndpoint == "" {
+		err = multierror.Append(err, fmt.Errorf("The Graph Endpoint must be specified"))
+	}
+
+	if env.KeyVaultDNSSuffix == "" {
+		err = multierror.Append(err, fmt.Errorf("The Key Vault DNS Suffix must be specified"))
+	}
+
+	if env.StorageEndpointSuffix == "" {
+		err = multierror.Append(err, fmt.Errorf("The Storage Endpoint Suffix must be specified"))
+	}
+
+	return err.ErrorOrNil()
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+
+	return ""
+}
@@ -1,7 +1,16 @@
 package authentication
 
 import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"reflect"
 	"testing"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
 )
 
 func TestAzureEnvironmentNames(t *testing.T) {
@@ -21,3 +30,239 @@ func TestAzureEnvironmentNames(t *testing.T) {
 		}
 	}
 }
+
+func TestAzureEnvironmentFromName(t *testing.T) {
+	testData := map[string]string{
+		"public":           "https://management.azure.com/",
+		"AzureGermanCloud": "https://management.microsoftazure.de/",
+		"usgovernment":     "https://management.usgovcloudapi.net/",
+	}
+
+	for input, expected := range testData {
+		env, err := EnvironmentFromName(input)
+		if err != nil {
+			t.Fatalf("Expected no error for %q but got: %+v", input, err)
+		}
+
+		if env.ResourceManagerEndpoint != expected {
+			t.Fatalf("Expected the Resource Manager Endpoint for %q to be %q but got %q", input, expected, env.ResourceManagerEndpoint)
+		}
+	}
+
+	if _, err := EnvironmentFromName("mars"); err == nil {
+		t.Fatalf("Expected an error for an unknown environment but didn't get one")
+	}
+}
+
+func TestAzureEnvironmentFromMetadataURL(t *testing.T) {
+	testCases := []struct {
+		Description string
+		Path        string
+		Document    string
+		StatusCode  int
+		ExpectError bool
+		Expected    azure.Environment
+	}{
+		{
+			Description: "Azure Stack",
+			Path:        "/",
+			Document: `{
+  "galleryEndpoint": "https://adminportal.local.azurestack.external:30015/",
+  "graphEndpoint": "https://graph.windows.net/",
+  "portalEndpoint": "https://adminportal.local.azurestack.external/",
+  "authentication": {
+    "loginEndpoint": "https://login.windows.net/",
+    "audiences": ["https://management.azurestack.onmicrosoft.com/00000000-0000-0000-0000-000000000000"]
+  }
+}`,
+			StatusCode: http.StatusOK,
+			Expected: azure.Environment{
+				Name:                    "HybridEnvironment",
+				ActiveDirectoryEndpoint: "https://login.windows.net/",
+				GraphEndpoint:           "https://graph.windows.net/",
+				GalleryEndpoint:         "https://adminportal.local.azurestack.external:30015/",
+				ResourceManagerEndpoint: "https://management.local.azurestack.external/",
+				ManagementPortalURL:     "https://adminportal.local.azurestack.external/",
+				KeyVaultEndpoint:        "https://vault.local.azurestack.external/",
+				KeyVaultDNSSuffix:       "vault.local.azurestack.external",
+				StorageEndpointSuffix:   "local.azurestack.external",
+				TokenAudience:           "https://management.azurestack.onmicrosoft.com/00000000-0000-0000-0000-000000000000",
+			},
+		},
+		{
+			Description: "Full Metadata Document",
+			Path:        "/metadata/endpoints?api-version=2018-01-01",
+			Document: `{
+  "name": "AirGappedCloud",
+  "portal": "https://portal.airgapped.example/",
+  "gallery": "https://gallery.airgapped.example/",
+  "graph": "https://graph.airgapped.example/",
+  "authentication": {
+    "loginEndpoint": "https://login.airgapped.example/",
+    "audiences": ["https://management.core.airgapped.example/"]
+  },
+  "suffixes": {
+    "acrLoginServer": "azurecr.airgapped.example",
+    "keyVaultDns": "vault.airgapped.example",
+    "sqlServerHostname": "database.airgapped.example",
+    "storage": "core.airgapped.example"
+  }
+}`,
+			StatusCode: http.StatusOK,
+			Expected: azure.Environment{
+				Name:                       "AirGappedCloud",
+				ResourceManagerEndpoint:    "https://management.local.azurestack.external/",
+				ManagementPortalURL:        "https://portal.airgapped.example/",
+				KeyVaultEndpoint:           "https://vault.airgapped.example/",
+				ActiveDirectoryEndpoint:    "https://login.airgapped.example/",
+				GraphEndpoint:              "https://graph.airgapped.example/",
+				GalleryEndpoint:            "https://gallery.airgapped.example/",
+				KeyVaultDNSSuffix:          "vault.airgapped.example",
+				StorageEndpointSuffix:      "core.airgapped.example",
+				SQLDatabaseDNSSuffix:       "database.airgapped.example",
+				ContainerRegistryDNSSuffix: "azurecr.airgapped.example",
+				TokenAudience:              "https://management.core.airgapped.example/",
+			},
+		},
+		{
+			Description: "Missing Graph Endpoint",
+			Path:        "/",
+			Document: `{
+  "authentication": {
+    "loginEndpoint": "https://login.windows.net/",
+    "audiences": ["https://management.azurestack.onmicrosoft.com/"]
+  }
+}`,
+			StatusCode:  http.StatusOK,
+			ExpectError: true,
+		},
+		{
+			Description: "Invalid Document",
+			Path:        "/",
+			Document:    `<html></html>`,
+			StatusCode:  http.StatusOK,
+			ExpectError: true,
+		},
+		{
+			Description: "Not Found",
+			Path:        "/",
+			Document:    `{}`,
+			StatusCode:  http.StatusNotFound,
+			ExpectError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Description, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/metadata/endpoints" {
+					t.Fatalf("Expected a request to `/metadata/endpoints` but got %q", r.URL.Path)
+				}
+				if r.URL.Query().Get("api-version") == "" {
+					t.Fatalf("Expected an `api-version` to be specified")
+				}
+
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tc.StatusCode)
+				w.Write([]byte(tc.Document))
+			}))
+			defer server.Close()
+
+			// route requests for the Azure Stack host name to the test server, so that the DNS Suffixes can be derived from it
+			serverUrl, _ := url.Parse(server.URL)
+			sender := autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+				r.URL.Scheme = serverUrl.Scheme
+				r.URL.Host = serverUrl.Host
+				return server.Client().Do(r)
+			})
+
+			env, err := EnvironmentFromMetadataURL("https://management.local.azurestack.external"+tc.Path, sender)
+			if tc.ExpectError {
+				if err == nil {
+					t.Fatalf("Expected an error but didn't get one")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Expected no error but got: %+v", err)
+			}
+
+			if !reflect.DeepEqual(*env, tc.Expected) {
+				t.Fatalf("Expected %+v but got %+v", tc.Expected, *env)
+			}
+		})
+	}
+}
+
+func TestAzureEnvironmentFromFile(t *testing.T) {
+	testCases := []struct {
+		Description string
+		Contents    string
+		ExpectError bool
+	}{
+		{
+			Description: "Valid",
+			Contents: `{
+  "name": "AirGappedCloud",
+  "resourceManagerEndpoint": "https://management.airgapped.example/",
+  "activeDirectoryEndpoint": "https://login.airgapped.example/",
+  "graphEndpoint": "https://graph.airgapped.example/",
+  "keyVaultDNSSuffix": "vault.airgapped.example",
+  "storageEndpointSuffix": "core.airgapped.example"
+}`,
+			ExpectError: false,
+		},
+		{
+			Description: "Missing Storage Suffix",
+			Contents: `{
+  "name": "AirGappedCloud",
+  "resourceManagerEndpoint": "https://management.airgapped.example/",
+  "activeDirectoryEndpoint": "https://login.airgapped.example/",
+  "graphEndpoint": "https://graph.airgapped.example/",
+  "keyVaultDNSSuffix": "vault.airgapped.example"
+}`,
+			ExpectError: true,
+		},
+		{
+			Description: "Invalid JSON",
+			Contents:    `resourceManagerEndpoint = "https://management.airgapped.example/"`,
+			ExpectError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Description, func(t *testing.T) {
+			file, err := ioutil.TempFile("", "environment")
+			if err != nil {
+				t.Fatalf("Error creating temporary file: %+v", err)
+			}
+			defer os.Remove(file.Name())
+
+			if _, err := file.WriteString(tc.Contents); err != nil {
+				t.Fatalf("Error writing temporary file: %+v", err)
+			}
+			file.Close()
+
+			env, err := EnvironmentFromFile(file.Name())
+			if tc.ExpectError {
+				if err == nil {
+					t.Fatalf("Expected an error but didn't get one")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Expected no error but got: %+v", err)
+			}
+
+			if env.StorageEndpointSuffix != "core.airgapped.example" {
+				t.Fatalf("Expected the Storage Endpoint Suffix to be %q but got %q", "core.airgapped.example", env.StorageEndpointSuffix)
+			}
+		})
+	}
+
+	if _, err := EnvironmentFromFile("/does/not/exist.json"); err == nil {
+		t.Fatalf("Expected an error for a file which doesn't exist but didn't get one")
+	}
+}
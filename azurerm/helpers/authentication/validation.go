@@ -25,6 +25,10 @@ func (c *Config) ValidateBearerAuth() error {
 		err = multierror.Append(err, fmt.Errorf("Tenant ID was not found in your Azure CLI Credentials.\n\nPlease login to the Azure CLI again via `az login`"))
 	}
 
+	if len(c.AuxiliaryTenantIDs) > 0 {
+		err = multierror.Append(err, fmt.Errorf("Auxiliary Tenant IDs can only be configured when authenticating using a Service Principal"))
+	}
+
 	return err.ErrorOrNil()
 }
 
@@ -50,6 +54,31 @@ func (c *Config) ValidateServicePrincipal() error {
 	return err.ErrorOrNil()
 }
 
+func (c *Config) ValidateServicePrincipalClientCertificate() error {
+	var err *multierror.Error
+
+	if c.SubscriptionID == "" {
+		err = multierror.Append(err, fmt.Errorf("Subscription ID must be configured for the AzureRM provider"))
+	}
+	if c.ClientID == "" {
+		err = multierror.Append(err, fmt.Errorf("Client ID must be configured for the AzureRM provider"))
+	}
+	if c.ClientCertPath == "" {
+		err = multierror.Append(err, fmt.Errorf("Client Certificate Path must be configured for the AzureRM provider"))
+	}
+	if c.ClientSecret != "" {
+		err = multierror.Append(err, fmt.Errorf("Only one of Client Secret or Client Certificate Path can be configured for the AzureRM provider"))
+	}
+	if c.TenantID == "" {
+		err = multierror.Append(err, fmt.Errorf("Tenant ID must be configured for the AzureRM provider"))
+	}
+	if c.Environment == "" {
+		err = multierror.Append(err, fmt.Errorf("Environment must be configured for the AzureRM provider"))
+	}
+
+	return err.ErrorOrNil()
+}
+
 func (c *Config) ValidateMsi() error {
 	var err *multierror.Error
 
@@ -65,6 +94,9 @@ func (c *Config) ValidateMsi() error {
 	if c.MsiEndpoint == "" {
 		err = multierror.Append(err, fmt.Errorf("MSI endpoint must be configured for the AzureRM provider"))
 	}
+	if len(c.AuxiliaryTenantIDs) > 0 {
+		err = multierror.Append(err, fmt.Errorf("Auxiliary Tenant IDs can only be configured when authenticating using a Service Principal"))
+	}
 
 	return err.ErrorOrNil()
 }
@@ -63,6 +63,17 @@ func TestAzureValidateBearerAuth(t *testing.T) {
 			},
 			ExpectError: false,
 		},
+		{
+			Description: "Auxiliary Tenant IDs",
+			Config: Config{
+				AccessToken:        &adal.Token{},
+				ClientID:           "62e73395-5017-43b6-8ebf-d6c30a514cf1",
+				SubscriptionID:     "8e8b5e02-5c13-4822-b7dc-4232afb7e8c2",
+				TenantID:           "9834f8d0-24b3-41b7-8b8d-c611c461a129",
+				AuxiliaryTenantIDs: []string{"a1f4c9b2-7f0e-4d5a-9b7e-2c3d4e5f6a7b"},
+			},
+			ExpectError: true,
+		},
 	}
 
 	for _, v := range cases {
@@ -165,6 +176,117 @@ func TestAzureValidateServicePrincipal(t *testing.T) {
 	}
 }
 
+func TestAzureValidateServicePrincipalClientCertificate(t *testing.T) {
+	cases := []struct {
+		Description string
+		Config      Config
+		ExpectError bool
+	}{
+		{
+			Description: "Empty Configuration",
+			Config:      Config{},
+			ExpectError: true,
+		},
+		{
+			Description: "Missing Client ID",
+			Config: Config{
+				SubscriptionID: "8e8b5e02-5c13-4822-b7dc-4232afb7e8c2",
+				ClientCertPath: "/tmp/certificate.pfx",
+				TenantID:       "9834f8d0-24b3-41b7-8b8d-c611c461a129",
+				Environment:    "public",
+			},
+			ExpectError: true,
+		},
+		{
+			Description: "Missing Subscription ID",
+			Config: Config{
+				ClientID:       "62e73395-5017-43b6-8ebf-d6c30a514cf1",
+				ClientCertPath: "/tmp/certificate.pfx",
+				TenantID:       "9834f8d0-24b3-41b7-8b8d-c611c461a129",
+				Environment:    "public",
+			},
+			ExpectError: true,
+		},
+		{
+			Description: "Missing Client Certificate Path",
+			Config: Config{
+				ClientID:       "62e73395-5017-43b6-8ebf-d6c30a514cf1",
+				SubscriptionID: "8e8b5e02-5c13-4822-b7dc-4232afb7e8c2",
+				TenantID:       "9834f8d0-24b3-41b7-8b8d-c611c461a129",
+				Environment:    "public",
+			},
+			ExpectError: true,
+		},
+		{
+			Description: "Client Secret and Client Certificate Path",
+			Config: Config{
+				ClientID:       "62e73395-5017-43b6-8ebf-d6c30a514cf1",
+				SubscriptionID: "8e8b5e02-5c13-4822-b7dc-4232afb7e8c2",
+				ClientCertPath: "/tmp/certificate.pfx",
+				ClientSecret:   "Does Hammer Time have Daylight Savings Time?",
+				TenantID:       "9834f8d0-24b3-41b7-8b8d-c611c461a129",
+				Environment:    "public",
+			},
+			ExpectError: true,
+		},
+		{
+			Description: "Missing Tenant ID",
+			Config: Config{
+				ClientID:       "62e73395-5017-43b6-8ebf-d6c30a514cf1",
+				SubscriptionID: "8e8b5e02-5c13-4822-b7dc-4232afb7e8c2",
+				ClientCertPath: "/tmp/certificate.pfx",
+				Environment:    "public",
+			},
+			ExpectError: true,
+		},
+		{
+			Description: "Missing Environment",
+			Config: Config{
+				ClientID:       "62e73395-5017-43b6-8ebf-d6c30a514cf1",
+				SubscriptionID: "8e8b5e02-5c13-4822-b7dc-4232afb7e8c2",
+				ClientCertPath: "/tmp/certificate.pfx",
+				TenantID:       "9834f8d0-24b3-41b7-8b8d-c611c461a129",
+			},
+			ExpectError: true,
+		},
+		{
+			Description: "Valid Configuration (without a password)",
+			Config: Config{
+				ClientID:       "62e73395-5017-43b6-8ebf-d6c30a514cf1",
+				SubscriptionID: "8e8b5e02-5c13-4822-b7dc-4232afb7e8c2",
+				ClientCertPath: "/tmp/certificate.pfx",
+				TenantID:       "9834f8d0-24b3-41b7-8b8d-c611c461a129",
+				Environment:    "public",
+			},
+			ExpectError: false,
+		},
+		{
+			Description: "Valid Configuration (with a password)",
+			Config: Config{
+				ClientID:           "62e73395-5017-43b6-8ebf-d6c30a514cf1",
+				SubscriptionID:     "8e8b5e02-5c13-4822-b7dc-4232afb7e8c2",
+				ClientCertPath:     "/tmp/certificate.pfx",
+				ClientCertPassword: "Password1234!",
+				TenantID:           "9834f8d0-24b3-41b7-8b8d-c611c461a129",
+				Environment:        "public",
+			},
+			ExpectError: false,
+		},
+	}
+
+	for _, v := range cases {
+		err := v.Config.ValidateServicePrincipalClientCertificate()
+
+		if v.ExpectError && err == nil {
+			t.Fatalf("Expected an error for %q: didn't get one", v.Description)
+		}
+
+		if !v.ExpectError && err != nil {
+			t.Fatalf("Expected there to be no error for %q - but got: %v", v.Description, err)
+		}
+	}
+}
+
 func TestAzureValidateMsi(t *testing.T) {
 	cases := []struct {
 		Description string
@@ -222,6 +344,17 @@ func TestAzureValidateMsi(t *testing.T) {
 			},
 			ExpectError: false,
 		},
+		{
+			Description: "Auxiliary Tenant IDs",
+			Config: Config{
+				MsiEndpoint:        "http://localhost:50342/oauth2/token",
+				SubscriptionID:     "8e8b5e02-5c13-4822-b7dc-4232afb7e8c2",
+				TenantID:           "9834f8d0-24b3-41b7-8b8d-c611c461a129",
+				Environment:        "public",
+				AuxiliaryTenantIDs: []string{"a1f4c9b2-7f0e-4d5a-9b7e-2c3d4e5f6a7b"},
+			},
+			ExpectError: true,
+		},
 	}
 
 	for _, v := range cases {
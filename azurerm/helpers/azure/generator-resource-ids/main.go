@@ -0,0 +1,377 @@
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"log"
+	"strings"
+	"unicode"
+)
+
+// resourceIDs are the formats of the Resource IDs which a typed ID is generated for - where each segment
+// in braces becomes a field on the typed ID (and must be unique within the format). Resources which
+// are scoped to another resource (e.g. Locks or Role Assignments) or which aren't identified by an
+// Azure Resource Manager ID (e.g. Storage Blobs or Key Vault Secrets) aren't included.
+var resourceIDs = []resourceID{
+	// App Service
+	{Name: "AppService", Format: "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Web/sites/{name}"},
+	{Name: "AppServiceCustomHostnameBinding", Format: "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Web/sites/{site}/hostNameBindings/{name}"},
+	{Name: "AppServicePlan", Format: "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Web/serverfarms/{name}"},
+	{Name: "AppServiceSlot", Format: "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Web/sites/{site}/slots/{name}"},
+
+	// Automation
+	{Name: "AutomationAccount", Format: "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Automation/automationAccounts/{name}"},
+	{Name: "AutomationCredential", Format: "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Automation/automationAccounts/{automationAccount}/credentials/{name}"},
+	{Name: "AutomationRunbook", Format: "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Automation/automationAccounts/{automationAccount}/runbooks/{name}"},
+	{Name: "AutomationSchedule", Format: "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Automation/automationAccounts/{automationAccount}/schedules/{name}"},
+
+	// CDN
+	{Name: "CdnEndpoint", Description: "CDN Endpoint", Format: "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Cdn/profiles/{profile}/endpoints/{name}"},
+	{Name: "CdnProfile", Description: "CDN Profile", Format: "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Cdn/profiles/{name}"},
+
+	// Compute
+	{Name: "AvailabilitySet", Format: "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Compute/availabilitySets/{name}"},
+	{Name: "Image", Format: "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Compute/images/{name}"},
+	{Name: "ManagedDisk", Format: "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Compute/disks/{name}"},
+	{Name: "SharedImage", Format: "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Compute/galleries/{gallery}/images/{name}"},
+	{Name: "SharedImageGallery", Format: "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Compute/galleries/{name}"},
+	{Name: "SharedImageVersion", Format: "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Compute/galleries/{gallery}/images/{image}/versions/{name}"},
+	{Name: "Snapshot", Format: "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Compute/snapshots/{name}"},
+	{Name: "VirtualMachine", Format: "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Compute/virtualMachines/{name}"},
+	{Name: "VirtualMachineDataDiskAttachment", Format: "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Compute/virtualMachines/{virtualMachine}/dataDisks/{name}"},
+	{Name: "VirtualMachineExtension", Format: "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Compute/virtualMachines/{virtualMachine}/extensions/{name}"},
+	{Name: "VirtualMachineRunCommand", Format: "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Compute/virtualMachines/{virtualMachine}/runCommands/{name}"},
+	{Name: "VirtualMachineScaleSet", Format: "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Compute/virtualMachineScaleSets/{name}"},
+	{Name: "VirtualMachineScaleSetInstance", Format: "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Compute/virtualMachineScaleSets/{virtualMachineScaleSet}/virtualMachines/{name}"},
+	{Name: "VirtualMachineScaleSetExtension", Format: "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Compute/virtualMachineScaleSets/{virtualMachineScaleSet}/extensions/{name}"},
+
+	// Containers
+	{Name: "ContainerGroup", Format: "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.ContainerInstance/containerGroups/{name}"},
+	{Name: "ContainerRegistry", Format: "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.ContainerRegistry/registries/{name}"},
+	{Name: "ContainerService", Format: "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.ContainerService/containerServices/{name}"},
+	{Name: "KubernetesCluster", Format: "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.ContainerService/managedClusters/{name}"},
+
+	// CosmosDB
+	{Name: "CosmosDBAccount", Description: "CosmosDB Account", Format: "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.DocumentDB/databaseAccounts/{name}"},
+
+	// Data Lake
+	{Name: "DataLakeAnalyticsAccount", Format: "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.DataLakeAnalytics/accounts/{name}"},
+	{Name: "DataLakeAnalyticsFirewallRule", Format: "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.DataLakeAnalytics/accounts/{account}/firewallRules/{name}"},
+	{Name: "DataLakeStore", Format: "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.DataLakeStore/accounts/{name}"},
+	{Name: "DataLakeStoreFirewallRule", Format: "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.DataLakeStore/accounts/{account}/firewallRules/{name}"},
+
+	// DevTest Labs
+	{Name: "DevTestLab", Description: "DevTest Lab", Format: "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.DevTestLab/labs/{name}"},
+	{Name: "DevTestVirtualNetwork", Description: "DevTest Virtual Network", Format: "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.DevTestLab/labs/{lab}/virtualnetworks/{name}"},
+
+	// DNS
+	{Name: "DnsZone", Description: "DNS Zone", Format: "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/dnszones/{name}"},
+	{Name: "DnsARecord", Description: "DNS A Record", Format: "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/dnszones/{dnsZone}/A/{name}"},
+	{Name: "DnsAAAARecord", Description: "DNS AAAA Record", Format: "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/dnszones/{dnsZone}/AAAA/{name}"},
+	{Name: "DnsCAARecord", Description: "DNS CAA Record", Format: "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/dnszones/{dnsZone}/CAA/{name}"},
+	{Name: "DnsCNameRecord", Description: "DNS CNAME Record", Format: "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/dnszones/{dnsZone}/CNAME/{name}"},
+	{Name: "DnsMxRecord", Description: "DNS MX Record", Format: "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/dnszones/{dnsZone}/MX/{name}"},
+	{Name: "DnsNsRecord", Description: "DNS NS Record", Format: "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/dnszones/{dnsZone}/NS/{name}"},
+	{Name: "DnsPtrRecord", Description: "DNS PTR Record", Format: "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/dnszones/{dnsZone}/PTR/{name}"},
+	{Name: "DnsSrvRecord", Description: "DNS SRV Record", Format: "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/dnszones/{dnsZone}/SRV/{name}"},
+	{Name: "DnsTxtRecord", Description: "DNS TXT Record", Format: "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/dnszones/{dnsZone}/TXT/{name}"},
+
+	// EventGrid
+	{Name: "EventGridTopic", Description: "EventGrid Topic", Format: "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.EventGrid/topics/{name}"},
+
+	// EventHub
+	{Name: "EventHub", Description: "EventHub", Format: "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.EventHub/namespaces/{namespace}/eventhubs/{name}"},
+	{Name: "EventHubAuthorizationRule", Description: "EventHub Authorization Rule", Format: "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.EventHub/namespaces/{namespace}/eventhubs/{eventHub}/authorizationRules/{name}"},
+	{Name: "EventHubConsumerGroup", Description: "EventHub Consumer Group", Format: "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.EventHub/namespaces/{namespace}/eventhubs/{eventHub}/consumergroups/{name}"},
+	{Name: "EventHubNamespace", Description: "EventHub Namespace", Format: "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.EventHub/namespaces/{name}"},
+	{Name: "EventHubNamespaceAuthorizationRule", Description: "EventHub Namespace Authorization Rule", Format: "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.EventHub/namespaces/{namespace}/authorizationRules/{name}"},
+
+	// IoT Hub
+	{Name: "IotHub", Description: "IoT Hub", Format: "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Devices/IotHubs/{name}"},
+
+	// Key Vault
+	{Name: "KeyVault", Format: "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.KeyVault/vaults/{name}"},
+
+	// Log Analytics
+	{Name: "LogAnalyticsSolution", Format: "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.OperationsManagement/solutions/{name}"},
+	{Name: "LogAnalyticsWorkspace", Format: "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.OperationalInsights/workspaces/{name}"},
+
+	// Logic Apps
+	{Name: "LogicAppAction", Format: "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Logic/workflows/{workflow}/actions/{name}"},
+	{Name: "LogicAppTrigger", Format: "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Logic/workflows/{workflow}/triggers/{name}"},
+	{Name: "LogicAppWorkflow", Format: "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Logic/workflows/{name}"},
+
+	// Managed Identity
+	{Name: "UserAssignedIdentity", Format: "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.ManagedIdentity/userAssignedIdentities/{name}"},
+
+	// Monitor
+	{Name: "ApplicationInsights", Format: "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Insights/components/{name}"},
+	{Name: "AutoscaleSetting", Format: "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Insights/autoscalesettings/{name}"},
+	{Name: "MetricAlertRule", Format: "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Insights/alertrules/{name}"},
+	{Name: "MonitorActionGroup", Format: "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Insights/actionGroups/{name}"},
+
+	// MySQL
+	{Name: "MySqlConfiguration", Description: "MySQL Configuration", Format: "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.DBforMySQL/servers/{server}/configurations/{name}"},
+	{Name: "MySqlDatabase", Description: "MySQL Database", Format: "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.DBforMySQL/servers/{server}/databases/{name}"},
+	{Name: "MySqlFirewallRule", Description: "MySQL Firewall Rule", Format: "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.DBforMySQL/servers/{server}/firewallRules/{name}"},
+	{Name: "MySqlServer", Description: "MySQL Server", Format: "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.DBforMySQL/servers/{name}"},
+	{Name: "MySqlVirtualNetworkRule", Description: "MySQL Virtual Network Rule", Format: "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.DBforMySQL/servers/{server}/virtualNetworkRules/{name}"},
+
+	// Network
+	{Name: "ApplicationGateway", Format: "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/applicationGateways/{name}"},
+	{Name: "ApplicationSecurityGroup", Format: "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/applicationSecurityGroups/{name}"},
+	{Name: "DdosProtectionPlan", Description: "DDoS Protection Plan", Format: "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/ddosProtectionPlans/{name}"},
+	{Name: "ExpressRouteCircuit", Format: "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/expressRouteCircuits/{name}"},
+	{Name: "ExpressRouteCircuitAuthorization", Format: "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/expressRouteCircuits/{expressRouteCircuit}/authorizations/{name}"},
+	{Name: "ExpressRouteCircuitPeering", Format: "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/expressRouteCircuits/{expressRouteCircuit}/peerings/{name}"},
+	{Name: "Firewall", Format: "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/azureFirewalls/{name}"},
+	{Name: "FirewallApplicationRuleCollection", Format: "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/azureFirewalls/{firewall}/applicationRuleCollections/{name}"},
+	{Name: "FirewallNetworkRuleCollection", Format: "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/azureFirewalls/{firewall}/networkRuleCollections/{name}"},
+	{Name: "LoadBalancer", Format: "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/loadBalancers/{name}"},
+	{Name: "LoadBalancerBackendAddressPool", Format: "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/loadBalancers/{loadBalancer}/backendAddressPools/{name}"},
+	{Name: "LoadBalancerFrontendIPConfiguration", Description: "Load Balancer Frontend IP Configuration", Format: "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/loadBalancers/{loadBalancer}/frontendIPConfigurations/{name}"},
+	{Name: "LoadBalancerNatPool", Format: "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/loadBalancers/{loadBalancer}/inboundNatPools/{name}"},
+	{Name: "LoadBalancerNatRule", Format: "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/loadBalancers/{loadBalancer}/inboundNatRules/{name}"},
+	{Name: "LoadBalancerProbe", Format: "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/loadBalancers/{loadBalancer}/probes/{name}"},
+	{Name: "LoadBalancerRule", Format: "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/loadBalancers/{loadBalancer}/loadBalancingRules/{name}"},
+	{Name: "LocalNetworkGateway", Format: "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/localNetworkGateways/{name}"},
+	{Name: "NetworkInterface", Format: "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/networkInterfaces/{name}"},
+	{Name: "NetworkSecurityGroup", Format: "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/networkSecurityGroups/{name}"},
+	{Name: "NetworkSecurityRule", Format: "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/networkSecurityGroups/{networkSecurityGroup}/securityRules/{name}"},
+	{Name: "NetworkWatcher", Format: "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/networkWatchers/{name}"},
+	{Name: "PacketCapture", Format: "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/networkWatchers/{networkWatcher}/packetCaptures/{name}"},
+	{Name: "PublicIPAddress", Description: "Public IP Address", Format: "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/publicIPAddresses/{name}"},
+	{Name: "Route", Format: "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/routeTables/{routeTable}/routes/{name}"},
+	{Name: "RouteTable", Format: "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/routeTables/{name}"},
+	{Name: "Subnet", Format: "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/virtualNetworks/{virtualNetwork}/subnets/{name}"},
+	{Name: "TrafficManagerProfile", Format: "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/trafficManagerProfiles/{name}"},
+	{Name: "VirtualHub", Format: "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/virtualHubs/{name}"},
+	{Name: "VirtualHubConnection", Format: "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/virtualHubs/{virtualHub}/hubVirtualNetworkConnections/{name}"},
+	{Name: "VirtualNetwork", Format: "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/virtualNetworks/{name}"},
+	{Name: "VirtualNetworkGateway", Format: "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/virtualNetworkGateways/{name}"},
+	{Name: "VirtualNetworkGatewayConnection", Format: "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/connections/{name}"},
+	{Name: "VirtualNetworkPeering", Format: "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/virtualNetworks/{virtualNetwork}/virtualNetworkPeerings/{name}"},
+	{Name: "VirtualWan", Description: "Virtual WAN", Format: "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/virtualWans/{name}"},
+	{Name: "VpnGateway", Description: "VPN Gateway", Format: "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/vpnGateways/{name}"},
+	{Name: "VpnSite", Description: "VPN Site", Format: "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/vpnSites/{name}"},
+
+	// Notification Hubs
+	{Name: "NotificationHub", Format: "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.NotificationHubs/namespaces/{namespace}/notificationHubs/{name}"},
+	{Name: "NotificationHubAuthorizationRule", Format: "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.NotificationHubs/namespaces/{namespace}/notificationHubs/{notificationHub}/AuthorizationRules/{name}"},
+	{Name: "NotificationHubNamespace", Format: "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.NotificationHubs/namespaces/{name}"},
+
+	// Policy
+	{Name: "PolicyDefinition", Format: "/subscriptions/{subscription}/providers/Microsoft.Authorization/policyDefinitions/{name}"},
+
+	// PostgreSQL
+	{Name: "PostgreSqlConfiguration", Description: "PostgreSQL Configuration", Format: "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.DBforPostgreSQL/servers/{server}/configurations/{name}"},
+	{Name: "PostgreSqlDatabase", Description: "PostgreSQL Database", Format: "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.DBforPostgreSQL/servers/{server}/databases/{name}"},
+	{Name: "PostgreSqlFirewallRule", Description: "PostgreSQL Firewall Rule", Format: "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.DBforPostgreSQL/servers/{server}/firewallRules/{name}"},
+	{Name: "PostgreSqlServer", Description: "PostgreSQL Server", Format: "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.DBforPostgreSQL/servers/{name}"},
+	{Name: "PostgreSqlVirtualNetworkRule", Description: "PostgreSQL Virtual Network Rule", Format: "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.DBforPostgreSQL/servers/{server}/virtualNetworkRules/{name}"},
+
+	// Recovery Services
+	{Name: "RecoveryServicesVault", Format: "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.RecoveryServices/vaults/{name}"},
+
+	// Redis
+	{Name: "RedisCache", Format: "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Cache/Redis/{name}"},
+	{Name: "RedisFirewallRule", Format: "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Cache/Redis/{cache}/firewallRules/{name}"},
+
+	// Relay
+	{Name: "RelayNamespace", Format: "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Relay/namespaces/{name}"},
+
+	// Resources
+	{Name: "ResourceGroup", Format: "/subscriptions/{subscription}/resourceGroups/{name}"},
+	{Name: "TemplateDeployment", Format: "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Resources/deployments/{name}"},
+
+	// Scheduler
+	{Name: "SchedulerJob", Format: "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Scheduler/jobCollections/{jobCollection}/jobs/{name}"},
+	{Name: "SchedulerJobCollection", Format: "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Scheduler/jobCollections/{name}"},
+
+	// Search
+	{Name: "SearchService", Format: "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Search/searchServices/{name}"},
+
+	// Service Bus
+	{Name: "ServiceBusNamespace", Description: "ServiceBus Namespace", Format: "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.ServiceBus/namespaces/{name}"},
+	{Name: "ServiceBusNamespaceAuthorizationRule", Description: "ServiceBus Namespace Authorization Rule", Format: "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.ServiceBus/namespaces/{namespace}/AuthorizationRules/{name}"},
+	{Name: "ServiceBusQueue", Description: "ServiceBus Queue", Format: "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.ServiceBus/namespaces/{namespace}/queues/{name}"},
+	{Name: "ServiceBusQueueAuthorizationRule", Description: "ServiceBus Queue Authorization Rule", Format: "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.ServiceBus/namespaces/{namespace}/queues/{queue}/authorizationRules/{name}"},
+	{Name: "ServiceBusSubscription", Description: "ServiceBus Subscription", Format: "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.ServiceBus/namespaces/{namespace}/topics/{topic}/subscriptions/{name}"},
+	{Name: "ServiceBusSubscriptionRule", Description: "ServiceBus Subscription Rule", Format: "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.ServiceBus/namespaces/{namespace}/topics/{topic}/subscriptions/{topicSubscription}/rules/{name}"},
+	{Name: "ServiceBusTopic", Description: "ServiceBus Topic", Format: "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.ServiceBus/namespaces/{namespace}/topics/{name}"},
+	{Name: "ServiceBusTopicAuthorizationRule", Description: "ServiceBus Topic Authorization Rule", Format: "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.ServiceBus/namespaces/{namespace}/topics/{topic}/authorizationRules/{name}"},
+
+	// Service Fabric
+	{Name: "ServiceFabricCluster", Format: "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.ServiceFabric/clusters/{name}"},
+
+	// SQL
+	{Name: "SqlActiveDirectoryAdministrator", Description: "SQL Active Directory Administrator", Format: "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Sql/servers/{server}/administrators/{name}"},
+	{Name: "SqlDatabase", Description: "SQL Database", Format: "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Sql/servers/{server}/databases/{name}"},
+	{Name: "SqlElasticPool", Description: "SQL Elastic Pool", Format: "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Sql/servers/{server}/elasticPools/{name}"},
+	{Name: "SqlFirewallRule", Description: "SQL Firewall Rule", Format: "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Sql/servers/{server}/firewallRules/{name}"},
+	{Name: "SqlServer", Description: "SQL Server", Format: "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Sql/servers/{name}"},
+	{Name: "SqlVirtualNetworkRule", Description: "SQL Virtual Network Rule", Format: "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Sql/servers/{server}/virtualNetworkRules/{name}"},
+
+	// Storage
+	{Name: "StorageAccount", Format: "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Storage/storageAccounts/{name}"},
+}
+
+type resourceID struct {
+	// Name is the name of the resource, which is used as the prefix for the generated types and functions
+	Name string
+
+	// Description is the readable name of the resource, which defaults to the Name split into words
+	Description string
+
+	// Format is the format of the Resource ID, with the user-specified segments in braces
+	Format string
+}
+
+type resourceIDField struct {
+	Name     string
+	Variable string
+}
+
+// description returns a readable name for the resource, which unless specified is derived from the Name e.g. `VirtualNetworkPeering` -> `Virtual Network Peering`
+func (r resourceID) description() string {
+	if r.Description != "" {
+		return r.Description
+	}
+
+	runes := []rune(r.Name)
+	var buf bytes.Buffer
+	for i, c := range runes {
+		if i > 0 && unicode.IsUpper(c) && (unicode.IsLower(runes[i-1]) || (i+1 < len(runes) && unicode.IsLower(runes[i+1]))) {
+			buf.WriteRune(' ')
+		}
+		buf.WriteRune(c)
+	}
+	return buf.String()
+}
+
+// withArticle prefixes the value with the indefinite article, e.g. `an Image` or `a Subnet`
+func withArticle(value string) string {
+	if strings.ContainsRune("AEIO", rune(value[0])) {
+		return fmt.Sprintf("an %s", value)
+	}
+	return fmt.Sprintf("a %s", value)
+}
+
+func (r resourceID) fields() ([]resourceIDField, error) {
+	fields := make([]resourceIDField, 0)
+	seen := make(map[string]struct{})
+	for _, segment := range strings.Split(strings.Trim(r.Format, "/"), "/") {
+		if !strings.HasPrefix(segment, "{") || !strings.HasSuffix(segment, "}") {
+			continue
+		}
+
+		variable := strings.TrimSuffix(strings.TrimPrefix(segment, "{"), "}")
+		if _, exists := seen[variable]; exists {
+			return nil, fmt.Errorf("the segment %q is defined multiple times in %q", variable, r.Format)
+		}
+		seen[variable] = struct{}{}
+
+		fields = append(fields, resourceIDField{
+			Name:     strings.ToUpper(variable[:1]) + variable[1:],
+			Variable: variable,
+		})
+	}
+
+	if len(fields) == 0 {
+		return nil, fmt.Errorf("%q has no user-specified segments", r.Format)
+	}
+
+	return fields, nil
+}
+
+func (r resourceID) generate() (string, error) {
+	fields, err := r.fields()
+	if err != nil {
+		return "", fmt.Errorf("Error generating %s: %+v", r.Name, err)
+	}
+
+	typeName := fmt.Sprintf("%sID", r.Name)
+	formatName := fmt.Sprintf("%s%sIDFormat", strings.ToLower(r.Name[:1]), r.Name[1:])
+	description := r.description()
+
+	arguments := make([]string, 0)
+	assignments := make([]string, 0)
+	values := make([]string, 0)
+	structFields := make([]string, 0)
+	for i, field := range fields {
+		arguments = append(arguments, field.Variable)
+		assignments = append(assignments, fmt.Sprintf("%s: segments[%d],", field.Name, i))
+		values = append(values, fmt.Sprintf("id.%s", field.Name))
+		structFields = append(structFields, fmt.Sprintf("%s string", field.Name))
+	}
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "const %s = %q\n\n", formatName, r.Format)
+
+	fmt.Fprintf(&buf, "// %s is the ID of %s in the format `%s`\n", typeName, withArticle(description), r.Format)
+	fmt.Fprintf(&buf, "type %s struct {\n%s\n}\n\n", typeName, strings.Join(structFields, "\n"))
+
+	fmt.Fprintf(&buf, "// New%s returns %s for the specified segments\n", typeName, withArticle(typeName))
+	fmt.Fprintf(&buf, "func New%s(%s string) %s {\n", typeName, strings.Join(arguments, ", "), typeName)
+	fmt.Fprintf(&buf, "return %s{\n", typeName)
+	for _, field := range fields {
+		fmt.Fprintf(&buf, "%s: %s,\n", field.Name, field.Variable)
+	}
+	fmt.Fprintf(&buf, "}\n}\n\n")
+
+	fmt.Fprintf(&buf, "// Parse%s parses the specified Resource ID as %s\n", typeName, withArticle(typeName))
+	fmt.Fprintf(&buf, "func Parse%s(input string) (*%s, error) {\n", typeName, typeName)
+	fmt.Fprintf(&buf, "segments, err := parseResourceIDFormat(%s, input)\n", formatName)
+	fmt.Fprintf(&buf, "if err != nil {\nreturn nil, fmt.Errorf(\"Error parsing %%q as %s ID: %%+v\", input, err)\n}\n\n", withArticle(description))
+	fmt.Fprintf(&buf, "return &%s{\n%s\n}, nil\n}\n\n", typeName, strings.Join(assignments, "\n"))
+
+	fmt.Fprintf(&buf, "// String returns the %s ID in the canonical casing\n", description)
+	fmt.Fprintf(&buf, "func (id %s) String() string {\n", typeName)
+	fmt.Fprintf(&buf, "return formatResourceID(%s, %s)\n}\n\n", formatName, strings.Join(values, ", "))
+
+	fmt.Fprintf(&buf, "// Validate%s is a SchemaValidateFunc which validates that the value is %s ID\n", typeName, withArticle(description))
+	fmt.Fprintf(&buf, "func Validate%s(i interface{}, k string) ([]string, []error) {\n", typeName)
+	fmt.Fprintf(&buf, "return validateResourceIDFormat(i, k, func(input string) error {\n_, err := Parse%s(input)\nreturn err\n})\n}\n\n", typeName)
+
+	fmt.Fprintf(&buf, "// Import%s is a StateFunc which validates that the ID being imported is %s ID\n", typeName, withArticle(description))
+	fmt.Fprintf(&buf, "func Import%s(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {\n", typeName)
+	fmt.Fprintf(&buf, "return importResourceIDFormat(d, func(input string) error {\n_, err := Parse%s(input)\nreturn err\n})\n}\n\n", typeName)
+
+	return buf.String(), nil
+}
+
+func main() {
+	output := flag.String("output", "resource_ids_gen.go", "the file the typed Resource IDs should be written to")
+	flag.Parse()
+
+	var buf bytes.Buffer
+	buf.WriteString("// Code generated by generator-resource-ids; DO NOT EDIT.\n\n")
+	buf.WriteString("package azure\n\n")
+	buf.WriteString("import (\n\"fmt\"\n\n\"github.com/hashicorp/terraform/helper/schema\"\n)\n\n")
+
+	names := make(map[string]struct{})
+	for _, r := range resourceIDs {
+		if _, exists := names[r.Name]; exists {
+			log.Fatalf("The Resource ID %q is defined multiple times", r.Name)
+		}
+		names[r.Name] = struct{}{}
+
+		code, err := r.generate()
+		if err != nil {
+			log.Fatal(err)
+		}
+		buf.WriteString(code)
+	}
+
+	formatted, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatalf("Error formatting the generated code: %+v", err)
+	}
+
+	if err := ioutil.WriteFile(*output, formatted, 0644); err != nil {
+		log.Fatalf("Error writing %q: %+v", *output, err)
+	}
+}
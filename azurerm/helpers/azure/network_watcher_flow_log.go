@@ -0,0 +1,62 @@
+package azure
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+const networkWatcherFlowLogIDSeparator = "/networkSecurityGroupId"
+
+// NetworkWatcherFlowLogID is the ID of the Flow Log for a Network Security Group - since Flow Logs aren't a resource
+// in Azure this is a combination of the Network Watcher ID and the Network Security Group ID, in the format
+// `{networkWatcherId}/networkSecurityGroupId{networkSecurityGroupId}`
+type NetworkWatcherFlowLogID struct {
+	NetworkWatcher       NetworkWatcherID
+	NetworkSecurityGroup NetworkSecurityGroupID
+}
+
+// NewNetworkWatcherFlowLogID returns a NetworkWatcherFlowLogID for the specified Network Watcher and Network Security Group
+func NewNetworkWatcherFlowLogID(networkWatcher NetworkWatcherID, networkSecurityGroup NetworkSecurityGroupID) NetworkWatcherFlowLogID {
+	return NetworkWatcherFlowLogID{
+		NetworkWatcher:       networkWatcher,
+		NetworkSecurityGroup: networkSecurityGroup,
+	}
+}
+
+// ParseNetworkWatcherFlowLogID parses the specified ID as a NetworkWatcherFlowLogID
+func ParseNetworkWatcherFlowLogID(input string) (*NetworkWatcherFlowLogID, error) {
+	segments := strings.Split(input, networkWatcherFlowLogIDSeparator)
+	if len(segments) != 2 {
+		return nil, fmt.Errorf("Error parsing %q as a Network Watcher Flow Log ID: expected the format `{networkWatcherId}%s{networkSecurityGroupId}`", input, networkWatcherFlowLogIDSeparator)
+	}
+
+	networkWatcher, err := ParseNetworkWatcherID(segments[0])
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a Network Watcher Flow Log ID: %+v", input, err)
+	}
+
+	networkSecurityGroup, err := ParseNetworkSecurityGroupID(segments[1])
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a Network Watcher Flow Log ID: %+v", input, err)
+	}
+
+	return &NetworkWatcherFlowLogID{
+		NetworkWatcher:       *networkWatcher,
+		NetworkSecurityGroup: *networkSecurityGroup,
+	}, nil
+}
+
+// String returns the Network Watcher Flow Log ID in the canonical casing
+func (id NetworkWatcherFlowLogID) String() string {
+	return id.NetworkWatcher.String() + networkWatcherFlowLogIDSeparator + id.NetworkSecurityGroup.String()
+}
+
+// ImportNetworkWatcherFlowLogID is a StateFunc which validates that the ID being imported is a Network Watcher Flow Log ID
+func ImportNetworkWatcherFlowLogID(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	return importResourceIDFormat(d, func(input string) error {
+		_, err := ParseNetworkWatcherFlowLogID(input)
+		return err
+	})
+}
@@ -0,0 +1,76 @@
+package azure
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseNetworkWatcherFlowLogID(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Input    string
+		Expected *NetworkWatcherFlowLogID
+	}{
+		{
+			Name:  "Empty",
+			Input: "",
+		},
+		{
+			Name:  "Network Watcher ID",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/networkWatchers/watcher1",
+		},
+		{
+			Name:  "Missing Network Security Group ID",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/networkWatchers/watcher1/networkSecurityGroupId",
+		},
+		{
+			Name:  "Wrong type of resource for the Network Security Group",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/networkWatchers/watcher1/networkSecurityGroupId/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group2/providers/Microsoft.Network/virtualNetworks/network1",
+		},
+		{
+			Name:  "Wrong type of resource for the Network Watcher",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1/networkSecurityGroupId/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group2/providers/Microsoft.Network/networkSecurityGroups/nsg1",
+		},
+		{
+			Name:  "Flow Log ID",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/networkWatchers/watcher1/networkSecurityGroupId/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group2/providers/Microsoft.Network/networkSecurityGroups/nsg1",
+			Expected: &NetworkWatcherFlowLogID{
+				NetworkWatcher: NetworkWatcherID{
+					Subscription:  "00000000-0000-0000-0000-000000000000",
+					ResourceGroup: "group1",
+					Name:          "watcher1",
+				},
+				NetworkSecurityGroup: NetworkSecurityGroupID{
+					Subscription:  "00000000-0000-0000-0000-000000000000",
+					ResourceGroup: "group2",
+					Name:          "nsg1",
+				},
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Run(v.Name, func(t *testing.T) {
+			actual, err := ParseNetworkWatcherFlowLogID(v.Input)
+			if err != nil {
+				if v.Expected == nil {
+					return
+				}
+
+				t.Fatalf("Expected no error but got: %+v", err)
+			}
+
+			if v.Expected == nil {
+				t.Fatalf("Expected an error but got: %+v", actual)
+			}
+
+			if !reflect.DeepEqual(*v.Expected, *actual) {
+				t.Fatalf("Expected %+v but got %+v", *v.Expected, *actual)
+			}
+
+			if actual.String() != v.Input {
+				t.Fatalf("Expected the ID to round-trip to %q but got %q", v.Input, actual.String())
+			}
+		})
+	}
+}
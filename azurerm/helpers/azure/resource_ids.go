@@ -0,0 +1,102 @@
+package azure
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// The typed Resource IDs in resource_ids_gen.go are generated from the formats defined in
+// ./generator-resource-ids/main.go - to add a new Resource ID, add it there and then run `make generate`
+
+//go:generate go run ./generator-resource-ids/main.go -output resource_ids_gen.go
+
+// parseResourceIDFormat parses the input against the specified format, where the values of the segments
+// in braces (e.g. `{name}`) are returned in order. The remaining segments must match the format - however
+// they're compared case-insensitively, since Azure doesn't consistently return the casing it was sent
+// (e.g. `resourcegroups` rather than `resourceGroups`, or `microsoft.compute` rather than `Microsoft.Compute`)
+func parseResourceIDFormat(format string, input string) ([]string, error) {
+	if input == "" {
+		return nil, fmt.Errorf("ID was empty")
+	}
+
+	if strings.ContainsAny(input, "?#") {
+		return nil, fmt.Errorf("ID cannot contain a query string or fragment")
+	}
+
+	formatSegments := splitResourceID(format)
+	inputSegments := splitResourceID(input)
+	if len(inputSegments) != len(formatSegments) {
+		return nil, fmt.Errorf("Expected %d segments in the format %q but got %d", len(formatSegments), format, len(inputSegments))
+	}
+
+	values := make([]string, 0)
+	for i, expected := range formatSegments {
+		actual := inputSegments[i]
+
+		if isResourceIDPlaceholder(expected) {
+			if actual == "" {
+				return nil, fmt.Errorf("Expected a value for the segment %s but it was empty", expected)
+			}
+
+			values = append(values, actual)
+			continue
+		}
+
+		if !strings.EqualFold(expected, actual) {
+			return nil, fmt.Errorf("Expected segment %d to be %q but got %q (the format is %q)", i+1, expected, actual, format)
+		}
+	}
+
+	return values, nil
+}
+
+// formatResourceID builds a Resource ID in the specified format (and canonical casing), using the
+// values for each of the segments in braces in order
+func formatResourceID(format string, values ...string) string {
+	segments := splitResourceID(format)
+
+	i := 0
+	for j, segment := range segments {
+		if isResourceIDPlaceholder(segment) {
+			segments[j] = values[i]
+			i++
+		}
+	}
+
+	return "/" + strings.Join(segments, "/")
+}
+
+func splitResourceID(input string) []string {
+	return strings.Split(strings.Trim(input, "/"), "/")
+}
+
+func isResourceIDPlaceholder(segment string) bool {
+	return strings.HasPrefix(segment, "{") && strings.HasSuffix(segment, "}")
+}
+
+// validateResourceIDFormat is a SchemaValidateFunc which validates the value using the specified parse function
+func validateResourceIDFormat(i interface{}, k string, parse func(input string) error) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if err := parse(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is invalid: %+v", k, err))
+	}
+
+	return
+}
+
+// importResourceIDFormat is a StateFunc which ensures the ID being imported can be parsed using the specified
+// parse function, since otherwise an ID for a different type of resource can be imported into the state
+func importResourceIDFormat(d *schema.ResourceData, parse func(input string) error) ([]*schema.ResourceData, error) {
+	if err := parse(d.Id()); err != nil {
+		return nil, fmt.Errorf("Error importing %q: %+v", d.Id(), err)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
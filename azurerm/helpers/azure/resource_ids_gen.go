@@ -0,0 +1,7557 @@
+// Code generated by generator-resource-ids; DO NOT EDIT.
+
+package azure
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+const appServiceIDFormat = "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Web/sites/{name}"
+
+// AppServiceID is the ID of an App Service in the format `/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Web/sites/{name}`
+type AppServiceID struct {
+	Subscription  string
+	ResourceGroup string
+	Name          string
+}
+
+// NewAppServiceID returns an AppServiceID for the specified segments
+func NewAppServiceID(subscription, resourceGroup, name string) AppServiceID {
+	return AppServiceID{
+		Subscription:  subscription,
+		ResourceGroup: resourceGroup,
+		Name:          name,
+	}
+}
+
+// ParseAppServiceID parses the specified Resource ID as an AppServiceID
+func ParseAppServiceID(input string) (*AppServiceID, error) {
+	segments, err := parseResourceIDFormat(appServiceIDFormat, input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as an App Service ID: %+v", input, err)
+	}
+
+	return &AppServiceID{
+		Subscription:  segments[0],
+		ResourceGroup: segments[1],
+		Name:          segments[2],
+	}, nil
+}
+
+// String returns the App Service ID in the canonical casing
+func (id AppServiceID) String() string {
+	return formatResourceID(appServiceIDFormat, id.Subscription, id.ResourceGroup, id.Name)
+}
+
+// ValidateAppServiceID is a SchemaValidateFunc which validates that the value is an App Service ID
+func ValidateAppServiceID(i interface{}, k string) ([]string, []error) {
+	return validateResourceIDFormat(i, k, func(input string) error {
+		_, err := ParseAppServiceID(input)
+		return err
+	})
+}
+
+// ImportAppServiceID is a StateFunc which validates that the ID being imported is an App Service ID
+func ImportAppServiceID(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	return importResourceIDFormat(d, func(input string) error {
+		_, err := ParseAppServiceID(input)
+		return err
+	})
+}
+
+const appServiceCustomHostnameBindingIDFormat = "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Web/sites/{site}/hostNameBindings/{name}"
+
+// AppServiceCustomHostnameBindingID is the ID of an App Service Custom Hostname Binding in the format `/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Web/sites/{site}/hostNameBindings/{name}`
+type AppServiceCustomHostnameBindingID struct {
+	Subscription  string
+	ResourceGroup string
+	Site          string
+	Name          string
+}
+
+// NewAppServiceCustomHostnameBindingID returns an AppServiceCustomHostnameBindingID for the specified segments
+func NewAppServiceCustomHostnameBindingID(subscription, resourceGroup, site, name string) AppServiceCustomHostnameBindingID {
+	return AppServiceCustomHostnameBindingID{
+		Subscription:  subscription,
+		ResourceGroup: resourceGroup,
+		Site:          site,
+		Name:          name,
+	}
+}
+
+// ParseAppServiceCustomHostnameBindingID parses the specified Resource ID as an AppServiceCustomHostnameBindingID
+func ParseAppServiceCustomHostnameBindingID(input string) (*AppServiceCustomHostnameBindingID, error) {
+	segments, err := parseResourceIDFormat(appServiceCustomHostnameBindingIDFormat, input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as an App Service Custom Hostname Binding ID: %+v", input, err)
+	}
+
+	return &AppServiceCustomHostnameBindingID{
+		Subscription:  segments[0],
+		ResourceGroup: segments[1],
+		Site:          segments[2],
+		Name:          segments[3],
+	}, nil
+}
+
+// String returns the App Service Custom Hostname Binding ID in the canonical casing
+func (id AppServiceCustomHostnameBindingID) String() string {
+	return formatResourceID(appServiceCustomHostnameBindingIDFormat, id.Subscription, id.ResourceGroup, id.Site, id.Name)
+}
+
+// ValidateAppServiceCustomHostnameBindingID is a SchemaValidateFunc which validates that the value is an App Service Custom Hostname Binding ID
+func ValidateAppServiceCustomHostnameBindingID(i interface{}, k string) ([]string, []error) {
+	return validateResourceIDFormat(i, k, func(input string) error {
+		_, err := ParseAppServiceCustomHostnameBindingID(input)
+		return err
+	})
+}
+
+// ImportAppServiceCustomHostnameBindingID is a StateFunc which validates that the ID being imported is an App Service Custom Hostname Binding ID
+func ImportAppServiceCustomHostnameBindingID(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	return importResourceIDFormat(d, func(input string) error {
+		_, err := ParseAppServiceCustomHostnameBindingID(input)
+		return err
+	})
+}
+
+const appServicePlanIDFormat = "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Web/serverfarms/{name}"
+
+// AppServicePlanID is the ID of an App Service Plan in the format `/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Web/serverfarms/{name}`
+type AppServicePlanID struct {
+	Subscription  string
+	ResourceGroup string
+	Name          string
+}
+
+// NewAppServicePlanID returns an AppServicePlanID for the specified segments
+func NewAppServicePlanID(subscription, resourceGroup, name string) AppServicePlanID {
+	return AppServicePlanID{
+		Subscription:  subscription,
+		ResourceGroup: resourceGroup,
+		Name:          name,
+	}
+}
+
+// ParseAppServicePlanID parses the specified Resource ID as an AppServicePlanID
+func ParseAppServicePlanID(input string) (*AppServicePlanID, error) {
+	segments, err := parseResourceIDFormat(appServicePlanIDFormat, input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as an App Service Plan ID: %+v", input, err)
+	}
+
+	return &AppServicePlanID{
+		Subscription:  segments[0],
+		ResourceGroup: segments[1],
+		Name:          segments[2],
+	}, nil
+}
+
+// String returns the App Service Plan ID in the canonical casing
+func (id AppServicePlanID) String() string {
+	return formatResourceID(appServicePlanIDFormat, id.Subscription, id.ResourceGroup, id.Name)
+}
+
+// ValidateAppServicePlanID is a SchemaValidateFunc which validates that the value is an App Service Plan ID
+func ValidateAppServicePlanID(i interface{}, k string) ([]string, []error) {
+	return validateResourceIDFormat(i, k, func(input string) error {
+		_, err := ParseAppServicePlanID(input)
+		return err
+	})
+}
+
+// ImportAppServicePlanID is a StateFunc which validates that the ID being imported is an App Service Plan ID
+func ImportAppServicePlanID(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	return importResourceIDFormat(d, func(input string) error {
+		_, err := ParseAppServicePlanID(input)
+		return err
+	})
+}
+
+const appServiceSlotIDFormat = "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Web/sites/{site}/slots/{name}"
+
+// AppServiceSlotID is the ID of an App Service Slot in the format `/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Web/sites/{site}/slots/{name}`
+type AppServiceSlotID struct {
+	Subscription  string
+	ResourceGroup string
+	Site          string
+	Name          string
+}
+
+// NewAppServiceSlotID returns an AppServiceSlotID for the specified segments
+func NewAppServiceSlotID(subscription, resourceGroup, site, name string) AppServiceSlotID {
+	return AppServiceSlotID{
+		Subscription:  subscription,
+		ResourceGroup: resourceGroup,
+		Site:          site,
+		Name:          name,
+	}
+}
+
+// ParseAppServiceSlotID parses the specified Resource ID as an AppServiceSlotID
+func ParseAppServiceSlotID(input string) (*AppServiceSlotID, error) {
+	segments, err := parseResourceIDFormat(appServiceSlotIDFormat, input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as an App Service Slot ID: %+v", input, err)
+	}
+
+	return &AppServiceSlotID{
+		Subscription:  segments[0],
+		ResourceGroup: segments[1],
+		Site:          segments[2],
+		Name:          segments[3],
+	}, nil
+}
+
+// String returns the App Service Slot ID in the canonical casing
+func (id AppServiceSlotID) String() string {
+	return formatResourceID(appServiceSlotIDFormat, id.Subscription, id.ResourceGroup, id.Site, id.Name)
+}
+
+// ValidateAppServiceSlotID is a SchemaValidateFunc which validates that the value is an App Service Slot ID
+func ValidateAppServiceSlotID(i interface{}, k string) ([]string, []error) {
+	return validateResourceIDFormat(i, k, func(input string) error {
+		_, err := ParseAppServiceSlotID(input)
+		return err
+	})
+}
+
+// ImportAppServiceSlotID is a StateFunc which validates that the ID being imported is an App Service Slot ID
+func ImportAppServiceSlotID(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	return importResourceIDFormat(d, func(input string) error {
+		_, err := ParseAppServiceSlotID(input)
+		return err
+	})
+}
+
+const automationAccountIDFormat = "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Automation/automationAccounts/{name}"
+
+// AutomationAccountID is the ID of an Automation Account in the format `/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Automation/automationAccounts/{name}`
+type AutomationAccountID struct {
+	Subscription  string
+	ResourceGroup string
+	Name          string
+}
+
+// NewAutomationAccountID returns an AutomationAccountID for the specified segments
+func NewAutomationAccountID(subscription, resourceGroup, name string) AutomationAccountID {
+	return AutomationAccountID{
+		Subscription:  subscription,
+		ResourceGroup: resourceGroup,
+		Name:          name,
+	}
+}
+
+// ParseAutomationAccountID parses the specified Resource ID as an AutomationAccountID
+func ParseAutomationAccountID(input string) (*AutomationAccountID, error) {
+	segments, err := parseResourceIDFormat(automationAccountIDFormat, input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as an Automation Account ID: %+v", input, err)
+	}
+
+	return &AutomationAccountID{
+		Subscription:  segments[0],
+		ResourceGroup: segments[1],
+		Name:          segments[2],
+	}, nil
+}
+
+// String returns the Automation Account ID in the canonical casing
+func (id AutomationAccountID) String() string {
+	return formatResourceID(automationAccountIDFormat, id.Subscription, id.ResourceGroup, id.Name)
+}
+
+// ValidateAutomationAccountID is a SchemaValidateFunc which validates that the value is an Automation Account ID
+func ValidateAutomationAccountID(i interface{}, k string) ([]string, []error) {
+	return validateResourceIDFormat(i, k, func(input string) error {
+		_, err := ParseAutomationAccountID(input)
+		return err
+	})
+}
+
+// ImportAutomationAccountID is a StateFunc which validates that the ID being imported is an Automation Account ID
+func ImportAutomationAccountID(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	return importResourceIDFormat(d, func(input string) error {
+		_, err := ParseAutomationAccountID(input)
+		return err
+	})
+}
+
+const automationCredentialIDFormat = "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Automation/automationAccounts/{automationAccount}/credentials/{name}"
+
+// AutomationCredentialID is the ID of an Automation Credential in the format `/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Automation/automationAccounts/{automationAccount}/credentials/{name}`
+type AutomationCredentialID struct {
+	Subscription      string
+	ResourceGroup     string
+	AutomationAccount string
+	Name              string
+}
+
+// NewAutomationCredentialID returns an AutomationCredentialID for the specified segments
+func NewAutomationCredentialID(subscription, resourceGroup, automationAccount, name string) AutomationCredentialID {
+	return AutomationCredentialID{
+		Subscription:      subscription,
+		ResourceGroup:     resourceGroup,
+		AutomationAccount: automationAccount,
+		Name:              name,
+	}
+}
+
+// ParseAutomationCredentialID parses the specified Resource ID as an AutomationCredentialID
+func ParseAutomationCredentialID(input string) (*AutomationCredentialID, error) {
+	segments, err := parseResourceIDFormat(automationCredentialIDFormat, input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as an Automation Credential ID: %+v", input, err)
+	}
+
+	return &AutomationCredentialID{
+		Subscription:      segments[0],
+		ResourceGroup:     segments[1],
+		AutomationAccount: segments[2],
+		Name:              segments[3],
+	}, nil
+}
+
+// String returns the Automation Credential ID in the canonical casing
+func (id AutomationCredentialID) String() string {
+	return formatResourceID(automationCredentialIDFormat, id.Subscription, id.ResourceGroup, id.AutomationAccount, id.Name)
+}
+
+// ValidateAutomationCredentialID is a SchemaValidateFunc which validates that the value is an Automation Credential ID
+func ValidateAutomationCredentialID(i interface{}, k string) ([]string, []error) {
+	return validateResourceIDFormat(i, k, func(input string) error {
+		_, err := ParseAutomationCredentialID(input)
+		return err
+	})
+}
+
+// ImportAutomationCredentialID is a StateFunc which validates that the ID being imported is an Automation Credential ID
+func ImportAutomationCredentialID(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	return importResourceIDFormat(d, func(input string) error {
+		_, err := ParseAutomationCredentialID(input)
+		return err
+	})
+}
+
+const automationRunbookIDFormat = "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Automation/automationAccounts/{automationAccount}/runbooks/{name}"
+
+// AutomationRunbookID is the ID of an Automation Runbook in the format `/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Automation/automationAccounts/{automationAccount}/runbooks/{name}`
+type AutomationRunbookID struct {
+	Subscription      string
+	ResourceGroup     string
+	AutomationAccount string
+	Name              string
+}
+
+// NewAutomationRunbookID returns an AutomationRunbookID for the specified segments
+func NewAutomationRunbookID(subscription, resourceGroup, automationAccount, name string) AutomationRunbookID {
+	return AutomationRunbookID{
+		Subscription:      subscription,
+		ResourceGroup:     resourceGroup,
+		AutomationAccount: automationAccount,
+		Name:              name,
+	}
+}
+
+// ParseAutomationRunbookID parses the specified Resource ID as an AutomationRunbookID
+func ParseAutomationRunbookID(input string) (*AutomationRunbookID, error) {
+	segments, err := parseResourceIDFormat(automationRunbookIDFormat, input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as an Automation Runbook ID: %+v", input, err)
+	}
+
+	return &AutomationRunbookID{
+		Subscription:      segments[0],
+		ResourceGroup:     segments[1],
+		AutomationAccount: segments[2],
+		Name:              segments[3],
+	}, nil
+}
+
+// String returns the Automation Runbook ID in the canonical casing
+func (id AutomationRunbookID) String() string {
+	return formatResourceID(automationRunbookIDFormat, id.Subscription, id.ResourceGroup, id.AutomationAccount, id.Name)
+}
+
+// ValidateAutomationRunbookID is a SchemaValidateFunc which validates that the value is an Automation Runbook ID
+func ValidateAutomationRunbookID(i interface{}, k string) ([]string, []error) {
+	return validateResourceIDFormat(i, k, func(input string) error {
+		_, err := ParseAutomationRunbookID(input)
+		return err
+	})
+}
+
+// ImportAutomationRunbookID is a StateFunc which validates that the ID being imported is an Automation Runbook ID
+func ImportAutomationRunbookID(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	return importResourceIDFormat(d, func(input string) error {
+		_, err := ParseAutomationRunbookID(input)
+		return err
+	})
+}
+
+const automationScheduleIDFormat = "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Automation/automationAccounts/{automationAccount}/schedules/{name}"
+
+// AutomationScheduleID is the ID of an Automation Schedule in the format `/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Automation/automationAccounts/{automationAccount}/schedules/{name}`
+type AutomationScheduleID struct {
+	Subscription      string
+	ResourceGroup     string
+	AutomationAccount string
+	Name              string
+}
+
+// NewAutomationScheduleID returns an AutomationScheduleID for the specified segments
+func NewAutomationScheduleID(subscription, resourceGroup, automationAccount, name string) AutomationScheduleID {
+	return AutomationScheduleID{
+		Subscription:      subscription,
+		ResourceGroup:     resourceGroup,
+		AutomationAccount: automationAccount,
+		Name:              name,
+	}
+}
+
+// ParseAutomationScheduleID parses the specified Resource ID as an AutomationScheduleID
+func ParseAutomationScheduleID(input string) (*AutomationScheduleID, error) {
+	segments, err := parseResourceIDFormat(automationScheduleIDFormat, input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as an Automation Schedule ID: %+v", input, err)
+	}
+
+	return &AutomationScheduleID{
+		Subscription:      segments[0],
+		ResourceGroup:     segments[1],
+		AutomationAccount: segments[2],
+		Name:              segments[3],
+	}, nil
+}
+
+// String returns the Automation Schedule ID in the canonical casing
+func (id AutomationScheduleID) String() string {
+	return formatResourceID(automationScheduleIDFormat, id.Subscription, id.ResourceGroup, id.AutomationAccount, id.Name)
+}
+
+// ValidateAutomationScheduleID is a SchemaValidateFunc which validates that the value is an Automation Schedule ID
+func ValidateAutomationScheduleID(i interface{}, k string) ([]string, []error) {
+	return validateResourceIDFormat(i, k, func(input string) error {
+		_, err := ParseAutomationScheduleID(input)
+		return err
+	})
+}
+
+// ImportAutomationScheduleID is a StateFunc which validates that the ID being imported is an Automation Schedule ID
+func ImportAutomationScheduleID(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	return importResourceIDFormat(d, func(input string) error {
+		_, err := ParseAutomationScheduleID(input)
+		return err
+	})
+}
+
+const cdnEndpointIDFormat = "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Cdn/profiles/{profile}/endpoints/{name}"
+
+// CdnEndpointID is the ID of a CDN Endpoint in the format `/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Cdn/profiles/{profile}/endpoints/{name}`
+type CdnEndpointID struct {
+	Subscription  string
+	ResourceGroup string
+	Profile       string
+	Name          string
+}
+
+// NewCdnEndpointID returns a CdnEndpointID for the specified segments
+func NewCdnEndpointID(subscription, resourceGroup, profile, name string) CdnEndpointID {
+	return CdnEndpointID{
+		Subscription:  subscription,
+		ResourceGroup: resourceGroup,
+		Profile:       profile,
+		Name:          name,
+	}
+}
+
+// ParseCdnEndpointID parses the specified Resource ID as a CdnEndpointID
+func ParseCdnEndpointID(input string) (*CdnEndpointID, error) {
+	segments, err := parseResourceIDFormat(cdnEndpointIDFormat, input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a CDN Endpoint ID: %+v", input, err)
+	}
+
+	return &CdnEndpointID{
+		Subscription:  segments[0],
+		ResourceGroup: segments[1],
+		Profile:       segments[2],
+		Name:          segments[3],
+	}, nil
+}
+
+// String returns the CDN Endpoint ID in the canonical casing
+func (id CdnEndpointID) String() string {
+	return formatResourceID(cdnEndpointIDFormat, id.Subscription, id.ResourceGroup, id.Profile, id.Name)
+}
+
+// ValidateCdnEndpointID is a SchemaValidateFunc which validates that the value is a CDN Endpoint ID
+func ValidateCdnEndpointID(i interface{}, k string) ([]string, []error) {
+	return validateResourceIDFormat(i, k, func(input string) error {
+		_, err := ParseCdnEndpointID(input)
+		return err
+	})
+}
+
+// ImportCdnEndpointID is a StateFunc which validates that the ID being imported is a CDN Endpoint ID
+func ImportCdnEndpointID(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	return importResourceIDFormat(d, func(input string) error {
+		_, err := ParseCdnEndpointID(input)
+		return err
+	})
+}
+
+const cdnProfileIDFormat = "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Cdn/profiles/{name}"
+
+// CdnProfileID is the ID of a CDN Profile in the format `/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Cdn/profiles/{name}`
+type CdnProfileID struct {
+	Subscription  string
+	ResourceGroup string
+	Name          string
+}
+
+// NewCdnProfileID returns a CdnProfileID for the specified segments
+func NewCdnProfileID(subscription, resourceGroup, name string) CdnProfileID {
+	return CdnProfileID{
+		Subscription:  subscription,
+		ResourceGroup: resourceGroup,
+		Name:          name,
+	}
+}
+
+// ParseCdnProfileID parses the specified Resource ID as a CdnProfileID
+func ParseCdnProfileID(input string) (*CdnProfileID, error) {
+	segments, err := parseResourceIDFormat(cdnProfileIDFormat, input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a CDN Profile ID: %+v", input, err)
+	}
+
+	return &CdnProfileID{
+		Subscription:  segments[0],
+		ResourceGroup: segments[1],
+		Name:          segments[2],
+	}, nil
+}
+
+// String returns the CDN Profile ID in the canonical casing
+func (id CdnProfileID) String() string {
+	return formatResourceID(cdnProfileIDFormat, id.Subscription, id.ResourceGroup, id.Name)
+}
+
+// ValidateCdnProfileID is a SchemaValidateFunc which validates that the value is a CDN Profile ID
+func ValidateCdnProfileID(i interface{}, k string) ([]string, []error) {
+	return validateResourceIDFormat(i, k, func(input string) error {
+		_, err := ParseCdnProfileID(input)
+		return err
+	})
+}
+
+// ImportCdnProfileID is a StateFunc which validates that the ID being imported is a CDN Profile ID
+func ImportCdnProfileID(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	return importResourceIDFormat(d, func(input string) error {
+		_, err := ParseCdnProfileID(input)
+		return err
+	})
+}
+
+const availabilitySetIDFormat = "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Compute/availabilitySets/{name}"
+
+// AvailabilitySetID is the ID of an Availability Set in the format `/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Compute/availabilitySets/{name}`
+type AvailabilitySetID struct {
+	Subscription  string
+	ResourceGroup string
+	Name          string
+}
+
+// NewAvailabilitySetID returns an AvailabilitySetID for the specified segments
+func NewAvailabilitySetID(subscription, resourceGroup, name string) AvailabilitySetID {
+	return AvailabilitySetID{
+		Subscription:  subscription,
+		ResourceGroup: resourceGroup,
+		Name:          name,
+	}
+}
+
+// ParseAvailabilitySetID parses the specified Resource ID as an AvailabilitySetID
+func ParseAvailabilitySetID(input string) (*AvailabilitySetID, error) {
+	segments, err := parseResourceIDFormat(availabilitySetIDFormat, input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as an Availability Set ID: %+v", input, err)
+	}
+
+	return &AvailabilitySetID{
+		Subscription:  segments[0],
+		ResourceGroup: segments[1],
+		Name:          segments[2],
+	}, nil
+}
+
+// String returns the Availability Set ID in the canonical casing
+func (id AvailabilitySetID) String() string {
+	return formatResourceID(availabilitySetIDFormat, id.Subscription, id.ResourceGroup, id.Name)
+}
+
+// ValidateAvailabilitySetID is a SchemaValidateFunc which validates that the value is an Availability Set ID
+func ValidateAvailabilitySetID(i interface{}, k string) ([]string, []error) {
+	return validateResourceIDFormat(i, k, func(input string) error {
+		_, err := ParseAvailabilitySetID(input)
+		return err
+	})
+}
+
+// ImportAvailabilitySetID is a StateFunc which validates that the ID being imported is an Availability Set ID
+func ImportAvailabilitySetID(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	return importResourceIDFormat(d, func(input string) error {
+		_, err := ParseAvailabilitySetID(input)
+		return err
+	})
+}
+
+const imageIDFormat = "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Compute/images/{name}"
+
+// ImageID is the ID of an Image in the format `/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Compute/images/{name}`
+type ImageID struct {
+	Subscription  string
+	ResourceGroup string
+	Name          string
+}
+
+// NewImageID returns an ImageID for the specified segments
+func NewImageID(subscription, resourceGroup, name string) ImageID {
+	return ImageID{
+		Subscription:  subscription,
+		ResourceGroup: resourceGroup,
+		Name:          name,
+	}
+}
+
+// ParseImageID parses the specified Resource ID as an ImageID
+func ParseImageID(input string) (*ImageID, error) {
+	segments, err := parseResourceIDFormat(imageIDFormat, input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as an Image ID: %+v", input, err)
+	}
+
+	return &ImageID{
+		Subscription:  segments[0],
+		ResourceGroup: segments[1],
+		Name:          segments[2],
+	}, nil
+}
+
+// String returns the Image ID in the canonical casing
+func (id ImageID) String() string {
+	return formatResourceID(imageIDFormat, id.Subscription, id.ResourceGroup, id.Name)
+}
+
+// ValidateImageID is a SchemaValidateFunc which validates that the value is an Image ID
+func ValidateImageID(i interface{}, k string) ([]string, []error) {
+	return validateResourceIDFormat(i, k, func(input string) error {
+		_, err := ParseImageID(input)
+		return err
+	})
+}
+
+// ImportImageID is a StateFunc which validates that the ID being imported is an Image ID
+func ImportImageID(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	return importResourceIDFormat(d, func(input string) error {
+		_, err := ParseImageID(input)
+		return err
+	})
+}
+
+const managedDiskIDFormat = "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Compute/disks/{name}"
+
+// ManagedDiskID is the ID of a Managed Disk in the format `/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Compute/disks/{name}`
+type ManagedDiskID struct {
+	Subscription  string
+	ResourceGroup string
+	Name          string
+}
+
+// NewManagedDiskID returns a ManagedDiskID for the specified segments
+func NewManagedDiskID(subscription, resourceGroup, name string) ManagedDiskID {
+	return ManagedDiskID{
+		Subscription:  subscription,
+		ResourceGroup: resourceGroup,
+		Name:          name,
+	}
+}
+
+// ParseManagedDiskID parses the specified Resource ID as a ManagedDiskID
+func ParseManagedDiskID(input string) (*ManagedDiskID, error) {
+	segments, err := parseResourceIDFormat(managedDiskIDFormat, input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a Managed Disk ID: %+v", input, err)
+	}
+
+	return &ManagedDiskID{
+		Subscription:  segments[0],
+		ResourceGroup: segments[1],
+		Name:          segments[2],
+	}, nil
+}
+
+// String returns the Managed Disk ID in the canonical casing
+func (id ManagedDiskID) String() string {
+	return formatResourceID(managedDiskIDFormat, id.Subscription, id.ResourceGroup, id.Name)
+}
+
+// ValidateManagedDiskID is a SchemaValidateFunc which validates that the value is a Managed Disk ID
+func ValidateManagedDiskID(i interface{}, k string) ([]string, []error) {
+	return validateResourceIDFormat(i, k, func(input string) error {
+		_, err := ParseManagedDiskID(input)
+		return err
+	})
+}
+
+// ImportManagedDiskID is a StateFunc which validates that the ID being imported is a Managed Disk ID
+func ImportManagedDiskID(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	return importResourceIDFormat(d, func(input string) error {
+		_, err := ParseManagedDiskID(input)
+		return err
+	})
+}
+
+const sharedImageIDFormat = "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Compute/galleries/{gallery}/images/{name}"
+
+// SharedImageID is the ID of a Shared Image in the format `/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Compute/galleries/{gallery}/images/{name}`
+type SharedImageID struct {
+	Subscription  string
+	ResourceGroup string
+	Gallery       string
+	Name          string
+}
+
+// NewSharedImageID returns a SharedImageID for the specified segments
+func NewSharedImageID(subscription, resourceGroup, gallery, name string) SharedImageID {
+	return SharedImageID{
+		Subscription:  subscription,
+		ResourceGroup: resourceGroup,
+		Gallery:       gallery,
+		Name:          name,
+	}
+}
+
+// ParseSharedImageID parses the specified Resource ID as a SharedImageID
+func ParseSharedImageID(input string) (*SharedImageID, error) {
+	segments, err := parseResourceIDFormat(sharedImageIDFormat, input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a Shared Image ID: %+v", input, err)
+	}
+
+	return &SharedImageID{
+		Subscription:  segments[0],
+		ResourceGroup: segments[1],
+		Gallery:       segments[2],
+		Name:          segments[3],
+	}, nil
+}
+
+// String returns the Shared Image ID in the canonical casing
+func (id SharedImageID) String() string {
+	return formatResourceID(sharedImageIDFormat, id.Subscription, id.ResourceGroup, id.Gallery, id.Name)
+}
+
+// ValidateSharedImageID is a SchemaValidateFunc which validates that the value is a Shared Image ID
+func ValidateSharedImageID(i interface{}, k string) ([]string, []error) {
+	return validateResourceIDFormat(i, k, func(input string) error {
+		_, err := ParseSharedImageID(input)
+		return err
+	})
+}
+
+// ImportSharedImageID is a StateFunc which validates that the ID being imported is a Shared Image ID
+func ImportSharedImageID(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	return importResourceIDFormat(d, func(input string) error {
+		_, err := ParseSharedImageID(input)
+		return err
+	})
+}
+
+const sharedImageGalleryIDFormat = "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Compute/galleries/{name}"
+
+// SharedImageGalleryID is the ID of a Shared Image Gallery in the format `/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Compute/galleries/{name}`
+type SharedImageGalleryID struct {
+	Subscription  string
+	ResourceGroup string
+	Name          string
+}
+
+// NewSharedImageGalleryID returns a SharedImageGalleryID for the specified segments
+func NewSharedImageGalleryID(subscription, resourceGroup, name string) SharedImageGalleryID {
+	return SharedImageGalleryID{
+		Subscription:  subscription,
+		ResourceGroup: resourceGroup,
+		Name:          name,
+	}
+}
+
+// ParseSharedImageGalleryID parses the specified Resource ID as a SharedImageGalleryID
+func ParseSharedImageGalleryID(input string) (*SharedImageGalleryID, error) {
+	segments, err := parseResourceIDFormat(sharedImageGalleryIDFormat, input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a Shared Image Gallery ID: %+v", input, err)
+	}
+
+	return &SharedImageGalleryID{
+		Subscription:  segments[0],
+		ResourceGroup: segments[1],
+		Name:          segments[2],
+	}, nil
+}
+
+// String returns the Shared Image Gallery ID in the canonical casing
+func (id SharedImageGalleryID) String() string {
+	return formatResourceID(sharedImageGalleryIDFormat, id.Subscription, id.ResourceGroup, id.Name)
+}
+
+// ValidateSharedImageGalleryID is a SchemaValidateFunc which validates that the value is a Shared Image Gallery ID
+func ValidateSharedImageGalleryID(i interface{}, k string) ([]string, []error) {
+	return validateResourceIDFormat(i, k, func(input string) error {
+		_, err := ParseSharedImageGalleryID(input)
+		return err
+	})
+}
+
+// ImportSharedImageGalleryID is a StateFunc which validates that the ID being imported is a Shared Image Gallery ID
+func ImportSharedImageGalleryID(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	return importResourceIDFormat(d, func(input string) error {
+		_, err := ParseSharedImageGalleryID(input)
+		return err
+	})
+}
+
+const sharedImageVersionIDFormat = "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Compute/galleries/{gallery}/images/{image}/versions/{name}"
+
+// SharedImageVersionID is the ID of a Shared Image Version in the format `/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Compute/galleries/{gallery}/images/{image}/versions/{name}`
+type SharedImageVersionID struct {
+	Subscription  string
+	ResourceGroup string
+	Gallery       string
+	Image         string
+	Name          string
+}
+
+// NewSharedImageVersionID returns a SharedImageVersionID for the specified segments
+func NewSharedImageVersionID(subscription, resourceGroup, gallery, image, name string) SharedImageVersionID {
+	return SharedImageVersionID{
+		Subscription:  subscription,
+		ResourceGroup: resourceGroup,
+		Gallery:       gallery,
+		Image:         image,
+		Name:          name,
+	}
+}
+
+// ParseSharedImageVersionID parses the specified Resource ID as a SharedImageVersionID
+func ParseSharedImageVersionID(input string) (*SharedImageVersionID, error) {
+	segments, err := parseResourceIDFormat(sharedImageVersionIDFormat, input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a Shared Image Version ID: %+v", input, err)
+	}
+
+	return &SharedImageVersionID{
+		Subscription:  segments[0],
+		ResourceGroup: segments[1],
+		Gallery:       segments[2],
+		Image:         segments[3],
+		Name:          segments[4],
+	}, nil
+}
+
+// String returns the Shared Image Version ID in the canonical casing
+func (id SharedImageVersionID) String() string {
+	return formatResourceID(sharedImageVersionIDFormat, id.Subscription, id.ResourceGroup, id.Gallery, id.Image, id.Name)
+}
+
+// ValidateSharedImageVersionID is a SchemaValidateFunc which validates that the value is a Shared Image Version ID
+func ValidateSharedImageVersionID(i interface{}, k string) ([]string, []error) {
+	return validateResourceIDFormat(i, k, func(input string) error {
+		_, err := ParseSharedImageVersionID(input)
+		return err
+	})
+}
+
+// ImportSharedImageVersionID is a StateFunc which validates that the ID being imported is a Shared Image Version ID
+func ImportSharedImageVersionID(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	return importResourceIDFormat(d, func(input string) error {
+		_, err := ParseSharedImageVersionID(input)
+		return err
+	})
+}
+
+const snapshotIDFormat = "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Compute/snapshots/{name}"
+
+// SnapshotID is the ID of a Snapshot in the format `/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Compute/snapshots/{name}`
+type SnapshotID struct {
+	Subscription  string
+	ResourceGroup string
+	Name          string
+}
+
+// NewSnapshotID returns a SnapshotID for the specified segments
+func NewSnapshotID(subscription, resourceGroup, name string) SnapshotID {
+	return SnapshotID{
+		Subscription:  subscription,
+		ResourceGroup: resourceGroup,
+		Name:          name,
+	}
+}
+
+// ParseSnapshotID parses the specified Resource ID as a SnapshotID
+func ParseSnapshotID(input string) (*SnapshotID, error) {
+	segments, err := parseResourceIDFormat(snapshotIDFormat, input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a Snapshot ID: %+v", input, err)
+	}
+
+	return &SnapshotID{
+		Subscription:  segments[0],
+		ResourceGroup: segments[1],
+		Name:          segments[2],
+	}, nil
+}
+
+// String returns the Snapshot ID in the canonical casing
+func (id SnapshotID) String() string {
+	return formatResourceID(snapshotIDFormat, id.Subscription, id.ResourceGroup, id.Name)
+}
+
+// ValidateSnapshotID is a SchemaValidateFunc which validates that the value is a Snapshot ID
+func ValidateSnapshotID(i interface{}, k string) ([]string, []error) {
+	return validateResourceIDFormat(i, k, func(input string) error {
+		_, err := ParseSnapshotID(input)
+		return err
+	})
+}
+
+// ImportSnapshotID is a StateFunc which validates that the ID being imported is a Snapshot ID
+func ImportSnapshotID(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	return importResourceIDFormat(d, func(input string) error {
+		_, err := ParseSnapshotID(input)
+		return err
+	})
+}
+
+const virtualMachineIDFormat = "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Compute/virtualMachines/{name}"
+
+// VirtualMachineID is the ID of a Virtual Machine in the format `/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Compute/virtualMachines/{name}`
+type VirtualMachineID struct {
+	Subscription  string
+	ResourceGroup string
+	Name          string
+}
+
+// NewVirtualMachineID returns a VirtualMachineID for the specified segments
+func NewVirtualMachineID(subscription, resourceGroup, name string) VirtualMachineID {
+	return VirtualMachineID{
+		Subscription:  subscription,
+		ResourceGroup: resourceGroup,
+		Name:          name,
+	}
+}
+
+// ParseVirtualMachineID parses the specified Resource ID as a VirtualMachineID
+func ParseVirtualMachineID(input string) (*VirtualMachineID, error) {
+	segments, err := parseResourceIDFormat(virtualMachineIDFormat, input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a Virtual Machine ID: %+v", input, err)
+	}
+
+	return &VirtualMachineID{
+		Subscription:  segments[0],
+		ResourceGroup: segments[1],
+		Name:          segments[2],
+	}, nil
+}
+
+// String returns the Virtual Machine ID in the canonical casing
+func (id VirtualMachineID) String() string {
+	return formatResourceID(virtualMachineIDFormat, id.Subscription, id.ResourceGroup, id.Name)
+}
+
+// ValidateVirtualMachineID is a SchemaValidateFunc which validates that the value is a Virtual Machine ID
+func ValidateVirtualMachineID(i interface{}, k string) ([]string, []error) {
+	return validateResourceIDFormat(i, k, func(input string) error {
+		_, err := ParseVirtualMachineID(input)
+		return err
+	})
+}
+
+// ImportVirtualMachineID is a StateFunc which validates that the ID being imported is a Virtual Machine ID
+func ImportVirtualMachineID(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	return importResourceIDFormat(d, func(input string) error {
+		_, err := ParseVirtualMachineID(input)
+		return err
+	})
+}
+
+const virtualMachineDataDiskAttachmentIDFormat = "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Compute/virtualMachines/{virtualMachine}/dataDisks/{name}"
+
+// VirtualMachineDataDiskAttachmentID is the ID of a Virtual Machine Data Disk Attachment in the format `/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Compute/virtualMachines/{virtualMachine}/dataDisks/{name}`
+type VirtualMachineDataDiskAttachmentID struct {
+	Subscription   string
+	ResourceGroup  string
+	VirtualMachine string
+	Name           string
+}
+
+// NewVirtualMachineDataDiskAttachmentID returns a VirtualMachineDataDiskAttachmentID for the specified segments
+func NewVirtualMachineDataDiskAttachmentID(subscription, resourceGroup, virtualMachine, name string) VirtualMachineDataDiskAttachmentID {
+	return VirtualMachineDataDiskAttachmentID{
+		Subscription:   subscription,
+		ResourceGroup:  resourceGroup,
+		VirtualMachine: virtualMachine,
+		Name:           name,
+	}
+}
+
+// ParseVirtualMachineDataDiskAttachmentID parses the specified Resource ID as a VirtualMachineDataDiskAttachmentID
+func ParseVirtualMachineDataDiskAttachmentID(input string) (*VirtualMachineDataDiskAttachmentID, error) {
+	segments, err := parseResourceIDFormat(virtualMachineDataDiskAttachmentIDFormat, input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a Virtual Machine Data Disk Attachment ID: %+v", input, err)
+	}
+
+	return &VirtualMachineDataDiskAttachmentID{
+		Subscription:   segments[0],
+		ResourceGroup:  segments[1],
+		VirtualMachine: segments[2],
+		Name:           segments[3],
+	}, nil
+}
+
+// String returns the Virtual Machine Data Disk Attachment ID in the canonical casing
+func (id VirtualMachineDataDiskAttachmentID) String() string {
+	return formatResourceID(virtualMachineDataDiskAttachmentIDFormat, id.Subscription, id.ResourceGroup, id.VirtualMachine, id.Name)
+}
+
+// ValidateVirtualMachineDataDiskAttachmentID is a SchemaValidateFunc which validates that the value is a Virtual Machine Data Disk Attachment ID
+func ValidateVirtualMachineDataDiskAttachmentID(i interface{}, k string) ([]string, []error) {
+	return validateResourceIDFormat(i, k, func(input string) error {
+		_, err := ParseVirtualMachineDataDiskAttachmentID(input)
+		return err
+	})
+}
+
+// ImportVirtualMachineDataDiskAttachmentID is a StateFunc which validates that the ID being imported is a Virtual Machine Data Disk Attachment ID
+func ImportVirtualMachineDataDiskAttachmentID(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	return importResourceIDFormat(d, func(input string) error {
+		_, err := ParseVirtualMachineDataDiskAttachmentID(input)
+		return err
+	})
+}
+
+const virtualMachineExtensionIDFormat = "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Compute/virtualMachines/{virtualMachine}/extensions/{name}"
+
+// VirtualMachineExtensionID is the ID of a Virtual Machine Extension in the format `/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Compute/virtualMachines/{virtualMachine}/extensions/{name}`
+type VirtualMachineExtensionID struct {
+	Subscription   string
+	ResourceGroup  string
+	VirtualMachine string
+	Name           string
+}
+
+// NewVirtualMachineExtensionID returns a VirtualMachineExtensionID for the specified segments
+func NewVirtualMachineExtensionID(subscription, resourceGroup, virtualMachine, name string) VirtualMachineExtensionID {
+	return VirtualMachineExtensionID{
+		Subscription:   subscription,
+		ResourceGroup:  resourceGroup,
+		VirtualMachine: virtualMachine,
+		Name:           name,
+	}
+}
+
+// ParseVirtualMachineExtensionID parses the specified Resource ID as a VirtualMachineExtensionID
+func ParseVirtualMachineExtensionID(input string) (*VirtualMachineExtensionID, error) {
+	segments, err := parseResourceIDFormat(virtualMachineExtensionIDFormat, input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a Virtual Machine Extension ID: %+v", input, err)
+	}
+
+	return &VirtualMachineExtensionID{
+		Subscription:   segments[0],
+		ResourceGroup:  segments[1],
+		VirtualMachine: segments[2],
+		Name:           segments[3],
+	}, nil
+}
+
+// String returns the Virtual Machine Extension ID in the canonical casing
+func (id VirtualMachineExtensionID) String() string {
+	return formatResourceID(virtualMachineExtensionIDFormat, id.Subscription, id.ResourceGroup, id.VirtualMachine, id.Name)
+}
+
+// ValidateVirtualMachineExtensionID is a SchemaValidateFunc which validates that the value is a Virtual Machine Extension ID
+func ValidateVirtualMachineExtensionID(i interface{}, k string) ([]string, []error) {
+	return validateResourceIDFormat(i, k, func(input string) error {
+		_, err := ParseVirtualMachineExtensionID(input)
+		return err
+	})
+}
+
+// ImportVirtualMachineExtensionID is a StateFunc which validates that the ID being imported is a Virtual Machine Extension ID
+func ImportVirtualMachineExtensionID(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	return importResourceIDFormat(d, func(input string) error {
+		_, err := ParseVirtualMachineExtensionID(input)
+		return err
+	})
+}
+
+const virtualMachineRunCommandIDFormat = "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Compute/virtualMachines/{virtualMachine}/runCommands/{name}"
+
+// VirtualMachineRunCommandID is the ID of a Virtual Machine Run Command in the format `/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Compute/virtualMachines/{virtualMachine}/runCommands/{name}`
+type VirtualMachineRunCommandID struct {
+	Subscription   string
+	ResourceGroup  string
+	VirtualMachine string
+	Name           string
+}
+
+// NewVirtualMachineRunCommandID returns a VirtualMachineRunCommandID for the specified segments
+func NewVirtualMachineRunCommandID(subscription, resourceGroup, virtualMachine, name string) VirtualMachineRunCommandID {
+	return VirtualMachineRunCommandID{
+		Subscription:   subscription,
+		ResourceGroup:  resourceGroup,
+		VirtualMachine: virtualMachine,
+		Name:           name,
+	}
+}
+
+// ParseVirtualMachineRunCommandID parses the specified Resource ID as a VirtualMachineRunCommandID
+func ParseVirtualMachineRunCommandID(input string) (*VirtualMachineRunCommandID, error) {
+	segments, err := parseResourceIDFormat(virtualMachineRunCommandIDFormat, input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a Virtual Machine Run Command ID: %+v", input, err)
+	}
+
+	return &VirtualMachineRunCommandID{
+		Subscription:   segments[0],
+		ResourceGroup:  segments[1],
+		VirtualMachine: segments[2],
+		Name:           segments[3],
+	}, nil
+}
+
+// String returns the Virtual Machine Run Command ID in the canonical casing
+func (id VirtualMachineRunCommandID) String() string {
+	return formatResourceID(virtualMachineRunCommandIDFormat, id.Subscription, id.ResourceGroup, id.VirtualMachine, id.Name)
+}
+
+// ValidateVirtualMachineRunCommandID is a SchemaValidateFunc which validates that the value is a Virtual Machine Run Command ID
+func ValidateVirtualMachineRunCommandID(i interface{}, k string) ([]string, []error) {
+	return validateResourceIDFormat(i, k, func(input string) error {
+		_, err := ParseVirtualMachineRunCommandID(input)
+		return err
+	})
+}
+
+// ImportVirtualMachineRunCommandID is a StateFunc which validates that the ID being imported is a Virtual Machine Run Command ID
+func ImportVirtualMachineRunCommandID(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	return importResourceIDFormat(d, func(input string) error {
+		_, err := ParseVirtualMachineRunCommandID(input)
+		return err
+	})
+}
+
+const virtualMachineScaleSetIDFormat = "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Compute/virtualMachineScaleSets/{name}"
+
+// VirtualMachineScaleSetID is the ID of a Virtual Machine Scale Set in the format `/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Compute/virtualMachineScaleSets/{name}`
+type VirtualMachineScaleSetID struct {
+	Subscription  string
+	ResourceGroup string
+	Name          string
+}
+
+// NewVirtualMachineScaleSetID returns a VirtualMachineScaleSetID for the specified segments
+func NewVirtualMachineScaleSetID(subscription, resourceGroup, name string) VirtualMachineScaleSetID {
+	return VirtualMachineScaleSetID{
+		Subscription:  subscription,
+		ResourceGroup: resourceGroup,
+		Name:          name,
+	}
+}
+
+// ParseVirtualMachineScaleSetID parses the specified Resource ID as a VirtualMachineScaleSetID
+func ParseVirtualMachineScaleSetID(input string) (*VirtualMachineScaleSetID, error) {
+	segments, err := parseResourceIDFormat(virtualMachineScaleSetIDFormat, input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a Virtual Machine Scale Set ID: %+v", input, err)
+	}
+
+	return &VirtualMachineScaleSetID{
+		Subscription:  segments[0],
+		ResourceGroup: segments[1],
+		Name:          segments[2],
+	}, nil
+}
+
+// String returns the Virtual Machine Scale Set ID in the canonical casing
+func (id VirtualMachineScaleSetID) String() string {
+	return formatResourceID(virtualMachineScaleSetIDFormat, id.Subscription, id.ResourceGroup, id.Name)
+}
+
+// ValidateVirtualMachineScaleSetID is a SchemaValidateFunc which validates that the value is a Virtual Machine Scale Set ID
+func ValidateVirtualMachineScaleSetID(i interface{}, k string) ([]string, []error) {
+	return validateResourceIDFormat(i, k, func(input string) error {
+		_, err := ParseVirtualMachineScaleSetID(input)
+		return err
+	})
+}
+
+// ImportVirtualMachineScaleSetID is a StateFunc which validates that the ID being imported is a Virtual Machine Scale Set ID
+func ImportVirtualMachineScaleSetID(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	return importResourceIDFormat(d, func(input string) error {
+		_, err := ParseVirtualMachineScaleSetID(input)
+		return err
+	})
+}
+
+const virtualMachineScaleSetInstanceIDFormat = "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Compute/virtualMachineScaleSets/{virtualMachineScaleSet}/virtualMachines/{name}"
+
+// VirtualMachineScaleSetInstanceID is the ID of a Virtual Machine Scale Set Instance in the format `/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Compute/virtualMachineScaleSets/{virtualMachineScaleSet}/virtualMachines/{name}`
+type VirtualMachineScaleSetInstanceID struct {
+	Subscription           string
+	ResourceGroup          string
+	VirtualMachineScaleSet string
+	Name                   string
+}
+
+// NewVirtualMachineScaleSetInstanceID returns a VirtualMachineScaleSetInstanceID for the specified segments
+func NewVirtualMachineScaleSetInstanceID(subscription, resourceGroup, virtualMachineScaleSet, name string) VirtualMachineScaleSetInstanceID {
+	return VirtualMachineScaleSetInstanceID{
+		Subscription:           subscription,
+		ResourceGroup:          resourceGroup,
+		VirtualMachineScaleSet: virtualMachineScaleSet,
+		Name:                   name,
+	}
+}
+
+// ParseVirtualMachineScaleSetInstanceID parses the specified Resource ID as a VirtualMachineScaleSetInstanceID
+func ParseVirtualMachineScaleSetInstanceID(input string) (*VirtualMachineScaleSetInstanceID, error) {
+	segments, err := parseResourceIDFormat(virtualMachineScaleSetInstanceIDFormat, input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a Virtual Machine Scale Set Instance ID: %+v", input, err)
+	}
+
+	return &VirtualMachineScaleSetInstanceID{
+		Subscription:           segments[0],
+		ResourceGroup:          segments[1],
+		VirtualMachineScaleSet: segments[2],
+		Name:                   segments[3],
+	}, nil
+}
+
+// String returns the Virtual Machine Scale Set Instance ID in the canonical casing
+func (id VirtualMachineScaleSetInstanceID) String() string {
+	return formatResourceID(virtualMachineScaleSetInstanceIDFormat, id.Subscription, id.ResourceGroup, id.VirtualMachineScaleSet, id.Name)
+}
+
+// ValidateVirtualMachineScaleSetInstanceID is a SchemaValidateFunc which validates that the value is a Virtual Machine Scale Set Instance ID
+func ValidateVirtualMachineScaleSetInstanceID(i interface{}, k string) ([]string, []error) {
+	return validateResourceIDFormat(i, k, func(input string) error {
+		_, err := ParseVirtualMachineScaleSetInstanceID(input)
+		return err
+	})
+}
+
+// ImportVirtualMachineScaleSetInstanceID is a StateFunc which validates that the ID being imported is a Virtual Machine Scale Set Instance ID
+func ImportVirtualMachineScaleSetInstanceID(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	return importResourceIDFormat(d, func(input string) error {
+		_, err := ParseVirtualMachineScaleSetInstanceID(input)
+		return err
+	})
+}
+
+const virtualMachineScaleSetExtensionIDFormat = "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Compute/virtualMachineScaleSets/{virtualMachineScaleSet}/extensions/{name}"
+
+// VirtualMachineScaleSetExtensionID is the ID of a Virtual Machine Scale Set Extension in the format `/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Compute/virtualMachineScaleSets/{virtualMachineScaleSet}/extensions/{name}`
+type VirtualMachineScaleSetExtensionID struct {
+	Subscription           string
+	ResourceGroup          string
+	VirtualMachineScaleSet string
+	Name                   string
+}
+
+// NewVirtualMachineScaleSetExtensionID returns a VirtualMachineScaleSetExtensionID for the specified segments
+func NewVirtualMachineScaleSetExtensionID(subscription, resourceGroup, virtualMachineScaleSet, name string) VirtualMachineScaleSetExtensionID {
+	return VirtualMachineScaleSetExtensionID{
+		Subscription:           subscription,
+		ResourceGroup:          resourceGroup,
+		VirtualMachineScaleSet: virtualMachineScaleSet,
+		Name:                   name,
+	}
+}
+
+// ParseVirtualMachineScaleSetExtensionID parses the specified Resource ID as a VirtualMachineScaleSetExtensionID
+func ParseVirtualMachineScaleSetExtensionID(input string) (*VirtualMachineScaleSetExtensionID, error) {
+	segments, err := parseResourceIDFormat(virtualMachineScaleSetExtensionIDFormat, input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a Virtual Machine Scale Set Extension ID: %+v", input, err)
+	}
+
+	return &VirtualMachineScaleSetExtensionID{
+		Subscription:           segments[0],
+		ResourceGroup:          segments[1],
+		VirtualMachineScaleSet: segments[2],
+		Name:                   segments[3],
+	}, nil
+}
+
+// String returns the Virtual Machine Scale Set Extension ID in the canonical casing
+func (id VirtualMachineScaleSetExtensionID) String() string {
+	return formatResourceID(virtualMachineScaleSetExtensionIDFormat, id.Subscription, id.ResourceGroup, id.VirtualMachineScaleSet, id.Name)
+}
+
+// ValidateVirtualMachineScaleSetExtensionID is a SchemaValidateFunc which validates that the value is a Virtual Machine Scale Set Extension ID
+func ValidateVirtualMachineScaleSetExtensionID(i interface{}, k string) ([]string, []error) {
+	return validateResourceIDFormat(i, k, func(input string) error {
+		_, err := ParseVirtualMachineScaleSetExtensionID(input)
+		return err
+	})
+}
+
+// ImportVirtualMachineScaleSetExtensionID is a StateFunc which validates that the ID being imported is a Virtual Machine Scale Set Extension ID
+func ImportVirtualMachineScaleSetExtensionID(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	return importResourceIDFormat(d, func(input string) error {
+		_, err := ParseVirtualMachineScaleSetExtensionID(input)
+		return err
+	})
+}
+
+const containerGroupIDFormat = "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.ContainerInstance/containerGroups/{name}"
+
+// ContainerGroupID is the ID of a Container Group in the format `/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.ContainerInstance/containerGroups/{name}`
+type ContainerGroupID struct {
+	Subscription  string
+	ResourceGroup string
+	Name          string
+}
+
+// NewContainerGroupID returns a ContainerGroupID for the specified segments
+func NewContainerGroupID(subscription, resourceGroup, name string) ContainerGroupID {
+	return ContainerGroupID{
+		Subscription:  subscription,
+		ResourceGroup: resourceGroup,
+		Name:          name,
+	}
+}
+
+// ParseContainerGroupID parses the specified Resource ID as a ContainerGroupID
+func ParseContainerGroupID(input string) (*ContainerGroupID, error) {
+	segments, err := parseResourceIDFormat(containerGroupIDFormat, input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a Container Group ID: %+v", input, err)
+	}
+
+	return &ContainerGroupID{
+		Subscription:  segments[0],
+		ResourceGroup: segments[1],
+		Name:          segments[2],
+	}, nil
+}
+
+// String returns the Container Group ID in the canonical casing
+func (id ContainerGroupID) String() string {
+	return formatResourceID(containerGroupIDFormat, id.Subscription, id.ResourceGroup, id.Name)
+}
+
+// ValidateContainerGroupID is a SchemaValidateFunc which validates that the value is a Container Group ID
+func ValidateContainerGroupID(i interface{}, k string) ([]string, []error) {
+	return validateResourceIDFormat(i, k, func(input string) error {
+		_, err := ParseContainerGroupID(input)
+		return err
+	})
+}
+
+// ImportContainerGroupID is a StateFunc which validates that the ID being imported is a Container Group ID
+func ImportContainerGroupID(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	return importResourceIDFormat(d, func(input string) error {
+		_, err := ParseContainerGroupID(input)
+		return err
+	})
+}
+
+const containerRegistryIDFormat = "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.ContainerRegistry/registries/{name}"
+
+// ContainerRegistryID is the ID of a Container Registry in the format `/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.ContainerRegistry/registries/{name}`
+type ContainerRegistryID struct {
+	Subscription  string
+	ResourceGroup string
+	Name          string
+}
+
+// NewContainerRegistryID returns a ContainerRegistryID for the specified segments
+func NewContainerRegistryID(subscription, resourceGroup, name string) ContainerRegistryID {
+	return ContainerRegistryID{
+		Subscription:  subscription,
+		ResourceGroup: resourceGroup,
+		Name:          name,
+	}
+}
+
+// ParseContainerRegistryID parses the specified Resource ID as a ContainerRegistryID
+func ParseContainerRegistryID(input string) (*ContainerRegistryID, error) {
+	segments, err := parseResourceIDFormat(containerRegistryIDFormat, input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a Container Registry ID: %+v", input, err)
+	}
+
+	return &ContainerRegistryID{
+		Subscription:  segments[0],
+		ResourceGroup: segments[1],
+		Name:          segments[2],
+	}, nil
+}
+
+// String returns the Container Registry ID in the canonical casing
+func (id ContainerRegistryID) String() string {
+	return formatResourceID(containerRegistryIDFormat, id.Subscription, id.ResourceGroup, id.Name)
+}
+
+// ValidateContainerRegistryID is a SchemaValidateFunc which validates that the value is a Container Registry ID
+func ValidateContainerRegistryID(i interface{}, k string) ([]string, []error) {
+	return validateResourceIDFormat(i, k, func(input string) error {
+		_, err := ParseContainerRegistryID(input)
+		return err
+	})
+}
+
+// ImportContainerRegistryID is a StateFunc which validates that the ID being imported is a Container Registry ID
+func ImportContainerRegistryID(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	return importResourceIDFormat(d, func(input string) error {
+		_, err := ParseContainerRegistryID(input)
+		return err
+	})
+}
+
+const containerServiceIDFormat = "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.ContainerService/containerServices/{name}"
+
+// ContainerServiceID is the ID of a Container Service in the format `/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.ContainerService/containerServices/{name}`
+type ContainerServiceID struct {
+	Subscription  string
+	ResourceGroup string
+	Name          string
+}
+
+// NewContainerServiceID returns a ContainerServiceID for the specified segments
+func NewContainerServiceID(subscription, resourceGroup, name string) ContainerServiceID {
+	return ContainerServiceID{
+		Subscription:  subscription,
+		ResourceGroup: resourceGroup,
+		Name:          name,
+	}
+}
+
+// ParseContainerServiceID parses the specified Resource ID as a ContainerServiceID
+func ParseContainerServiceID(input string) (*ContainerServiceID, error) {
+	segments, err := parseResourceIDFormat(containerServiceIDFormat, input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a Container Service ID: %+v", input, err)
+	}
+
+	return &ContainerServiceID{
+		Subscription:  segments[0],
+		ResourceGroup: segments[1],
+		Name:          segments[2],
+	}, nil
+}
+
+// String returns the Container Service ID in the canonical casing
+func (id ContainerServiceID) String() string {
+	return formatResourceID(containerServiceIDFormat, id.Subscription, id.ResourceGroup, id.Name)
+}
+
+// ValidateContainerServiceID is a SchemaValidateFunc which validates that the value is a Container Service ID
+func ValidateContainerServiceID(i interface{}, k string) ([]string, []error) {
+	return validateResourceIDFormat(i, k, func(input string) error {
+		_, err := ParseContainerServiceID(input)
+		return err
+	})
+}
+
+// ImportContainerServiceID is a StateFunc which validates that the ID being imported is a Container Service ID
+func ImportContainerServiceID(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	return importResourceIDFormat(d, func(input string) error {
+		_, err := ParseContainerServiceID(input)
+		return err
+	})
+}
+
+const kubernetesClusterIDFormat = "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.ContainerService/managedClusters/{name}"
+
+// KubernetesClusterID is the ID of a Kubernetes Cluster in the format `/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.ContainerService/managedClusters/{name}`
+type KubernetesClusterID struct {
+	Subscription  string
+	ResourceGroup string
+	Name          string
+}
+
+// NewKubernetesClusterID returns a KubernetesClusterID for the specified segments
+func NewKubernetesClusterID(subscription, resourceGroup, name string) KubernetesClusterID {
+	return KubernetesClusterID{
+		Subscription:  subscription,
+		ResourceGroup: resourceGroup,
+		Name:          name,
+	}
+}
+
+// ParseKubernetesClusterID parses the specified Resource ID as a KubernetesClusterID
+func ParseKubernetesClusterID(input string) (*KubernetesClusterID, error) {
+	segments, err := parseResourceIDFormat(kubernetesClusterIDFormat, input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a Kubernetes Cluster ID: %+v", input, err)
+	}
+
+	return &KubernetesClusterID{
+		Subscription:  segments[0],
+		ResourceGroup: segments[1],
+		Name:          segments[2],
+	}, nil
+}
+
+// String returns the Kubernetes Cluster ID in the canonical casing
+func (id KubernetesClusterID) String() string {
+	return formatResourceID(kubernetesClusterIDFormat, id.Subscription, id.ResourceGroup, id.Name)
+}
+
+// ValidateKubernetesClusterID is a SchemaValidateFunc which validates that the value is a Kubernetes Cluster ID
+func ValidateKubernetesClusterID(i interface{}, k string) ([]string, []error) {
+	return validateResourceIDFormat(i, k, func(input string) error {
+		_, err := ParseKubernetesClusterID(input)
+		return err
+	})
+}
+
+// ImportKubernetesClusterID is a StateFunc which validates that the ID being imported is a Kubernetes Cluster ID
+func ImportKubernetesClusterID(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	return importResourceIDFormat(d, func(input string) error {
+		_, err := ParseKubernetesClusterID(input)
+		return err
+	})
+}
+
+const cosmosDBAccountIDFormat = "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.DocumentDB/databaseAccounts/{name}"
+
+// CosmosDBAccountID is the ID of a CosmosDB Account in the format `/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.DocumentDB/databaseAccounts/{name}`
+type CosmosDBAccountID struct {
+	Subscription  string
+	ResourceGroup string
+	Name          string
+}
+
+// NewCosmosDBAccountID returns a CosmosDBAccountID for the specified segments
+func NewCosmosDBAccountID(subscription, resourceGroup, name string) CosmosDBAccountID {
+	return CosmosDBAccountID{
+		Subscription:  subscription,
+		ResourceGroup: resourceGroup,
+		Name:          name,
+	}
+}
+
+// ParseCosmosDBAccountID parses the specified Resource ID as a CosmosDBAccountID
+func ParseCosmosDBAccountID(input string) (*CosmosDBAccountID, error) {
+	segments, err := parseResourceIDFormat(cosmosDBAccountIDFormat, input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a CosmosDB Account ID: %+v", input, err)
+	}
+
+	return &CosmosDBAccountID{
+		Subscription:  segments[0],
+		ResourceGroup: segments[1],
+		Name:          segments[2],
+	}, nil
+}
+
+// String returns the CosmosDB Account ID in the canonical casing
+func (id CosmosDBAccountID) String() string {
+	return formatResourceID(cosmosDBAccountIDFormat, id.Subscription, id.ResourceGroup, id.Name)
+}
+
+// ValidateCosmosDBAccountID is a SchemaValidateFunc which validates that the value is a CosmosDB Account ID
+func ValidateCosmosDBAccountID(i interface{}, k string) ([]string, []error) {
+	return validateResourceIDFormat(i, k, func(input string) error {
+		_, err := ParseCosmosDBAccountID(input)
+		return err
+	})
+}
+
+// ImportCosmosDBAccountID is a StateFunc which validates that the ID being imported is a CosmosDB Account ID
+func ImportCosmosDBAccountID(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	return importResourceIDFormat(d, func(input string) error {
+		_, err := ParseCosmosDBAccountID(input)
+		return err
+	})
+}
+
+const dataLakeAnalyticsAccountIDFormat = "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.DataLakeAnalytics/accounts/{name}"
+
+// DataLakeAnalyticsAccountID is the ID of a Data Lake Analytics Account in the format `/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.DataLakeAnalytics/accounts/{name}`
+type DataLakeAnalyticsAccountID struct {
+	Subscription  string
+	ResourceGroup string
+	Name          string
+}
+
+// NewDataLakeAnalyticsAccountID returns a DataLakeAnalyticsAccountID for the specified segments
+func NewDataLakeAnalyticsAccountID(subscription, resourceGroup, name string) DataLakeAnalyticsAccountID {
+	return DataLakeAnalyticsAccountID{
+		Subscription:  subscription,
+		ResourceGroup: resourceGroup,
+		Name:          name,
+	}
+}
+
+// ParseDataLakeAnalyticsAccountID parses the specified Resource ID as a DataLakeAnalyticsAccountID
+func ParseDataLakeAnalyticsAccountID(input string) (*DataLakeAnalyticsAccountID, error) {
+	segments, err := parseResourceIDFormat(dataLakeAnalyticsAccountIDFormat, input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a Data Lake Analytics Account ID: %+v", input, err)
+	}
+
+	return &DataLakeAnalyticsAccountID{
+		Subscription:  segments[0],
+		ResourceGroup: segments[1],
+		Name:          segments[2],
+	}, nil
+}
+
+// String returns the Data Lake Analytics Account ID in the canonical casing
+func (id DataLakeAnalyticsAccountID) String() string {
+	return formatResourceID(dataLakeAnalyticsAccountIDFormat, id.Subscription, id.ResourceGroup, id.Name)
+}
+
+// ValidateDataLakeAnalyticsAccountID is a SchemaValidateFunc which validates that the value is a Data Lake Analytics Account ID
+func ValidateDataLakeAnalyticsAccountID(i interface{}, k string) ([]string, []error) {
+	return validateResourceIDFormat(i, k, func(input string) error {
+		_, err := ParseDataLakeAnalyticsAccountID(input)
+		return err
+	})
+}
+
+// ImportDataLakeAnalyticsAccountID is a StateFunc which validates that the ID being imported is a Data Lake Analytics Account ID
+func ImportDataLakeAnalyticsAccountID(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	return importResourceIDFormat(d, func(input string) error {
+		_, err := ParseDataLakeAnalyticsAccountID(input)
+		return err
+	})
+}
+
+const dataLakeAnalyticsFirewallRuleIDFormat = "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.DataLakeAnalytics/accounts/{account}/firewallRules/{name}"
+
+// DataLakeAnalyticsFirewallRuleID is the ID of a Data Lake Analytics Firewall Rule in the format `/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.DataLakeAnalytics/accounts/{account}/firewallRules/{name}`
+type DataLakeAnalyticsFirewallRuleID struct {
+	Subscription  string
+	ResourceGroup string
+	Account       string
+	Name          string
+}
+
+// NewDataLakeAnalyticsFirewallRuleID returns a DataLakeAnalyticsFirewallRuleID for the specified segments
+func NewDataLakeAnalyticsFirewallRuleID(subscription, resourceGroup, account, name string) DataLakeAnalyticsFirewallRuleID {
+	return DataLakeAnalyticsFirewallRuleID{
+		Subscription:  subscription,
+		ResourceGroup: resourceGroup,
+		Account:       account,
+		Name:          name,
+	}
+}
+
+// ParseDataLakeAnalyticsFirewallRuleID parses the specified Resource ID as a DataLakeAnalyticsFirewallRuleID
+func ParseDataLakeAnalyticsFirewallRuleID(input string) (*DataLakeAnalyticsFirewallRuleID, error) {
+	segments, err := parseResourceIDFormat(dataLakeAnalyticsFirewallRuleIDFormat, input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a Data Lake Analytics Firewall Rule ID: %+v", input, err)
+	}
+
+	return &DataLakeAnalyticsFirewallRuleID{
+		Subscription:  segments[0],
+		ResourceGroup: segments[1],
+		Account:       segments[2],
+		Name:          segments[3],
+	}, nil
+}
+
+// String returns the Data Lake Analytics Firewall Rule ID in the canonical casing
+func (id DataLakeAnalyticsFirewallRuleID) String() string {
+	return formatResourceID(dataLakeAnalyticsFirewallRuleIDFormat, id.Subscription, id.ResourceGroup, id.Account, id.Name)
+}
+
+// ValidateDataLakeAnalyticsFirewallRuleID is a SchemaValidateFunc which validates that the value is a Data Lake Analytics Firewall Rule ID
+func ValidateDataLakeAnalyticsFirewallRuleID(i interface{}, k string) ([]string, []error) {
+	return validateResourceIDFormat(i, k, func(input string) error {
+		_, err := ParseDataLakeAnalyticsFirewallRuleID(input)
+		return err
+	})
+}
+
+// ImportDataLakeAnalyticsFirewallRuleID is a StateFunc which validates that the ID being imported is a Data Lake Analytics Firewall Rule ID
+func ImportDataLakeAnalyticsFirewallRuleID(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	return importResourceIDFormat(d, func(input string) error {
+		_, err := ParseDataLakeAnalyticsFirewallRuleID(input)
+		return err
+	})
+}
+
+const dataLakeStoreIDFormat = "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.DataLakeStore/accounts/{name}"
+
+// DataLakeStoreID is the ID of a Data Lake Store in the format `/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.DataLakeStore/accounts/{name}`
+type DataLakeStoreID struct {
+	Subscription  string
+	ResourceGroup string
+	Name          string
+}
+
+// NewDataLakeStoreID returns a DataLakeStoreID for the specified segments
+func NewDataLakeStoreID(subscription, resourceGroup, name string) DataLakeStoreID {
+	return DataLakeStoreID{
+		Subscription:  subscription,
+		ResourceGroup: resourceGroup,
+		Name:          name,
+	}
+}
+
+// ParseDataLakeStoreID parses the specified Resource ID as a DataLakeStoreID
+func ParseDataLakeStoreID(input string) (*DataLakeStoreID, error) {
+	segments, err := parseResourceIDFormat(dataLakeStoreIDFormat, input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a Data Lake Store ID: %+v", input, err)
+	}
+
+	return &DataLakeStoreID{
+		Subscription:  segments[0],
+		ResourceGroup: segments[1],
+		Name:          segments[2],
+	}, nil
+}
+
+// String returns the Data Lake Store ID in the canonical casing
+func (id DataLakeStoreID) String() string {
+	return formatResourceID(dataLakeStoreIDFormat, id.Subscription, id.ResourceGroup, id.Name)
+}
+
+// ValidateDataLakeStoreID is a SchemaValidateFunc which validates that the value is a Data Lake Store ID
+func ValidateDataLakeStoreID(i interface{}, k string) ([]string, []error) {
+	return validateResourceIDFormat(i, k, func(input string) error {
+		_, err := ParseDataLakeStoreID(input)
+		return err
+	})
+}
+
+// ImportDataLakeStoreID is a StateFunc which validates that the ID being imported is a Data Lake Store ID
+func ImportDataLakeStoreID(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	return importResourceIDFormat(d, func(input string) error {
+		_, err := ParseDataLakeStoreID(input)
+		return err
+	})
+}
+
+const dataLakeStoreFirewallRuleIDFormat = "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.DataLakeStore/accounts/{account}/firewallRules/{name}"
+
+// DataLakeStoreFirewallRuleID is the ID of a Data Lake Store Firewall Rule in the format `/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.DataLakeStore/accounts/{account}/firewallRules/{name}`
+type DataLakeStoreFirewallRuleID struct {
+	Subscription  string
+	ResourceGroup string
+	Account       string
+	Name          string
+}
+
+// NewDataLakeStoreFirewallRuleID returns a DataLakeStoreFirewallRuleID for the specified segments
+func NewDataLakeStoreFirewallRuleID(subscription, resourceGroup, account, name string) DataLakeStoreFirewallRuleID {
+	return DataLakeStoreFirewallRuleID{
+		Subscription:  subscription,
+		ResourceGroup: resourceGroup,
+		Account:       account,
+		Name:          name,
+	}
+}
+
+// ParseDataLakeStoreFirewallRuleID parses the specified Resource ID as a DataLakeStoreFirewallRuleID
+func ParseDataLakeStoreFirewallRuleID(input string) (*DataLakeStoreFirewallRuleID, error) {
+	segments, err := parseResourceIDFormat(dataLakeStoreFirewallRuleIDFormat, input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a Data Lake Store Firewall Rule ID: %+v", input, err)
+	}
+
+	return &DataLakeStoreFirewallRuleID{
+		Subscription:  segments[0],
+		ResourceGroup: segments[1],
+		Account:       segments[2],
+		Name:          segments[3],
+	}, nil
+}
+
+// String returns the Data Lake Store Firewall Rule ID in the canonical casing
+func (id DataLakeStoreFirewallRuleID) String() string {
+	return formatResourceID(dataLakeStoreFirewallRuleIDFormat, id.Subscription, id.ResourceGroup, id.Account, id.Name)
+}
+
+// ValidateDataLakeStoreFirewallRuleID is a SchemaValidateFunc which validates that the value is a Data Lake Store Firewall Rule ID
+func ValidateDataLakeStoreFirewallRuleID(i interface{}, k string) ([]string, []error) {
+	return validateResourceIDFormat(i, k, func(input string) error {
+		_, err := ParseDataLakeStoreFirewallRuleID(input)
+		return err
+	})
+}
+
+// ImportDataLakeStoreFirewallRuleID is a StateFunc which validates that the ID being imported is a Data Lake Store Firewall Rule ID
+func ImportDataLakeStoreFirewallRuleID(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	return importResourceIDFormat(d, func(input string) error {
+		_, err := ParseDataLakeStoreFirewallRuleID(input)
+		return err
+	})
+}
+
+const devTestLabIDFormat = "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.DevTestLab/labs/{name}"
+
+// DevTestLabID is the ID of a DevTest Lab in the format `/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.DevTestLab/labs/{name}`
+type DevTestLabID struct {
+	Subscription  string
+	ResourceGroup string
+	Name          string
+}
+
+// NewDevTestLabID returns a DevTestLabID for the specified segments
+func NewDevTestLabID(subscription, resourceGroup, name string) DevTestLabID {
+	return DevTestLabID{
+		Subscription:  subscription,
+		ResourceGroup: resourceGroup,
+		Name:          name,
+	}
+}
+
+// ParseDevTestLabID parses the specified Resource ID as a DevTestLabID
+func ParseDevTestLabID(input string) (*DevTestLabID, error) {
+	segments, err := parseResourceIDFormat(devTestLabIDFormat, input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a DevTest Lab ID: %+v", input, err)
+	}
+
+	return &DevTestLabID{
+		Subscription:  segments[0],
+		ResourceGroup: segments[1],
+		Name:          segments[2],
+	}, nil
+}
+
+// String returns the DevTest Lab ID in the canonical casing
+func (id DevTestLabID) String() string {
+	return formatResourceID(devTestLabIDFormat, id.Subscription, id.ResourceGroup, id.Name)
+}
+
+// ValidateDevTestLabID is a SchemaValidateFunc which validates that the value is a DevTest Lab ID
+func ValidateDevTestLabID(i interface{}, k string) ([]string, []error) {
+	return validateResourceIDFormat(i, k, func(input string) error {
+		_, err := ParseDevTestLabID(input)
+		return err
+	})
+}
+
+// ImportDevTestLabID is a StateFunc which validates that the ID being imported is a DevTest Lab ID
+func ImportDevTestLabID(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	return importResourceIDFormat(d, func(input string) error {
+		_, err := ParseDevTestLabID(input)
+		return err
+	})
+}
+
+const devTestVirtualNetworkIDFormat = "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.DevTestLab/labs/{lab}/virtualnetworks/{name}"
+
+// DevTestVirtualNetworkID is the ID of a DevTest Virtual Network in the format `/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.DevTestLab/labs/{lab}/virtualnetworks/{name}`
+type DevTestVirtualNetworkID struct {
+	Subscription  string
+	ResourceGroup string
+	Lab           string
+	Name          string
+}
+
+// NewDevTestVirtualNetworkID returns a DevTestVirtualNetworkID for the specified segments
+func NewDevTestVirtualNetworkID(subscription, resourceGroup, lab, name string) DevTestVirtualNetworkID {
+	return DevTestVirtualNetworkID{
+		Subscription:  subscription,
+		ResourceGroup: resourceGroup,
+		Lab:           lab,
+		Name:          name,
+	}
+}
+
+// ParseDevTestVirtualNetworkID parses the specified Resource ID as a DevTestVirtualNetworkID
+func ParseDevTestVirtualNetworkID(input string) (*DevTestVirtualNetworkID, error) {
+	segments, err := parseResourceIDFormat(devTestVirtualNetworkIDFormat, input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a DevTest Virtual Network ID: %+v", input, err)
+	}
+
+	return &DevTestVirtualNetworkID{
+		Subscription:  segments[0],
+		ResourceGroup: segments[1],
+		Lab:           segments[2],
+		Name:          segments[3],
+	}, nil
+}
+
+// String returns the DevTest Virtual Network ID in the canonical casing
+func (id DevTestVirtualNetworkID) String() string {
+	return formatResourceID(devTestVirtualNetworkIDFormat, id.Subscription, id.ResourceGroup, id.Lab, id.Name)
+}
+
+// ValidateDevTestVirtualNetworkID is a SchemaValidateFunc which validates that the value is a DevTest Virtual Network ID
+func ValidateDevTestVirtualNetworkID(i interface{}, k string) ([]string, []error) {
+	return validateResourceIDFormat(i, k, func(input string) error {
+		_, err := ParseDevTestVirtualNetworkID(input)
+		return err
+	})
+}
+
+// ImportDevTestVirtualNetworkID is a StateFunc which validates that the ID being imported is a DevTest Virtual Network ID
+func ImportDevTestVirtualNetworkID(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	return importResourceIDFormat(d, func(input string) error {
+		_, err := ParseDevTestVirtualNetworkID(input)
+		return err
+	})
+}
+
+const dnsZoneIDFormat = "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/dnszones/{name}"
+
+// DnsZoneID is the ID of a DNS Zone in the format `/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/dnszones/{name}`
+type DnsZoneID struct {
+	Subscription  string
+	ResourceGroup string
+	Name          string
+}
+
+// NewDnsZoneID returns a DnsZoneID for the specified segments
+func NewDnsZoneID(subscription, resourceGroup, name string) DnsZoneID {
+	return DnsZoneID{
+		Subscription:  subscription,
+		ResourceGroup: resourceGroup,
+		Name:          name,
+	}
+}
+
+// ParseDnsZoneID parses the specified Resource ID as a DnsZoneID
+func ParseDnsZoneID(input string) (*DnsZoneID, error) {
+	segments, err := parseResourceIDFormat(dnsZoneIDFormat, input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a DNS Zone ID: %+v", input, err)
+	}
+
+	return &DnsZoneID{
+		Subscription:  segments[0],
+		ResourceGroup: segments[1],
+		Name:          segments[2],
+	}, nil
+}
+
+// String returns the DNS Zone ID in the canonical casing
+func (id DnsZoneID) String() string {
+	return formatResourceID(dnsZoneIDFormat, id.Subscription, id.ResourceGroup, id.Name)
+}
+
+// ValidateDnsZoneID is a SchemaValidateFunc which validates that the value is a DNS Zone ID
+func ValidateDnsZoneID(i interface{}, k string) ([]string, []error) {
+	return validateResourceIDFormat(i, k, func(input string) error {
+		_, err := ParseDnsZoneID(input)
+		return err
+	})
+}
+
+// ImportDnsZoneID is a StateFunc which validates that the ID being imported is a DNS Zone ID
+func ImportDnsZoneID(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	return importResourceIDFormat(d, func(input string) error {
+		_, err := ParseDnsZoneID(input)
+		return err
+	})
+}
+
+const dnsARecordIDFormat = "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/dnszones/{dnsZone}/A/{name}"
+
+// DnsARecordID is the ID of a DNS A Record in the format `/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/dnszones/{dnsZone}/A/{name}`
+type DnsARecordID struct {
+	Subscription  string
+	ResourceGroup string
+	DnsZone       string
+	Name          string
+}
+
+// NewDnsARecordID returns a DnsARecordID for the specified segments
+func NewDnsARecordID(subscription, resourceGroup, dnsZone, name string) DnsARecordID {
+	return DnsARecordID{
+		Subscription:  subscription,
+		ResourceGroup: resourceGroup,
+		DnsZone:       dnsZone,
+		Name:          name,
+	}
+}
+
+// ParseDnsARecordID parses the specified Resource ID as a DnsARecordID
+func ParseDnsARecordID(input string) (*DnsARecordID, error) {
+	segments, err := parseResourceIDFormat(dnsARecordIDFormat, input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a DNS A Record ID: %+v", input, err)
+	}
+
+	return &DnsARecordID{
+		Subscription:  segments[0],
+		ResourceGroup: segments[1],
+		DnsZone:       segments[2],
+		Name:          segments[3],
+	}, nil
+}
+
+// String returns the DNS A Record ID in the canonical casing
+func (id DnsARecordID) String() string {
+	return formatResourceID(dnsARecordIDFormat, id.Subscription, id.ResourceGroup, id.DnsZone, id.Name)
+}
+
+// ValidateDnsARecordID is a SchemaValidateFunc which validates that the value is a DNS A Record ID
+func ValidateDnsARecordID(i interface{}, k string) ([]string, []error) {
+	return validateResourceIDFormat(i, k, func(input string) error {
+		_, err := ParseDnsARecordID(input)
+		return err
+	})
+}
+
+// ImportDnsARecordID is a StateFunc which validates that the ID being imported is a DNS A Record ID
+func ImportDnsARecordID(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	return importResourceIDFormat(d, func(input string) error {
+		_, err := ParseDnsARecordID(input)
+		return err
+	})
+}
+
+const dnsAAAARecordIDFormat = "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/dnszones/{dnsZone}/AAAA/{name}"
+
+// DnsAAAARecordID is the ID of a DNS AAAA Record in the format `/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/dnszones/{dnsZone}/AAAA/{name}`
+type DnsAAAARecordID struct {
+	Subscription  string
+	ResourceGroup string
+	DnsZone       string
+	Name          string
+}
+
+// NewDnsAAAARecordID returns a DnsAAAARecordID for the specified segments
+func NewDnsAAAARecordID(subscription, resourceGroup, dnsZone, name string) DnsAAAARecordID {
+	return DnsAAAARecordID{
+		Subscription:  subscription,
+		ResourceGroup: resourceGroup,
+		DnsZone:       dnsZone,
+		Name:          name,
+	}
+}
+
+// ParseDnsAAAARecordID parses the specified Resource ID as a DnsAAAARecordID
+func ParseDnsAAAARecordID(input string) (*DnsAAAARecordID, error) {
+	segments, err := parseResourceIDFormat(dnsAAAARecordIDFormat, input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a DNS AAAA Record ID: %+v", input, err)
+	}
+
+	return &DnsAAAARecordID{
+		Subscription:  segments[0],
+		ResourceGroup: segments[1],
+		DnsZone:       segments[2],
+		Name:          segments[3],
+	}, nil
+}
+
+// String returns the DNS AAAA Record ID in the canonical casing
+func (id DnsAAAARecordID) String() string {
+	return formatResourceID(dnsAAAARecordIDFormat, id.Subscription, id.ResourceGroup, id.DnsZone, id.Name)
+}
+
+// ValidateDnsAAAARecordID is a SchemaValidateFunc which validates that the value is a DNS AAAA Record ID
+func ValidateDnsAAAARecordID(i interface{}, k string) ([]string, []error) {
+	return validateResourceIDFormat(i, k, func(input string) error {
+		_, err := ParseDnsAAAARecordID(input)
+		return err
+	})
+}
+
+// ImportDnsAAAARecordID is a StateFunc which validates that the ID being imported is a DNS AAAA Record ID
+func ImportDnsAAAARecordID(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	return importResourceIDFormat(d, func(input string) error {
+		_, err := ParseDnsAAAARecordID(input)
+		return err
+	})
+}
+
+const dnsCAARecordIDFormat = "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/dnszones/{dnsZone}/CAA/{name}"
+
+// DnsCAARecordID is the ID of a DNS CAA Record in the format `/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/dnszones/{dnsZone}/CAA/{name}`
+type DnsCAARecordID struct {
+	Subscription  string
+	ResourceGroup string
+	DnsZone       string
+	Name          string
+}
+
+// NewDnsCAARecordID returns a DnsCAARecordID for the specified segments
+func NewDnsCAARecordID(subscription, resourceGroup, dnsZone, name string) DnsCAARecordID {
+	return DnsCAARecordID{
+		Subscription:  subscription,
+		ResourceGroup: resourceGroup,
+		DnsZone:       dnsZone,
+		Name:          name,
+	}
+}
+
+// ParseDnsCAARecordID parses the specified Resource ID as a DnsCAARecordID
+func ParseDnsCAARecordID(input string) (*DnsCAARecordID, error) {
+	segments, err := parseResourceIDFormat(dnsCAARecordIDFormat, input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a DNS CAA Record ID: %+v", input, err)
+	}
+
+	return &DnsCAARecordID{
+		Subscription:  segments[0],
+		ResourceGroup: segments[1],
+		DnsZone:       segments[2],
+		Name:          segments[3],
+	}, nil
+}
+
+// String returns the DNS CAA Record ID in the canonical casing
+func (id DnsCAARecordID) String() string {
+	return formatResourceID(dnsCAARecordIDFormat, id.Subscription, id.ResourceGroup, id.DnsZone, id.Name)
+}
+
+// ValidateDnsCAARecordID is a SchemaValidateFunc which validates that the value is a DNS CAA Record ID
+func ValidateDnsCAARecordID(i interface{}, k string) ([]string, []error) {
+	return validateResourceIDFormat(i, k, func(input string) error {
+		_, err := ParseDnsCAARecordID(input)
+		return err
+	})
+}
+
+// ImportDnsCAARecordID is a StateFunc which validates that the ID being imported is a DNS CAA Record ID
+func ImportDnsCAARecordID(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	return importResourceIDFormat(d, func(input string) error {
+		_, err := ParseDnsCAARecordID(input)
+		return err
+	})
+}
+
+const dnsCNameRecordIDFormat = "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/dnszones/{dnsZone}/CNAME/{name}"
+
+// DnsCNameRecordID is the ID of a DNS CNAME Record in the format `/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/dnszones/{dnsZone}/CNAME/{name}`
+type DnsCNameRecordID struct {
+	Subscription  string
+	ResourceGroup string
+	DnsZone       string
+	Name          string
+}
+
+// NewDnsCNameRecordID returns a DnsCNameRecordID for the specified segments
+func NewDnsCNameRecordID(subscription, resourceGroup, dnsZone, name string) DnsCNameRecordID {
+	return DnsCNameRecordID{
+		Subscription:  subscription,
+		ResourceGroup: resourceGroup,
+		DnsZone:       dnsZone,
+		Name:          name,
+	}
+}
+
+// ParseDnsCNameRecordID parses the specified Resource ID as a DnsCNameRecordID
+func ParseDnsCNameRecordID(input string) (*DnsCNameRecordID, error) {
+	segments, err := parseResourceIDFormat(dnsCNameRecordIDFormat, input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a DNS CNAME Record ID: %+v", input, err)
+	}
+
+	return &DnsCNameRecordID{
+		Subscription:  segments[0],
+		ResourceGroup: segments[1],
+		DnsZone:       segments[2],
+		Name:          segments[3],
+	}, nil
+}
+
+// String returns the DNS CNAME Record ID in the canonical casing
+func (id DnsCNameRecordID) String() string {
+	return formatResourceID(dnsCNameRecordIDFormat, id.Subscription, id.ResourceGroup, id.DnsZone, id.Name)
+}
+
+// ValidateDnsCNameRecordID is a SchemaValidateFunc which validates that the value is a DNS CNAME Record ID
+func ValidateDnsCNameRecordID(i interface{}, k string) ([]string, []error) {
+	return validateResourceIDFormat(i, k, func(input string) error {
+		_, err := ParseDnsCNameRecordID(input)
+		return err
+	})
+}
+
+// ImportDnsCNameRecordID is a StateFunc which validates that the ID being imported is a DNS CNAME Record ID
+func ImportDnsCNameRecordID(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	return importResourceIDFormat(d, func(input string) error {
+		_, err := ParseDnsCNameRecordID(input)
+		return err
+	})
+}
+
+const dnsMxRecordIDFormat = "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/dnszones/{dnsZone}/MX/{name}"
+
+// DnsMxRecordID is the ID of a DNS MX Record in the format `/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/dnszones/{dnsZone}/MX/{name}`
+type DnsMxRecordID struct {
+	Subscription  string
+	ResourceGroup string
+	DnsZone       string
+	Name          string
+}
+
+// NewDnsMxRecordID returns a DnsMxRecordID for the specified segments
+func NewDnsMxRecordID(subscription, resourceGroup, dnsZone, name string) DnsMxRecordID {
+	return DnsMxRecordID{
+		Subscription:  subscription,
+		ResourceGroup: resourceGroup,
+		DnsZone:       dnsZone,
+		Name:          name,
+	}
+}
+
+// ParseDnsMxRecordID parses the specified Resource ID as a DnsMxRecordID
+func ParseDnsMxRecordID(input string) (*DnsMxRecordID, error) {
+	segments, err := parseResourceIDFormat(dnsMxRecordIDFormat, input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a DNS MX Record ID: %+v", input, err)
+	}
+
+	return &DnsMxRecordID{
+		Subscription:  segments[0],
+		ResourceGroup: segments[1],
+		DnsZone:       segments[2],
+		Name:          segments[3],
+	}, nil
+}
+
+// String returns the DNS MX Record ID in the canonical casing
+func (id DnsMxRecordID) String() string {
+	return formatResourceID(dnsMxRecordIDFormat, id.Subscription, id.ResourceGroup, id.DnsZone, id.Name)
+}
+
+// ValidateDnsMxRecordID is a SchemaValidateFunc which validates that the value is a DNS MX Record ID
+func ValidateDnsMxRecordID(i interface{}, k string) ([]string, []error) {
+	return validateResourceIDFormat(i, k, func(input string) error {
+		_, err := ParseDnsMxRecordID(input)
+		return err
+	})
+}
+
+// ImportDnsMxRecordID is a StateFunc which validates that the ID being imported is a DNS MX Record ID
+func ImportDnsMxRecordID(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	return importResourceIDFormat(d, func(input string) error {
+		_, err := ParseDnsMxRecordID(input)
+		return err
+	})
+}
+
+const dnsNsRecordIDFormat = "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/dnszones/{dnsZone}/NS/{name}"
+
+// DnsNsRecordID is the ID of a DNS NS Record in the format `/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/dnszones/{dnsZone}/NS/{name}`
+type DnsNsRecordID struct {
+	Subscription  string
+	ResourceGroup string
+	DnsZone       string
+	Name          string
+}
+
+// NewDnsNsRecordID returns a DnsNsRecordID for the specified segments
+func NewDnsNsRecordID(subscription, resourceGroup, dnsZone, name string) DnsNsRecordID {
+	return DnsNsRecordID{
+		Subscription:  subscription,
+		ResourceGroup: resourceGroup,
+		DnsZone:       dnsZone,
+		Name:          name,
+	}
+}
+
+// ParseDnsNsRecordID parses the specified Resource ID as a DnsNsRecordID
+func ParseDnsNsRecordID(input string) (*DnsNsRecordID, error) {
+	segments, err := parseResourceIDFormat(dnsNsRecordIDFormat, input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a DNS NS Record ID: %+v", input, err)
+	}
+
+	return &DnsNsRecordID{
+		Subscription:  segments[0],
+		ResourceGroup: segments[1],
+		DnsZone:       segments[2],
+		Name:          segments[3],
+	}, nil
+}
+
+// String returns the DNS NS Record ID in the canonical casing
+func (id DnsNsRecordID) String() string {
+	return formatResourceID(dnsNsRecordIDFormat, id.Subscription, id.ResourceGroup, id.DnsZone, id.Name)
+}
+
+// ValidateDnsNsRecordID is a SchemaValidateFunc which validates that the value is a DNS NS Record ID
+func ValidateDnsNsRecordID(i interface{}, k string) ([]string, []error) {
+	return validateResourceIDFormat(i, k, func(input string) error {
+		_, err := ParseDnsNsRecordID(input)
+		return err
+	})
+}
+
+// ImportDnsNsRecordID is a StateFunc which validates that the ID being imported is a DNS NS Record ID
+func ImportDnsNsRecordID(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	return importResourceIDFormat(d, func(input string) error {
+		_, err := ParseDnsNsRecordID(input)
+		return err
+	})
+}
+
+const dnsPtrRecordIDFormat = "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/dnszones/{dnsZone}/PTR/{name}"
+
+// DnsPtrRecordID is the ID of a DNS PTR Record in the format `/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/dnszones/{dnsZone}/PTR/{name}`
+type DnsPtrRecordID struct {
+	Subscription  string
+	ResourceGroup string
+	DnsZone       string
+	Name          string
+}
+
+// NewDnsPtrRecordID returns a DnsPtrRecordID for the specified segments
+func NewDnsPtrRecordID(subscription, resourceGroup, dnsZone, name string) DnsPtrRecordID {
+	return DnsPtrRecordID{
+		Subscription:  subscription,
+		ResourceGroup: resourceGroup,
+		DnsZone:       dnsZone,
+		Name:          name,
+	}
+}
+
+// ParseDnsPtrRecordID parses the specified Resource ID as a DnsPtrRecordID
+func ParseDnsPtrRecordID(input string) (*DnsPtrRecordID, error) {
+	segments, err := parseResourceIDFormat(dnsPtrRecordIDFormat, input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a DNS PTR Record ID: %+v", input, err)
+	}
+
+	return &DnsPtrRecordID{
+		Subscription:  segments[0],
+		ResourceGroup: segments[1],
+		DnsZone:       segments[2],
+		Name:          segments[3],
+	}, nil
+}
+
+// String returns the DNS PTR Record ID in the canonical casing
+func (id DnsPtrRecordID) String() string {
+	return formatResourceID(dnsPtrRecordIDFormat, id.Subscription, id.ResourceGroup, id.DnsZone, id.Name)
+}
+
+// ValidateDnsPtrRecordID is a SchemaValidateFunc which validates that the value is a DNS PTR Record ID
+func ValidateDnsPtrRecordID(i interface{}, k string) ([]string, []error) {
+	return validateResourceIDFormat(i, k, func(input string) error {
+		_, err := ParseDnsPtrRecordID(input)
+		return err
+	})
+}
+
+// ImportDnsPtrRecordID is a StateFunc which validates that the ID being imported is a DNS PTR Record ID
+func ImportDnsPtrRecordID(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	return importResourceIDFormat(d, func(input string) error {
+		_, err := ParseDnsPtrRecordID(input)
+		return err
+	})
+}
+
+const dnsSrvRecordIDFormat = "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/dnszones/{dnsZone}/SRV/{name}"
+
+// DnsSrvRecordID is the ID of a DNS SRV Record in the format `/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/dnszones/{dnsZone}/SRV/{name}`
+type DnsSrvRecordID struct {
+	Subscription  string
+	ResourceGroup string
+	DnsZone       string
+	Name          string
+}
+
+// NewDnsSrvRecordID returns a DnsSrvRecordID for the specified segments
+func NewDnsSrvRecordID(subscription, resourceGroup, dnsZone, name string) DnsSrvRecordID {
+	return DnsSrvRecordID{
+		Subscription:  subscription,
+		ResourceGroup: resourceGroup,
+		DnsZone:       dnsZone,
+		Name:          name,
+	}
+}
+
+// ParseDnsSrvRecordID parses the specified Resource ID as a DnsSrvRecordID
+func ParseDnsSrvRecordID(input string) (*DnsSrvRecordID, error) {
+	segments, err := parseResourceIDFormat(dnsSrvRecordIDFormat, input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a DNS SRV Record ID: %+v", input, err)
+	}
+
+	return &DnsSrvRecordID{
+		Subscription:  segments[0],
+		ResourceGroup: segments[1],
+		DnsZone:       segments[2],
+		Name:          segments[3],
+	}, nil
+}
+
+// String returns the DNS SRV Record ID in the canonical casing
+func (id DnsSrvRecordID) String() string {
+	return formatResourceID(dnsSrvRecordIDFormat, id.Subscription, id.ResourceGroup, id.DnsZone, id.Name)
+}
+
+// ValidateDnsSrvRecordID is a SchemaValidateFunc which validates that the value is a DNS SRV Record ID
+func ValidateDnsSrvRecordID(i interface{}, k string) ([]string, []error) {
+	return validateResourceIDFormat(i, k, func(input string) error {
+		_, err := ParseDnsSrvRecordID(input)
+		return err
+	})
+}
+
+// ImportDnsSrvRecordID is a StateFunc which validates that the ID being imported is a DNS SRV Record ID
+func ImportDnsSrvRecordID(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	return importResourceIDFormat(d, func(input string) error {
+		_, err := ParseDnsSrvRecordID(input)
+		return err
+	})
+}
+
+const dnsTxtRecordIDFormat = "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/dnszones/{dnsZone}/TXT/{name}"
+
+// DnsTxtRecordID is the ID of a DNS TXT Record in the format `/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/dnszones/{dnsZone}/TXT/{name}`
+type DnsTxtRecordID struct {
+	Subscription  string
+	ResourceGroup string
+	DnsZone       string
+	Name          string
+}
+
+// NewDnsTxtRecordID returns a DnsTxtRecordID for the specified segments
+func NewDnsTxtRecordID(subscription, resourceGroup, dnsZone, name string) DnsTxtRecordID {
+	return DnsTxtRecordID{
+		Subscription:  subscription,
+		ResourceGroup: resourceGroup,
+		DnsZone:       dnsZone,
+		Name:          name,
+	}
+}
+
+// ParseDnsTxtRecordID parses the specified Resource ID as a DnsTxtRecordID
+func ParseDnsTxtRecordID(input string) (*DnsTxtRecordID, error) {
+	segments, err := parseResourceIDFormat(dnsTxtRecordIDFormat, input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a DNS TXT Record ID: %+v", input, err)
+	}
+
+	return &DnsTxtRecordID{
+		Subscription:  segments[0],
+		ResourceGroup: segments[1],
+		DnsZone:       segments[2],
+		Name:          segments[3],
+	}, nil
+}
+
+// String returns the DNS TXT Record ID in the canonical casing
+func (id DnsTxtRecordID) String() string {
+	return formatResourceID(dnsTxtRecordIDFormat, id.Subscription, id.ResourceGroup, id.DnsZone, id.Name)
+}
+
+// ValidateDnsTxtRecordID is a SchemaValidateFunc which validates that the value is a DNS TXT Record ID
+func ValidateDnsTxtRecordID(i interface{}, k string) ([]string, []error) {
+	return validateResourceIDFormat(i, k, func(input string) error {
+		_, err := ParseDnsTxtRecordID(input)
+		return err
+	})
+}
+
+// ImportDnsTxtRecordID is a StateFunc which validates that the ID being imported is a DNS TXT Record ID
+func ImportDnsTxtRecordID(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	return importResourceIDFormat(d, func(input string) error {
+		_, err := ParseDnsTxtRecordID(input)
+		return err
+	})
+}
+
+const eventGridTopicIDFormat = "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.EventGrid/topics/{name}"
+
+// EventGridTopicID is the ID of an EventGrid Topic in the format `/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.EventGrid/topics/{name}`
+type EventGridTopicID struct {
+	Subscription  string
+	ResourceGroup string
+	Name          string
+}
+
+// NewEventGridTopicID returns an EventGridTopicID for the specified segments
+func NewEventGridTopicID(subscription, resourceGroup, name string) EventGridTopicID {
+	return EventGridTopicID{
+		Subscription:  subscription,
+		ResourceGroup: resourceGroup,
+		Name:          name,
+	}
+}
+
+// ParseEventGridTopicID parses the specified Resource ID as an EventGridTopicID
+func ParseEventGridTopicID(input string) (*EventGridTopicID, error) {
+	segments, err := parseResourceIDFormat(eventGridTopicIDFormat, input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as an EventGrid Topic ID: %+v", input, err)
+	}
+
+	return &EventGridTopicID{
+		Subscription:  segments[0],
+		ResourceGroup: segments[1],
+		Name:          segments[2],
+	}, nil
+}
+
+// String returns the EventGrid Topic ID in the canonical casing
+func (id EventGridTopicID) String() string {
+	return formatResourceID(eventGridTopicIDFormat, id.Subscription, id.ResourceGroup, id.Name)
+}
+
+// ValidateEventGridTopicID is a SchemaValidateFunc which validates that the value is an EventGrid Topic ID
+func ValidateEventGridTopicID(i interface{}, k string) ([]string, []error) {
+	return validateResourceIDFormat(i, k, func(input string) error {
+		_, err := ParseEventGridTopicID(input)
+		return err
+	})
+}
+
+// ImportEventGridTopicID is a StateFunc which validates that the ID being imported is an EventGrid Topic ID
+func ImportEventGridTopicID(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	return importResourceIDFormat(d, func(input string) error {
+		_, err := ParseEventGridTopicID(input)
+		return err
+	})
+}
+
+const eventHubIDFormat = "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.EventHub/namespaces/{namespace}/eventhubs/{name}"
+
+// EventHubID is the ID of an EventHub in the format `/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.EventHub/namespaces/{namespace}/eventhubs/{name}`
+type EventHubID struct {
+	Subscription  string
+	ResourceGroup string
+	Namespace     string
+	Name          string
+}
+
+// NewEventHubID returns an EventHubID for the specified segments
+func NewEventHubID(subscription, resourceGroup, namespace, name string) EventHubID {
+	return EventHubID{
+		Subscription:  subscription,
+		ResourceGroup: resourceGroup,
+		Namespace:     namespace,
+		Name:          name,
+	}
+}
+
+// ParseEventHubID parses the specified Resource ID as an EventHubID
+func ParseEventHubID(input string) (*EventHubID, error) {
+	segments, err := parseResourceIDFormat(eventHubIDFormat, input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as an EventHub ID: %+v", input, err)
+	}
+
+	return &EventHubID{
+		Subscription:  segments[0],
+		ResourceGroup: segments[1],
+		Namespace:     segments[2],
+		Name:          segments[3],
+	}, nil
+}
+
+// String returns the EventHub ID in the canonical casing
+func (id EventHubID) String() string {
+	return formatResourceID(eventHubIDFormat, id.Subscription, id.ResourceGroup, id.Namespace, id.Name)
+}
+
+// ValidateEventHubID is a SchemaValidateFunc which validates that the value is an EventHub ID
+func ValidateEventHubID(i interface{}, k string) ([]string, []error) {
+	return validateResourceIDFormat(i, k, func(input string) error {
+		_, err := ParseEventHubID(input)
+		return err
+	})
+}
+
+// ImportEventHubID is a StateFunc which validates that the ID being imported is an EventHub ID
+func ImportEventHubID(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	return importResourceIDFormat(d, func(input string) error {
+		_, err := ParseEventHubID(input)
+		return err
+	})
+}
+
+const eventHubAuthorizationRuleIDFormat = "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.EventHub/namespaces/{namespace}/eventhubs/{eventHub}/authorizationRules/{name}"
+
+// EventHubAuthorizationRuleID is the ID of an EventHub Authorization Rule in the format `/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.EventHub/namespaces/{namespace}/eventhubs/{eventHub}/authorizationRules/{name}`
+type EventHubAuthorizationRuleID struct {
+	Subscription  string
+	ResourceGroup string
+	Namespace     string
+	EventHub      string
+	Name          string
+}
+
+// NewEventHubAuthorizationRuleID returns an EventHubAuthorizationRuleID for the specified segments
+func NewEventHubAuthorizationRuleID(subscription, resourceGroup, namespace, eventHub, name string) EventHubAuthorizationRuleID {
+	return EventHubAuthorizationRuleID{
+		Subscription:  subscription,
+		ResourceGroup: resourceGroup,
+		Namespace:     namespace,
+		EventHub:      eventHub,
+		Name:          name,
+	}
+}
+
+// ParseEventHubAuthorizationRuleID parses the specified Resource ID as an EventHubAuthorizationRuleID
+func ParseEventHubAuthorizationRuleID(input string) (*EventHubAuthorizationRuleID, error) {
+	segments, err := parseResourceIDFormat(eventHubAuthorizationRuleIDFormat, input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as an EventHub Authorization Rule ID: %+v", input, err)
+	}
+
+	return &EventHubAuthorizationRuleID{
+		Subscription:  segments[0],
+		ResourceGroup: segments[1],
+		Namespace:     segments[2],
+		EventHub:      segments[3],
+		Name:          segments[4],
+	}, nil
+}
+
+// String returns the EventHub Authorization Rule ID in the canonical casing
+func (id EventHubAuthorizationRuleID) String() string {
+	return formatResourceID(eventHubAuthorizationRuleIDFormat, id.Subscription, id.ResourceGroup, id.Namespace, id.EventHub, id.Name)
+}
+
+// ValidateEventHubAuthorizationRuleID is a SchemaValidateFunc which validates that the value is an EventHub Authorization Rule ID
+func ValidateEventHubAuthorizationRuleID(i interface{}, k string) ([]string, []error) {
+	return validateResourceIDFormat(i, k, func(input string) error {
+		_, err := ParseEventHubAuthorizationRuleID(input)
+		return err
+	})
+}
+
+// ImportEventHubAuthorizationRuleID is a StateFunc which validates that the ID being imported is an EventHub Authorization Rule ID
+func ImportEventHubAuthorizationRuleID(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	return importResourceIDFormat(d, func(input string) error {
+		_, err := ParseEventHubAuthorizationRuleID(input)
+		return err
+	})
+}
+
+const eventHubConsumerGroupIDFormat = "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.EventHub/namespaces/{namespace}/eventhubs/{eventHub}/consumergroups/{name}"
+
+// EventHubConsumerGroupID is the ID of an EventHub Consumer Group in the format `/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.EventHub/namespaces/{namespace}/eventhubs/{eventHub}/consumergroups/{name}`
+type EventHubConsumerGroupID struct {
+	Subscription  string
+	ResourceGroup string
+	Namespace     string
+	EventHub      string
+	Name          string
+}
+
+// NewEventHubConsumerGroupID returns an EventHubConsumerGroupID for the specified segments
+func NewEventHubConsumerGroupID(subscription, resourceGroup, namespace, eventHub, name string) EventHubConsumerGroupID {
+	return EventHubConsumerGroupID{
+		Subscription:  subscription,
+		ResourceGroup: resourceGroup,
+		Namespace:     namespace,
+		EventHub:      eventHub,
+		Name:          name,
+	}
+}
+
+// ParseEventHubConsumerGroupID parses the specified Resource ID as an EventHubConsumerGroupID
+func ParseEventHubConsumerGroupID(input string) (*EventHubConsumerGroupID, error) {
+	segments, err := parseResourceIDFormat(eventHubConsumerGroupIDFormat, input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as an EventHub Consumer Group ID: %+v", input, err)
+	}
+
+	return &EventHubConsumerGroupID{
+		Subscription:  segments[0],
+		ResourceGroup: segments[1],
+		Namespace:     segments[2],
+		EventHub:      segments[3],
+		Name:          segments[4],
+	}, nil
+}
+
+// String returns the EventHub Consumer Group ID in the canonical casing
+func (id EventHubConsumerGroupID) String() string {
+	return formatResourceID(eventHubConsumerGroupIDFormat, id.Subscription, id.ResourceGroup, id.Namespace, id.EventHub, id.Name)
+}
+
+// ValidateEventHubConsumerGroupID is a SchemaValidateFunc which validates that the value is an EventHub Consumer Group ID
+func ValidateEventHubConsumerGroupID(i interface{}, k string) ([]string, []error) {
+	return validateResourceIDFormat(i, k, func(input string) error {
+		_, err := ParseEventHubConsumerGroupID(input)
+		return err
+	})
+}
+
+// ImportEventHubConsumerGroupID is a StateFunc which validates that the ID being imported is an EventHub Consumer Group ID
+func ImportEventHubConsumerGroupID(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	return importResourceIDFormat(d, func(input string) error {
+		_, err := ParseEventHubConsumerGroupID(input)
+		return err
+	})
+}
+
+const eventHubNamespaceIDFormat = "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.EventHub/namespaces/{name}"
+
+// EventHubNamespaceID is the ID of an EventHub Namespace in the format `/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.EventHub/namespaces/{name}`
+type EventHubNamespaceID struct {
+	Subscription  string
+	ResourceGroup string
+	Name          string
+}
+
+// NewEventHubNamespaceID returns an EventHubNamespaceID for the specified segments
+func NewEventHubNamespaceID(subscription, resourceGroup, name string) EventHubNamespaceID {
+	return EventHubNamespaceID{
+		Subscription:  subscription,
+		ResourceGroup: resourceGroup,
+		Name:          name,
+	}
+}
+
+// ParseEventHubNamespaceID parses the specified Resource ID as an EventHubNamespaceID
+func ParseEventHubNamespaceID(input string) (*EventHubNamespaceID, error) {
+	segments, err := parseResourceIDFormat(eventHubNamespaceIDFormat, input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as an EventHub Namespace ID: %+v", input, err)
+	}
+
+	return &EventHubNamespaceID{
+		Subscription:  segments[0],
+		ResourceGroup: segments[1],
+		Name:          segments[2],
+	}, nil
+}
+
+// String returns the EventHub Namespace ID in the canonical casing
+func (id EventHubNamespaceID) String() string {
+	return formatResourceID(eventHubNamespaceIDFormat, id.Subscription, id.ResourceGroup, id.Name)
+}
+
+// ValidateEventHubNamespaceID is a SchemaValidateFunc which validates that the value is an EventHub Namespace ID
+func ValidateEventHubNamespaceID(i interface{}, k string) ([]string, []error) {
+	return validateResourceIDFormat(i, k, func(input string) error {
+		_, err := ParseEventHubNamespaceID(input)
+		return err
+	})
+}
+
+// ImportEventHubNamespaceID is a StateFunc which validates that the ID being imported is an EventHub Namespace ID
+func ImportEventHubNamespaceID(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	return importResourceIDFormat(d, func(input string) error {
+		_, err := ParseEventHubNamespaceID(input)
+		return err
+	})
+}
+
+const eventHubNamespaceAuthorizationRuleIDFormat = "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.EventHub/namespaces/{namespace}/authorizationRules/{name}"
+
+// EventHubNamespaceAuthorizationRuleID is the ID of an EventHub Namespace Authorization Rule in the format `/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.EventHub/namespaces/{namespace}/authorizationRules/{name}`
+type EventHubNamespaceAuthorizationRuleID struct {
+	Subscription  string
+	ResourceGroup string
+	Namespace     string
+	Name          string
+}
+
+// NewEventHubNamespaceAuthorizationRuleID returns an EventHubNamespaceAuthorizationRuleID for the specified segments
+func NewEventHubNamespaceAuthorizationRuleID(subscription, resourceGroup, namespace, name string) EventHubNamespaceAuthorizationRuleID {
+	return EventHubNamespaceAuthorizationRuleID{
+		Subscription:  subscription,
+		ResourceGroup: resourceGroup,
+		Namespace:     namespace,
+		Name:          name,
+	}
+}
+
+// ParseEventHubNamespaceAuthorizationRuleID parses the specified Resource ID as an EventHubNamespaceAuthorizationRuleID
+func ParseEventHubNamespaceAuthorizationRuleID(input string) (*EventHubNamespaceAuthorizationRuleID, error) {
+	segments, err := parseResourceIDFormat(eventHubNamespaceAuthorizationRuleIDFormat, input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as an EventHub Namespace Authorization Rule ID: %+v", input, err)
+	}
+
+	return &EventHubNamespaceAuthorizationRuleID{
+		Subscription:  segments[0],
+		ResourceGroup: segments[1],
+		Namespace:     segments[2],
+		Name:          segments[3],
+	}, nil
+}
+
+// String returns the EventHub Namespace Authorization Rule ID in the canonical casing
+func (id EventHubNamespaceAuthorizationRuleID) String() string {
+	return formatResourceID(eventHubNamespaceAuthorizationRuleIDFormat, id.Subscription, id.ResourceGroup, id.Namespace, id.Name)
+}
+
+// ValidateEventHubNamespaceAuthorizationRuleID is a SchemaValidateFunc which validates that the value is an EventHub Namespace Authorization Rule ID
+func ValidateEventHubNamespaceAuthorizationRuleID(i interface{}, k string) ([]string, []error) {
+	return validateResourceIDFormat(i, k, func(input string) error {
+		_, err := ParseEventHubNamespaceAuthorizationRuleID(input)
+		return err
+	})
+}
+
+// ImportEventHubNamespaceAuthorizationRuleID is a StateFunc which validates that the ID being imported is an EventHub Namespace Authorization Rule ID
+func ImportEventHubNamespaceAuthorizationRuleID(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	return importResourceIDFormat(d, func(input string) error {
+		_, err := ParseEventHubNamespaceAuthorizationRuleID(input)
+		return err
+	})
+}
+
+const iotHubIDFormat = "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Devices/IotHubs/{name}"
+
+// IotHubID is the ID of an IoT Hub in the format `/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Devices/IotHubs/{name}`
+type IotHubID struct {
+	Subscription  string
+	ResourceGroup string
+	Name          string
+}
+
+// NewIotHubID returns an IotHubID for the specified segments
+func NewIotHubID(subscription, resourceGroup, name string) IotHubID {
+	return IotHubID{
+		Subscription:  subscription,
+		ResourceGroup: resourceGroup,
+		Name:          name,
+	}
+}
+
+// ParseIotHubID parses the specified Resource ID as an IotHubID
+func ParseIotHubID(input string) (*IotHubID, error) {
+	segments, err := parseResourceIDFormat(iotHubIDFormat, input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as an IoT Hub ID: %+v", input, err)
+	}
+
+	return &IotHubID{
+		Subscription:  segments[0],
+		ResourceGroup: segments[1],
+		Name:          segments[2],
+	}, nil
+}
+
+// String returns the IoT Hub ID in the canonical casing
+func (id IotHubID) String() string {
+	return formatResourceID(iotHubIDFormat, id.Subscription, id.ResourceGroup, id.Name)
+}
+
+// ValidateIotHubID is a SchemaValidateFunc which validates that the value is an IoT Hub ID
+func ValidateIotHubID(i interface{}, k string) ([]string, []error) {
+	return validateResourceIDFormat(i, k, func(input string) error {
+		_, err := ParseIotHubID(input)
+		return err
+	})
+}
+
+// ImportIotHubID is a StateFunc which validates that the ID being imported is an IoT Hub ID
+func ImportIotHubID(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	return importResourceIDFormat(d, func(input string) error {
+		_, err := ParseIotHubID(input)
+		return err
+	})
+}
+
+const keyVaultIDFormat = "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.KeyVault/vaults/{name}"
+
+// KeyVaultID is the ID of a Key Vault in the format `/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.KeyVault/vaults/{name}`
+type KeyVaultID struct {
+	Subscription  string
+	ResourceGroup string
+	Name          string
+}
+
+// NewKeyVaultID returns a KeyVaultID for the specified segments
+func NewKeyVaultID(subscription, resourceGroup, name string) KeyVaultID {
+	return KeyVaultID{
+		Subscription:  subscription,
+		ResourceGroup: resourceGroup,
+		Name:          name,
+	}
+}
+
+// ParseKeyVaultID parses the specified Resource ID as a KeyVaultID
+func ParseKeyVaultID(input string) (*KeyVaultID, error) {
+	segments, err := parseResourceIDFormat(keyVaultIDFormat, input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a Key Vault ID: %+v", input, err)
+	}
+
+	return &KeyVaultID{
+		Subscription:  segments[0],
+		ResourceGroup: segments[1],
+		Name:          segments[2],
+	}, nil
+}
+
+// String returns the Key Vault ID in the canonical casing
+func (id KeyVaultID) String() string {
+	return formatResourceID(keyVaultIDFormat, id.Subscription, id.ResourceGroup, id.Name)
+}
+
+// ValidateKeyVaultID is a SchemaValidateFunc which validates that the value is a Key Vault ID
+func ValidateKeyVaultID(i interface{}, k string) ([]string, []error) {
+	return validateResourceIDFormat(i, k, func(input string) error {
+		_, err := ParseKeyVaultID(input)
+		return err
+	})
+}
+
+// ImportKeyVaultID is a StateFunc which validates that the ID being imported is a Key Vault ID
+func ImportKeyVaultID(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	return importResourceIDFormat(d, func(input string) error {
+		_, err := ParseKeyVaultID(input)
+		return err
+	})
+}
+
+const logAnalyticsSolutionIDFormat = "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.OperationsManagement/solutions/{name}"
+
+// LogAnalyticsSolutionID is the ID of a Log Analytics Solution in the format `/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.OperationsManagement/solutions/{name}`
+type LogAnalyticsSolutionID struct {
+	Subscription  string
+	ResourceGroup string
+	Name          string
+}
+
+// NewLogAnalyticsSolutionID returns a LogAnalyticsSolutionID for the specified segments
+func NewLogAnalyticsSolutionID(subscription, resourceGroup, name string) LogAnalyticsSolutionID {
+	return LogAnalyticsSolutionID{
+		Subscription:  subscription,
+		ResourceGroup: resourceGroup,
+		Name:          name,
+	}
+}
+
+// ParseLogAnalyticsSolutionID parses the specified Resource ID as a LogAnalyticsSolutionID
+func ParseLogAnalyticsSolutionID(input string) (*LogAnalyticsSolutionID, error) {
+	segments, err := parseResourceIDFormat(logAnalyticsSolutionIDFormat, input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a Log Analytics Solution ID: %+v", input, err)
+	}
+
+	return &LogAnalyticsSolutionID{
+		Subscription:  segments[0],
+		ResourceGroup: segments[1],
+		Name:          segments[2],
+	}, nil
+}
+
+// String returns the Log Analytics Solution ID in the canonical casing
+func (id LogAnalyticsSolutionID) String() string {
+	return formatResourceID(logAnalyticsSolutionIDFormat, id.Subscription, id.ResourceGroup, id.Name)
+}
+
+// ValidateLogAnalyticsSolutionID is a SchemaValidateFunc which validates that the value is a Log Analytics Solution ID
+func ValidateLogAnalyticsSolutionID(i interface{}, k string) ([]string, []error) {
+	return validateResourceIDFormat(i, k, func(input string) error {
+		_, err := ParseLogAnalyticsSolutionID(input)
+		return err
+	})
+}
+
+// ImportLogAnalyticsSolutionID is a StateFunc which validates that the ID being imported is a Log Analytics Solution ID
+func ImportLogAnalyticsSolutionID(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	return importResourceIDFormat(d, func(input string) error {
+		_, err := ParseLogAnalyticsSolutionID(input)
+		return err
+	})
+}
+
+const logAnalyticsWorkspaceIDFormat = "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.OperationalInsights/workspaces/{name}"
+
+// LogAnalyticsWorkspaceID is the ID of a Log Analytics Workspace in the format `/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.OperationalInsights/workspaces/{name}`
+type LogAnalyticsWorkspaceID struct {
+	Subscription  string
+	ResourceGroup string
+	Name          string
+}
+
+// NewLogAnalyticsWorkspaceID returns a LogAnalyticsWorkspaceID for the specified segments
+func NewLogAnalyticsWorkspaceID(subscription, resourceGroup, name string) LogAnalyticsWorkspaceID {
+	return LogAnalyticsWorkspaceID{
+		Subscription:  subscription,
+		ResourceGroup: resourceGroup,
+		Name:          name,
+	}
+}
+
+// ParseLogAnalyticsWorkspaceID parses the specified Resource ID as a LogAnalyticsWorkspaceID
+func ParseLogAnalyticsWorkspaceID(input string) (*LogAnalyticsWorkspaceID, error) {
+	segments, err := parseResourceIDFormat(logAnalyticsWorkspaceIDFormat, input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a Log Analytics Workspace ID: %+v", input, err)
+	}
+
+	return &LogAnalyticsWorkspaceID{
+		Subscription:  segments[0],
+		ResourceGroup: segments[1],
+		Name:          segments[2],
+	}, nil
+}
+
+// String returns the Log Analytics Workspace ID in the canonical casing
+func (id LogAnalyticsWorkspaceID) String() string {
+	return formatResourceID(logAnalyticsWorkspaceIDFormat, id.Subscription, id.ResourceGroup, id.Name)
+}
+
+// ValidateLogAnalyticsWorkspaceID is a SchemaValidateFunc which validates that the value is a Log Analytics Workspace ID
+func ValidateLogAnalyticsWorkspaceID(i interface{}, k string) ([]string, []error) {
+	return validateResourceIDFormat(i, k, func(input string) error {
+		_, err := ParseLogAnalyticsWorkspaceID(input)
+		return err
+	})
+}
+
+// ImportLogAnalyticsWorkspaceID is a StateFunc which validates that the ID being imported is a Log Analytics Workspace ID
+func ImportLogAnalyticsWorkspaceID(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	return importResourceIDFormat(d, func(input string) error {
+		_, err := ParseLogAnalyticsWorkspaceID(input)
+		return err
+	})
+}
+
+const logicAppActionIDFormat = "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Logic/workflows/{workflow}/actions/{name}"
+
+// LogicAppActionID is the ID of a Logic App Action in the format `/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Logic/workflows/{workflow}/actions/{name}`
+type LogicAppActionID struct {
+	Subscription  string
+	ResourceGroup string
+	Workflow      string
+	Name          string
+}
+
+// NewLogicAppActionID returns a LogicAppActionID for the specified segments
+func NewLogicAppActionID(subscription, resourceGroup, workflow, name string) LogicAppActionID {
+	return LogicAppActionID{
+		Subscription:  subscription,
+		ResourceGroup: resourceGroup,
+		Workflow:      workflow,
+		Name:          name,
+	}
+}
+
+// ParseLogicAppActionID parses the specified Resource ID as a LogicAppActionID
+func ParseLogicAppActionID(input string) (*LogicAppActionID, error) {
+	segments, err := parseResourceIDFormat(logicAppActionIDFormat, input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a Logic App Action ID: %+v", input, err)
+	}
+
+	return &LogicAppActionID{
+		Subscription:  segments[0],
+		ResourceGroup: segments[1],
+		Workflow:      segments[2],
+		Name:          segments[3],
+	}, nil
+}
+
+// String returns the Logic App Action ID in the canonical casing
+func (id LogicAppActionID) String() string {
+	return formatResourceID(logicAppActionIDFormat, id.Subscription, id.ResourceGroup, id.Workflow, id.Name)
+}
+
+// ValidateLogicAppActionID is a SchemaValidateFunc which validates that the value is a Logic App Action ID
+func ValidateLogicAppActionID(i interface{}, k string) ([]string, []error) {
+	return validateResourceIDFormat(i, k, func(input string) error {
+		_, err := ParseLogicAppActionID(input)
+		return err
+	})
+}
+
+// ImportLogicAppActionID is a StateFunc which validates that the ID being imported is a Logic App Action ID
+func ImportLogicAppActionID(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	return importResourceIDFormat(d, func(input string) error {
+		_, err := ParseLogicAppActionID(input)
+		return err
+	})
+}
+
+const logicAppTriggerIDFormat = "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Logic/workflows/{workflow}/triggers/{name}"
+
+// LogicAppTriggerID is the ID of a Logic App Trigger in the format `/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Logic/workflows/{workflow}/triggers/{name}`
+type LogicAppTriggerID struct {
+	Subscription  string
+	ResourceGroup string
+	Workflow      string
+	Name          string
+}
+
+// NewLogicAppTriggerID returns a LogicAppTriggerID for the specified segments
+func NewLogicAppTriggerID(subscription, resourceGroup, workflow, name string) LogicAppTriggerID {
+	return LogicAppTriggerID{
+		Subscription:  subscription,
+		ResourceGroup: resourceGroup,
+		Workflow:      workflow,
+		Name:          name,
+	}
+}
+
+// ParseLogicAppTriggerID parses the specified Resource ID as a LogicAppTriggerID
+func ParseLogicAppTriggerID(input string) (*LogicAppTriggerID, error) {
+	segments, err := parseResourceIDFormat(logicAppTriggerIDFormat, input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a Logic App Trigger ID: %+v", input, err)
+	}
+
+	return &LogicAppTriggerID{
+		Subscription:  segments[0],
+		ResourceGroup: segments[1],
+		Workflow:      segments[2],
+		Name:          segments[3],
+	}, nil
+}
+
+// String returns the Logic App Trigger ID in the canonical casing
+func (id LogicAppTriggerID) String() string {
+	return formatResourceID(logicAppTriggerIDFormat, id.Subscription, id.ResourceGroup, id.Workflow, id.Name)
+}
+
+// ValidateLogicAppTriggerID is a SchemaValidateFunc which validates that the value is a Logic App Trigger ID
+func ValidateLogicAppTriggerID(i interface{}, k string) ([]string, []error) {
+	return validateResourceIDFormat(i, k, func(input string) error {
+		_, err := ParseLogicAppTriggerID(input)
+		return err
+	})
+}
+
+// ImportLogicAppTriggerID is a StateFunc which validates that the ID being imported is a Logic App Trigger ID
+func ImportLogicAppTriggerID(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	return importResourceIDFormat(d, func(input string) error {
+		_, err := ParseLogicAppTriggerID(input)
+		return err
+	})
+}
+
+const logicAppWorkflowIDFormat = "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Logic/workflows/{name}"
+
+// LogicAppWorkflowID is the ID of a Logic App Workflow in the format `/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Logic/workflows/{name}`
+type LogicAppWorkflowID struct {
+	Subscription  string
+	ResourceGroup string
+	Name          string
+}
+
+// NewLogicAppWorkflowID returns a LogicAppWorkflowID for the specified segments
+func NewLogicAppWorkflowID(subscription, resourceGroup, name string) LogicAppWorkflowID {
+	return LogicAppWorkflowID{
+		Subscription:  subscription,
+		ResourceGroup: resourceGroup,
+		Name:          name,
+	}
+}
+
+// ParseLogicAppWorkflowID parses the specified Resource ID as a LogicAppWorkflowID
+func ParseLogicAppWorkflowID(input string) (*LogicAppWorkflowID, error) {
+	segments, err := parseResourceIDFormat(logicAppWorkflowIDFormat, input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a Logic App Workflow ID: %+v", input, err)
+	}
+
+	return &LogicAppWorkflowID{
+		Subscription:  segments[0],
+		ResourceGroup: segments[1],
+		Name:          segments[2],
+	}, nil
+}
+
+// String returns the Logic App Workflow ID in the canonical casing
+func (id LogicAppWorkflowID) String() string {
+	return formatResourceID(logicAppWorkflowIDFormat, id.Subscription, id.ResourceGroup, id.Name)
+}
+
+// ValidateLogicAppWorkflowID is a SchemaValidateFunc which validates that the value is a Logic App Workflow ID
+func ValidateLogicAppWorkflowID(i interface{}, k string) ([]string, []error) {
+	return validateResourceIDFormat(i, k, func(input string) error {
+		_, err := ParseLogicAppWorkflowID(input)
+		return err
+	})
+}
+
+// ImportLogicAppWorkflowID is a StateFunc which validates that the ID being imported is a Logic App Workflow ID
+func ImportLogicAppWorkflowID(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	return importResourceIDFormat(d, func(input string) error {
+		_, err := ParseLogicAppWorkflowID(input)
+		return err
+	})
+}
+
+const userAssignedIdentityIDFormat = "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.ManagedIdentity/userAssignedIdentities/{name}"
+
+// UserAssignedIdentityID is the ID of a User Assigned Identity in the format `/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.ManagedIdentity/userAssignedIdentities/{name}`
+type UserAssignedIdentityID struct {
+	Subscription  string
+	ResourceGroup string
+	Name          string
+}
+
+// NewUserAssignedIdentityID returns a UserAssignedIdentityID for the specified segments
+func NewUserAssignedIdentityID(subscription, resourceGroup, name string) UserAssignedIdentityID {
+	return UserAssignedIdentityID{
+		Subscription:  subscription,
+		ResourceGroup: resourceGroup,
+		Name:          name,
+	}
+}
+
+// ParseUserAssignedIdentityID parses the specified Resource ID as a UserAssignedIdentityID
+func ParseUserAssignedIdentityID(input string) (*UserAssignedIdentityID, error) {
+	segments, err := parseResourceIDFormat(userAssignedIdentityIDFormat, input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a User Assigned Identity ID: %+v", input, err)
+	}
+
+	return &UserAssignedIdentityID{
+		Subscription:  segments[0],
+		ResourceGroup: segments[1],
+		Name:          segments[2],
+	}, nil
+}
+
+// String returns the User Assigned Identity ID in the canonical casing
+func (id UserAssignedIdentityID) String() string {
+	return formatResourceID(userAssignedIdentityIDFormat, id.Subscription, id.ResourceGroup, id.Name)
+}
+
+// ValidateUserAssignedIdentityID is a SchemaValidateFunc which validates that the value is a User Assigned Identity ID
+func ValidateUserAssignedIdentityID(i interface{}, k string) ([]string, []error) {
+	return validateResourceIDFormat(i, k, func(input string) error {
+		_, err := ParseUserAssignedIdentityID(input)
+		return err
+	})
+}
+
+// ImportUserAssignedIdentityID is a StateFunc which validates that the ID being imported is a User Assigned Identity ID
+func ImportUserAssignedIdentityID(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	return importResourceIDFormat(d, func(input string) error {
+		_, err := ParseUserAssignedIdentityID(input)
+		return err
+	})
+}
+
+const applicationInsightsIDFormat = "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Insights/components/{name}"
+
+// ApplicationInsightsID is the ID of an Application Insights in the format `/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Insights/components/{name}`
+type ApplicationInsightsID struct {
+	Subscription  string
+	ResourceGroup string
+	Name          string
+}
+
+// NewApplicationInsightsID returns an ApplicationInsightsID for the specified segments
+func NewApplicationInsightsID(subscription, resourceGroup, name string) ApplicationInsightsID {
+	return ApplicationInsightsID{
+		Subscription:  subscription,
+		ResourceGroup: resourceGroup,
+		Name:          name,
+	}
+}
+
+// ParseApplicationInsightsID parses the specified Resource ID as an ApplicationInsightsID
+func ParseApplicationInsightsID(input string) (*ApplicationInsightsID, error) {
+	segments, err := parseResourceIDFormat(applicationInsightsIDFormat, input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as an Application Insights ID: %+v", input, err)
+	}
+
+	return &ApplicationInsightsID{
+		Subscription:  segments[0],
+		ResourceGroup: segments[1],
+		Name:          segments[2],
+	}, nil
+}
+
+// String returns the Application Insights ID in the canonical casing
+func (id ApplicationInsightsID) String() string {
+	return formatResourceID(applicationInsightsIDFormat, id.Subscription, id.ResourceGroup, id.Name)
+}
+
+// ValidateApplicationInsightsID is a SchemaValidateFunc which validates that the value is an Application Insights ID
+func ValidateApplicationInsightsID(i interface{}, k string) ([]string, []error) {
+	return validateResourceIDFormat(i, k, func(input string) error {
+		_, err := ParseApplicationInsightsID(input)
+		return err
+	})
+}
+
+// ImportApplicationInsightsID is a StateFunc which validates that the ID being imported is an Application Insights ID
+func ImportApplicationInsightsID(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	return importResourceIDFormat(d, func(input string) error {
+		_, err := ParseApplicationInsightsID(input)
+		return err
+	})
+}
+
+const autoscaleSettingIDFormat = "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Insights/autoscalesettings/{name}"
+
+// AutoscaleSettingID is the ID of an Autoscale Setting in the format `/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Insights/autoscalesettings/{name}`
+type AutoscaleSettingID struct {
+	Subscription  string
+	ResourceGroup string
+	Name          string
+}
+
+// NewAutoscaleSettingID returns an AutoscaleSettingID for the specified segments
+func NewAutoscaleSettingID(subscription, resourceGroup, name string) AutoscaleSettingID {
+	return AutoscaleSettingID{
+		Subscription:  subscription,
+		ResourceGroup: resourceGroup,
+		Name:          name,
+	}
+}
+
+// ParseAutoscaleSettingID parses the specified Resource ID as an AutoscaleSettingID
+func ParseAutoscaleSettingID(input string) (*AutoscaleSettingID, error) {
+	segments, err := parseResourceIDFormat(autoscaleSettingIDFormat, input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as an Autoscale Setting ID: %+v", input, err)
+	}
+
+	return &AutoscaleSettingID{
+		Subscription:  segments[0],
+		ResourceGroup: segments[1],
+		Name:          segments[2],
+	}, nil
+}
+
+// String returns the Autoscale Setting ID in the canonical casing
+func (id AutoscaleSettingID) String() string {
+	return formatResourceID(autoscaleSettingIDFormat, id.Subscription, id.ResourceGroup, id.Name)
+}
+
+// ValidateAutoscaleSettingID is a SchemaValidateFunc which validates that the value is an Autoscale Setting ID
+func ValidateAutoscaleSettingID(i interface{}, k string) ([]string, []error) {
+	return validateResourceIDFormat(i, k, func(input string) error {
+		_, err := ParseAutoscaleSettingID(input)
+		return err
+	})
+}
+
+// ImportAutoscaleSettingID is a StateFunc which validates that the ID being imported is an Autoscale Setting ID
+func ImportAutoscaleSettingID(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	return importResourceIDFormat(d, func(input string) error {
+		_, err := ParseAutoscaleSettingID(input)
+		return err
+	})
+}
+
+const metricAlertRuleIDFormat = "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Insights/alertrules/{name}"
+
+// MetricAlertRuleID is the ID of a Metric Alert Rule in the format `/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Insights/alertrules/{name}`
+type MetricAlertRuleID struct {
+	Subscription  string
+	ResourceGroup string
+	Name          string
+}
+
+// NewMetricAlertRuleID returns a MetricAlertRuleID for the specified segments
+func NewMetricAlertRuleID(subscription, resourceGroup, name string) MetricAlertRuleID {
+	return MetricAlertRuleID{
+		Subscription:  subscription,
+		ResourceGroup: resourceGroup,
+		Name:          name,
+	}
+}
+
+// ParseMetricAlertRuleID parses the specified Resource ID as a MetricAlertRuleID
+func ParseMetricAlertRuleID(input string) (*MetricAlertRuleID, error) {
+	segments, err := parseResourceIDFormat(metricAlertRuleIDFormat, input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a Metric Alert Rule ID: %+v", input, err)
+	}
+
+	return &MetricAlertRuleID{
+		Subscription:  segments[0],
+		ResourceGroup: segments[1],
+		Name:          segments[2],
+	}, nil
+}
+
+// String returns the Metric Alert Rule ID in the canonical casing
+func (id MetricAlertRuleID) String() string {
+	return formatResourceID(metricAlertRuleIDFormat, id.Subscription, id.ResourceGroup, id.Name)
+}
+
+// ValidateMetricAlertRuleID is a SchemaValidateFunc which validates that the value is a Metric Alert Rule ID
+func ValidateMetricAlertRuleID(i interface{}, k string) ([]string, []error) {
+	return validateResourceIDFormat(i, k, func(input string) error {
+		_, err := ParseMetricAlertRuleID(input)
+		return err
+	})
+}
+
+// ImportMetricAlertRuleID is a StateFunc which validates that the ID being imported is a Metric Alert Rule ID
+func ImportMetricAlertRuleID(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	return importResourceIDFormat(d, func(input string) error {
+		_, err := ParseMetricAlertRuleID(input)
+		return err
+	})
+}
+
+const monitorActionGroupIDFormat = "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Insights/actionGroups/{name}"
+
+// MonitorActionGroupID is the ID of a Monitor Action Group in the format `/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Insights/actionGroups/{name}`
+type MonitorActionGroupID struct {
+	Subscription  string
+	ResourceGroup string
+	Name          string
+}
+
+// NewMonitorActionGroupID returns a MonitorActionGroupID for the specified segments
+func NewMonitorActionGroupID(subscription, resourceGroup, name string) MonitorActionGroupID {
+	return MonitorActionGroupID{
+		Subscription:  subscription,
+		ResourceGroup: resourceGroup,
+		Name:          name,
+	}
+}
+
+// ParseMonitorActionGroupID parses the specified Resource ID as a MonitorActionGroupID
+func ParseMonitorActionGroupID(input string) (*MonitorActionGroupID, error) {
+	segments, err := parseResourceIDFormat(monitorActionGroupIDFormat, input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a Monitor Action Group ID: %+v", input, err)
+	}
+
+	return &MonitorActionGroupID{
+		Subscription:  segments[0],
+		ResourceGroup: segments[1],
+		Name:          segments[2],
+	}, nil
+}
+
+// String returns the Monitor Action Group ID in the canonical casing
+func (id MonitorActionGroupID) String() string {
+	return formatResourceID(monitorActionGroupIDFormat, id.Subscription, id.ResourceGroup, id.Name)
+}
+
+// ValidateMonitorActionGroupID is a SchemaValidateFunc which validates that the value is a Monitor Action Group ID
+func ValidateMonitorActionGroupID(i interface{}, k string) ([]string, []error) {
+	return validateResourceIDFormat(i, k, func(input string) error {
+		_, err := ParseMonitorActionGroupID(input)
+		return err
+	})
+}
+
+// ImportMonitorActionGroupID is a StateFunc which validates that the ID being imported is a Monitor Action Group ID
+func ImportMonitorActionGroupID(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	return importResourceIDFormat(d, func(input string) error {
+		_, err := ParseMonitorActionGroupID(input)
+		return err
+	})
+}
+
+const mySqlConfigurationIDFormat = "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.DBforMySQL/servers/{server}/configurations/{name}"
+
+// MySqlConfigurationID is the ID of a MySQL Configuration in the format `/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.DBforMySQL/servers/{server}/configurations/{name}`
+type MySqlConfigurationID struct {
+	Subscription  string
+	ResourceGroup string
+	Server        string
+	Name          string
+}
+
+// NewMySqlConfigurationID returns a MySqlConfigurationID for the specified segments
+func NewMySqlConfigurationID(subscription, resourceGroup, server, name string) MySqlConfigurationID {
+	return MySqlConfigurationID{
+		Subscription:  subscription,
+		ResourceGroup: resourceGroup,
+		Server:        server,
+		Name:          name,
+	}
+}
+
+// ParseMySqlConfigurationID parses the specified Resource ID as a MySqlConfigurationID
+func ParseMySqlConfigurationID(input string) (*MySqlConfigurationID, error) {
+	segments, err := parseResourceIDFormat(mySqlConfigurationIDFormat, input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a MySQL Configuration ID: %+v", input, err)
+	}
+
+	return &MySqlConfigurationID{
+		Subscription:  segments[0],
+		ResourceGroup: segments[1],
+		Server:        segments[2],
+		Name:          segments[3],
+	}, nil
+}
+
+// String returns the MySQL Configuration ID in the canonical casing
+func (id MySqlConfigurationID) String() string {
+	return formatResourceID(mySqlConfigurationIDFormat, id.Subscription, id.ResourceGroup, id.Server, id.Name)
+}
+
+// ValidateMySqlConfigurationID is a SchemaValidateFunc which validates that the value is a MySQL Configuration ID
+func ValidateMySqlConfigurationID(i interface{}, k string) ([]string, []error) {
+	return validateResourceIDFormat(i, k, func(input string) error {
+		_, err := ParseMySqlConfigurationID(input)
+		return err
+	})
+}
+
+// ImportMySqlConfigurationID is a StateFunc which validates that the ID being imported is a MySQL Configuration ID
+func ImportMySqlConfigurationID(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	return importResourceIDFormat(d, func(input string) error {
+		_, err := ParseMySqlConfigurationID(input)
+		return err
+	})
+}
+
+const mySqlDatabaseIDFormat = "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.DBforMySQL/servers/{server}/databases/{name}"
+
+// MySqlDatabaseID is the ID of a MySQL Database in the format `/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.DBforMySQL/servers/{server}/databases/{name}`
+type MySqlDatabaseID struct {
+	Subscription  string
+	ResourceGroup string
+	Server        string
+	Name          string
+}
+
+// NewMySqlDatabaseID returns a MySqlDatabaseID for the specified segments
+func NewMySqlDatabaseID(subscription, resourceGroup, server, name string) MySqlDatabaseID {
+	return MySqlDatabaseID{
+		Subscription:  subscription,
+		ResourceGroup: resourceGroup,
+		Server:        server,
+		Name:          name,
+	}
+}
+
+// ParseMySqlDatabaseID parses the specified Resource ID as a MySqlDatabaseID
+func ParseMySqlDatabaseID(input string) (*MySqlDatabaseID, error) {
+	segments, err := parseResourceIDFormat(mySqlDatabaseIDFormat, input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a MySQL Database ID: %+v", input, err)
+	}
+
+	return &MySqlDatabaseID{
+		Subscription:  segments[0],
+		ResourceGroup: segments[1],
+		Server:        segments[2],
+		Name:          segments[3],
+	}, nil
+}
+
+// String returns the MySQL Database ID in the canonical casing
+func (id MySqlDatabaseID) String() string {
+	return formatResourceID(mySqlDatabaseIDFormat, id.Subscription, id.ResourceGroup, id.Server, id.Name)
+}
+
+// ValidateMySqlDatabaseID is a SchemaValidateFunc which validates that the value is a MySQL Database ID
+func ValidateMySqlDatabaseID(i interface{}, k string) ([]string, []error) {
+	return validateResourceIDFormat(i, k, func(input string) error {
+		_, err := ParseMySqlDatabaseID(input)
+		return err
+	})
+}
+
+// ImportMySqlDatabaseID is a StateFunc which validates that the ID being imported is a MySQL Database ID
+func ImportMySqlDatabaseID(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	return importResourceIDFormat(d, func(input string) error {
+		_, err := ParseMySqlDatabaseID(input)
+		return err
+	})
+}
+
+const mySqlFirewallRuleIDFormat = "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.DBforMySQL/servers/{server}/firewallRules/{name}"
+
+// MySqlFirewallRuleID is the ID of a MySQL Firewall Rule in the format `/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.DBforMySQL/servers/{server}/firewallRules/{name}`
+type MySqlFirewallRuleID struct {
+	Subscription  string
+	ResourceGroup string
+	Server        string
+	Name          string
+}
+
+// NewMySqlFirewallRuleID returns a MySqlFirewallRuleID for the specified segments
+func NewMySqlFirewallRuleID(subscription, resourceGroup, server, name string) MySqlFirewallRuleID {
+	return MySqlFirewallRuleID{
+		Subscription:  subscription,
+		ResourceGroup: resourceGroup,
+		Server:        server,
+		Name:          name,
+	}
+}
+
+// ParseMySqlFirewallRuleID parses the specified Resource ID as a MySqlFirewallRuleID
+func ParseMySqlFirewallRuleID(input string) (*MySqlFirewallRuleID, error) {
+	segments, err := parseResourceIDFormat(mySqlFirewallRuleIDFormat, input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a MySQL Firewall Rule ID: %+v", input, err)
+	}
+
+	return &MySqlFirewallRuleID{
+		Subscription:  segments[0],
+		ResourceGroup: segments[1],
+		Server:        segments[2],
+		Name:          segments[3],
+	}, nil
+}
+
+// String returns the MySQL Firewall Rule ID in the canonical casing
+func (id MySqlFirewallRuleID) String() string {
+	return formatResourceID(mySqlFirewallRuleIDFormat, id.Subscription, id.ResourceGroup, id.Server, id.Name)
+}
+
+// ValidateMySqlFirewallRuleID is a SchemaValidateFunc which validates that the value is a MySQL Firewall Rule ID
+func ValidateMySqlFirewallRuleID(i interface{}, k string) ([]string, []error) {
+	return validateResourceIDFormat(i, k, func(input string) error {
+		_, err := ParseMySqlFirewallRuleID(input)
+		return err
+	})
+}
+
+// ImportMySqlFirewallRuleID is a StateFunc which validates that the ID being imported is a MySQL Firewall Rule ID
+func ImportMySqlFirewallRuleID(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	return importResourceIDFormat(d, func(input string) error {
+		_, err := ParseMySqlFirewallRuleID(input)
+		return err
+	})
+}
+
+const mySqlServerIDFormat = "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.DBforMySQL/servers/{name}"
+
+// MySqlServerID is the ID of a MySQL Server in the format `/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.DBforMySQL/servers/{name}`
+type MySqlServerID struct {
+	Subscription  string
+	ResourceGroup string
+	Name          string
+}
+
+// NewMySqlServerID returns a MySqlServerID for the specified segments
+func NewMySqlServerID(subscription, resourceGroup, name string) MySqlServerID {
+	return MySqlServerID{
+		Subscription:  subscription,
+		ResourceGroup: resourceGroup,
+		Name:          name,
+	}
+}
+
+// ParseMySqlServerID parses the specified Resource ID as a MySqlServerID
+func ParseMySqlServerID(input string) (*MySqlServerID, error) {
+	segments, err := parseResourceIDFormat(mySqlServerIDFormat, input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a MySQL Server ID: %+v", input, err)
+	}
+
+	return &MySqlServerID{
+		Subscription:  segments[0],
+		ResourceGroup: segments[1],
+		Name:          segments[2],
+	}, nil
+}
+
+// String returns the MySQL Server ID in the canonical casing
+func (id MySqlServerID) String() string {
+	return formatResourceID(mySqlServerIDFormat, id.Subscription, id.ResourceGroup, id.Name)
+}
+
+// ValidateMySqlServerID is a SchemaValidateFunc which validates that the value is a MySQL Server ID
+func ValidateMySqlServerID(i interface{}, k string) ([]string, []error) {
+	return validateResourceIDFormat(i, k, func(input string) error {
+		_, err := ParseMySqlServerID(input)
+		return err
+	})
+}
+
+// ImportMySqlServerID is a StateFunc which validates that the ID being imported is a MySQL Server ID
+func ImportMySqlServerID(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	return importResourceIDFormat(d, func(input string) error {
+		_, err := ParseMySqlServerID(input)
+		return err
+	})
+}
+
+const mySqlVirtualNetworkRuleIDFormat = "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.DBforMySQL/servers/{server}/virtualNetworkRules/{name}"
+
+// MySqlVirtualNetworkRuleID is the ID of a MySQL Virtual Network Rule in the format `/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.DBforMySQL/servers/{server}/virtualNetworkRules/{name}`
+type MySqlVirtualNetworkRuleID struct {
+	Subscription  string
+	ResourceGroup string
+	Server        string
+	Name          string
+}
+
+// NewMySqlVirtualNetworkRuleID returns a MySqlVirtualNetworkRuleID for the specified segments
+func NewMySqlVirtualNetworkRuleID(subscription, resourceGroup, server, name string) MySqlVirtualNetworkRuleID {
+	return MySqlVirtualNetworkRuleID{
+		Subscription:  subscription,
+		ResourceGroup: resourceGroup,
+		Server:        server,
+		Name:          name,
+	}
+}
+
+// ParseMySqlVirtualNetworkRuleID parses the specified Resource ID as a MySqlVirtualNetworkRuleID
+func ParseMySqlVirtualNetworkRuleID(input string) (*MySqlVirtualNetworkRuleID, error) {
+	segments, err := parseResourceIDFormat(mySqlVirtualNetworkRuleIDFormat, input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a MySQL Virtual Network Rule ID: %+v", input, err)
+	}
+
+	return &MySqlVirtualNetworkRuleID{
+		Subscription:  segments[0],
+		ResourceGroup: segments[1],
+		Server:        segments[2],
+		Name:          segments[3],
+	}, nil
+}
+
+// String returns the MySQL Virtual Network Rule ID in the canonical casing
+func (id MySqlVirtualNetworkRuleID) String() string {
+	return formatResourceID(mySqlVirtualNetworkRuleIDFormat, id.Subscription, id.ResourceGroup, id.Server, id.Name)
+}
+
+// ValidateMySqlVirtualNetworkRuleID is a SchemaValidateFunc which validates that the value is a MySQL Virtual Network Rule ID
+func ValidateMySqlVirtualNetworkRuleID(i interface{}, k string) ([]string, []error) {
+	return validateResourceIDFormat(i, k, func(input string) error {
+		_, err := ParseMySqlVirtualNetworkRuleID(input)
+		return err
+	})
+}
+
+// ImportMySqlVirtualNetworkRuleID is a StateFunc which validates that the ID being imported is a MySQL Virtual Network Rule ID
+func ImportMySqlVirtualNetworkRuleID(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	return importResourceIDFormat(d, func(input string) error {
+		_, err := ParseMySqlVirtualNetworkRuleID(input)
+		return err
+	})
+}
+
+const applicationGatewayIDFormat = "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/applicationGateways/{name}"
+
+// ApplicationGatewayID is the ID of an Application Gateway in the format `/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/applicationGateways/{name}`
+type ApplicationGatewayID struct {
+	Subscription  string
+	ResourceGroup string
+	Name          string
+}
+
+// NewApplicationGatewayID returns an ApplicationGatewayID for the specified segments
+func NewApplicationGatewayID(subscription, resourceGroup, name string) ApplicationGatewayID {
+	return ApplicationGatewayID{
+		Subscription:  subscription,
+		ResourceGroup: resourceGroup,
+		Name:          name,
+	}
+}
+
+// ParseApplicationGatewayID parses the specified Resource ID as an ApplicationGatewayID
+func ParseApplicationGatewayID(input string) (*ApplicationGatewayID, error) {
+	segments, err := parseResourceIDFormat(applicationGatewayIDFormat, input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as an Application Gateway ID: %+v", input, err)
+	}
+
+	return &ApplicationGatewayID{
+		Subscription:  segments[0],
+		ResourceGroup: segments[1],
+		Name:          segments[2],
+	}, nil
+}
+
+// String returns the Application Gateway ID in the canonical casing
+func (id ApplicationGatewayID) String() string {
+	return formatResourceID(applicationGatewayIDFormat, id.Subscription, id.ResourceGroup, id.Name)
+}
+
+// ValidateApplicationGatewayID is a SchemaValidateFunc which validates that the value is an Application Gateway ID
+func ValidateApplicationGatewayID(i interface{}, k string) ([]string, []error) {
+	return validateResourceIDFormat(i, k, func(input string) error {
+		_, err := ParseApplicationGatewayID(input)
+		return err
+	})
+}
+
+// ImportApplicationGatewayID is a StateFunc which validates that the ID being imported is an Application Gateway ID
+func ImportApplicationGatewayID(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	return importResourceIDFormat(d, func(input string) error {
+		_, err := ParseApplicationGatewayID(input)
+		return err
+	})
+}
+
+const applicationSecurityGroupIDFormat = "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/applicationSecurityGroups/{name}"
+
+// ApplicationSecurityGroupID is the ID of an Application Security Group in the format `/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/applicationSecurityGroups/{name}`
+type ApplicationSecurityGroupID struct {
+	Subscription  string
+	ResourceGroup string
+	Name          string
+}
+
+// NewApplicationSecurityGroupID returns an ApplicationSecurityGroupID for the specified segments
+func NewApplicationSecurityGroupID(subscription, resourceGroup, name string) ApplicationSecurityGroupID {
+	return ApplicationSecurityGroupID{
+		Subscription:  subscription,
+		ResourceGroup: resourceGroup,
+		Name:          name,
+	}
+}
+
+// ParseApplicationSecurityGroupID parses the specified Resource ID as an ApplicationSecurityGroupID
+func ParseApplicationSecurityGroupID(input string) (*ApplicationSecurityGroupID, error) {
+	segments, err := parseResourceIDFormat(applicationSecurityGroupIDFormat, input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as an Application Security Group ID: %+v", input, err)
+	}
+
+	return &ApplicationSecurityGroupID{
+		Subscription:  segments[0],
+		ResourceGroup: segments[1],
+		Name:          segments[2],
+	}, nil
+}
+
+// String returns the Application Security Group ID in the canonical casing
+func (id ApplicationSecurityGroupID) String() string {
+	return formatResourceID(applicationSecurityGroupIDFormat, id.Subscription, id.ResourceGroup, id.Name)
+}
+
+// ValidateApplicationSecurityGroupID is a SchemaValidateFunc which validates that the value is an Application Security Group ID
+func ValidateApplicationSecurityGroupID(i interface{}, k string) ([]string, []error) {
+	return validateResourceIDFormat(i, k, func(input string) error {
+		_, err := ParseApplicationSecurityGroupID(input)
+		return err
+	})
+}
+
+// ImportApplicationSecurityGroupID is a StateFunc which validates that the ID being imported is an Application Security Group ID
+func ImportApplicationSecurityGroupID(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	return importResourceIDFormat(d, func(input string) error {
+		_, err := ParseApplicationSecurityGroupID(input)
+		return err
+	})
+}
+
+const ddosProtectionPlanIDFormat = "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/ddosProtectionPlans/{name}"
+
+// DdosProtectionPlanID is the ID of a DDoS Protection Plan in the format `/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/ddosProtectionPlans/{name}`
+type DdosProtectionPlanID struct {
+	Subscription  string
+	ResourceGroup string
+	Name          string
+}
+
+// NewDdosProtectionPlanID returns a DdosProtectionPlanID for the specified segments
+func NewDdosProtectionPlanID(subscription, resourceGroup, name string) DdosProtectionPlanID {
+	return DdosProtectionPlanID{
+		Subscription:  subscription,
+		ResourceGroup: resourceGroup,
+		Name:          name,
+	}
+}
+
+// ParseDdosProtectionPlanID parses the specified Resource ID as a DdosProtectionPlanID
+func ParseDdosProtectionPlanID(input string) (*DdosProtectionPlanID, error) {
+	segments, err := parseResourceIDFormat(ddosProtectionPlanIDFormat, input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a DDoS Protection Plan ID: %+v", input, err)
+	}
+
+	return &DdosProtectionPlanID{
+		Subscription:  segments[0],
+		ResourceGroup: segments[1],
+		Name:          segments[2],
+	}, nil
+}
+
+// String returns the DDoS Protection Plan ID in the canonical casing
+func (id DdosProtectionPlanID) String() string {
+	return formatResourceID(ddosProtectionPlanIDFormat, id.Subscription, id.ResourceGroup, id.Name)
+}
+
+// ValidateDdosProtectionPlanID is a SchemaValidateFunc which validates that the value is a DDoS Protection Plan ID
+func ValidateDdosProtectionPlanID(i interface{}, k string) ([]string, []error) {
+	return validateResourceIDFormat(i, k, func(input string) error {
+		_, err := ParseDdosProtectionPlanID(input)
+		return err
+	})
+}
+
+// ImportDdosProtectionPlanID is a StateFunc which validates that the ID being imported is a DDoS Protection Plan ID
+func ImportDdosProtectionPlanID(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	return importResourceIDFormat(d, func(input string) error {
+		_, err := ParseDdosProtectionPlanID(input)
+		return err
+	})
+}
+
+const expressRouteCircuitIDFormat = "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/expressRouteCircuits/{name}"
+
+// ExpressRouteCircuitID is the ID of an Express Route Circuit in the format `/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/expressRouteCircuits/{name}`
+type ExpressRouteCircuitID struct {
+	Subscription  string
+	ResourceGroup string
+	Name          string
+}
+
+// NewExpressRouteCircuitID returns an ExpressRouteCircuitID for the specified segments
+func NewExpressRouteCircuitID(subscription, resourceGroup, name string) ExpressRouteCircuitID {
+	return ExpressRouteCircuitID{
+		Subscription:  subscription,
+		ResourceGroup: resourceGroup,
+		Name:          name,
+	}
+}
+
+// ParseExpressRouteCircuitID parses the specified Resource ID as an ExpressRouteCircuitID
+func ParseExpressRouteCircuitID(input string) (*ExpressRouteCircuitID, error) {
+	segments, err := parseResourceIDFormat(expressRouteCircuitIDFormat, input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as an Express Route Circuit ID: %+v", input, err)
+	}
+
+	return &ExpressRouteCircuitID{
+		Subscription:  segments[0],
+		ResourceGroup: segments[1],
+		Name:          segments[2],
+	}, nil
+}
+
+// String returns the Express Route Circuit ID in the canonical casing
+func (id ExpressRouteCircuitID) String() string {
+	return formatResourceID(expressRouteCircuitIDFormat, id.Subscription, id.ResourceGroup, id.Name)
+}
+
+// ValidateExpressRouteCircuitID is a SchemaValidateFunc which validates that the value is an Express Route Circuit ID
+func ValidateExpressRouteCircuitID(i interface{}, k string) ([]string, []error) {
+	return validateResourceIDFormat(i, k, func(input string) error {
+		_, err := ParseExpressRouteCircuitID(input)
+		return err
+	})
+}
+
+// ImportExpressRouteCircuitID is a StateFunc which validates that the ID being imported is an Express Route Circuit ID
+func ImportExpressRouteCircuitID(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	return importResourceIDFormat(d, func(input string) error {
+		_, err := ParseExpressRouteCircuitID(input)
+		return err
+	})
+}
+
+const expressRouteCircuitAuthorizationIDFormat = "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/expressRouteCircuits/{expressRouteCircuit}/authorizations/{name}"
+
+// ExpressRouteCircuitAuthorizationID is the ID of an Express Route Circuit Authorization in the format `/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/expressRouteCircuits/{expressRouteCircuit}/authorizations/{name}`
+type ExpressRouteCircuitAuthorizationID struct {
+	Subscription        string
+	ResourceGroup       string
+	ExpressRouteCircuit string
+	Name                string
+}
+
+// NewExpressRouteCircuitAuthorizationID returns an ExpressRouteCircuitAuthorizationID for the specified segments
+func NewExpressRouteCircuitAuthorizationID(subscription, resourceGroup, expressRouteCircuit, name string) ExpressRouteCircuitAuthorizationID {
+	return ExpressRouteCircuitAuthorizationID{
+		Subscription:        subscription,
+		ResourceGroup:       resourceGroup,
+		ExpressRouteCircuit: expressRouteCircuit,
+		Name:                name,
+	}
+}
+
+// ParseExpressRouteCircuitAuthorizationID parses the specified Resource ID as an ExpressRouteCircuitAuthorizationID
+func ParseExpressRouteCircuitAuthorizationID(input string) (*ExpressRouteCircuitAuthorizationID, error) {
+	segments, err := parseResourceIDFormat(expressRouteCircuitAuthorizationIDFormat, input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as an Express Route Circuit Authorization ID: %+v", input, err)
+	}
+
+	return &ExpressRouteCircuitAuthorizationID{
+		Subscription:        segments[0],
+		ResourceGroup:       segments[1],
+		ExpressRouteCircuit: segments[2],
+		Name:                segments[3],
+	}, nil
+}
+
+// String returns the Express Route Circuit Authorization ID in the canonical casing
+func (id ExpressRouteCircuitAuthorizationID) String() string {
+	return formatResourceID(expressRouteCircuitAuthorizationIDFormat, id.Subscription, id.ResourceGroup, id.ExpressRouteCircuit, id.Name)
+}
+
+// ValidateExpressRouteCircuitAuthorizationID is a SchemaValidateFunc which validates that the value is an Express Route Circuit Authorization ID
+func ValidateExpressRouteCircuitAuthorizationID(i interface{}, k string) ([]string, []error) {
+	return validateResourceIDFormat(i, k, func(input string) error {
+		_, err := ParseExpressRouteCircuitAuthorizationID(input)
+		return err
+	})
+}
+
+// ImportExpressRouteCircuitAuthorizationID is a StateFunc which validates that the ID being imported is an Express Route Circuit Authorization ID
+func ImportExpressRouteCircuitAuthorizationID(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	return importResourceIDFormat(d, func(input string) error {
+		_, err := ParseExpressRouteCircuitAuthorizationID(input)
+		return err
+	})
+}
+
+const expressRouteCircuitPeeringIDFormat = "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/expressRouteCircuits/{expressRouteCircuit}/peerings/{name}"
+
+// ExpressRouteCircuitPeeringID is the ID of an Express Route Circuit Peering in the format `/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/expressRouteCircuits/{expressRouteCircuit}/peerings/{name}`
+type ExpressRouteCircuitPeeringID struct {
+	Subscription        string
+	ResourceGroup       string
+	ExpressRouteCircuit string
+	Name                string
+}
+
+// NewExpressRouteCircuitPeeringID returns an ExpressRouteCircuitPeeringID for the specified segments
+func NewExpressRouteCircuitPeeringID(subscription, resourceGroup, expressRouteCircuit, name string) ExpressRouteCircuitPeeringID {
+	return ExpressRouteCircuitPeeringID{
+		Subscription:        subscription,
+		ResourceGroup:       resourceGroup,
+		ExpressRouteCircuit: expressRouteCircuit,
+		Name:                name,
+	}
+}
+
+// ParseExpressRouteCircuitPeeringID parses the specified Resource ID as an ExpressRouteCircuitPeeringID
+func ParseExpressRouteCircuitPeeringID(input string) (*ExpressRouteCircuitPeeringID, error) {
+	segments, err := parseResourceIDFormat(expressRouteCircuitPeeringIDFormat, input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as an Express Route Circuit Peering ID: %+v", input, err)
+	}
+
+	return &ExpressRouteCircuitPeeringID{
+		Subscription:        segments[0],
+		ResourceGroup:       segments[1],
+		ExpressRouteCircuit: segments[2],
+		Name:                segments[3],
+	}, nil
+}
+
+// String returns the Express Route Circuit Peering ID in the canonical casing
+func (id ExpressRouteCircuitPeeringID) String() string {
+	return formatResourceID(expressRouteCircuitPeeringIDFormat, id.Subscription, id.ResourceGroup, id.ExpressRouteCircuit, id.Name)
+}
+
+// ValidateExpressRouteCircuitPeeringID is a SchemaValidateFunc which validates that the value is an Express Route Circuit Peering ID
+func ValidateExpressRouteCircuitPeeringID(i interface{}, k string) ([]string, []error) {
+	return validateResourceIDFormat(i, k, func(input string) error {
+		_, err := ParseExpressRouteCircuitPeeringID(input)
+		return err
+	})
+}
+
+// ImportExpressRouteCircuitPeeringID is a StateFunc which validates that the ID being imported is an Express Route Circuit Peering ID
+func ImportExpressRouteCircuitPeeringID(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	return importResourceIDFormat(d, func(input string) error {
+		_, err := ParseExpressRouteCircuitPeeringID(input)
+		return err
+	})
+}
+
+const firewallIDFormat = "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/azureFirewalls/{name}"
+
+// FirewallID is the ID of a Firewall in the format `/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/azureFirewalls/{name}`
+type FirewallID struct {
+	Subscription  string
+	ResourceGroup string
+	Name          string
+}
+
+// NewFirewallID returns a FirewallID for the specified segments
+func NewFirewallID(subscription, resourceGroup, name string) FirewallID {
+	return FirewallID{
+		Subscription:  subscription,
+		ResourceGroup: resourceGroup,
+		Name:          name,
+	}
+}
+
+// ParseFirewallID parses the specified Resource ID as a FirewallID
+func ParseFirewallID(input string) (*FirewallID, error) {
+	segments, err := parseResourceIDFormat(firewallIDFormat, input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a Firewall ID: %+v", input, err)
+	}
+
+	return &FirewallID{
+		Subscription:  segments[0],
+		ResourceGroup: segments[1],
+		Name:          segments[2],
+	}, nil
+}
+
+// String returns the Firewall ID in the canonical casing
+func (id FirewallID) String() string {
+	return formatResourceID(firewallIDFormat, id.Subscription, id.ResourceGroup, id.Name)
+}
+
+// ValidateFirewallID is a SchemaValidateFunc which validates that the value is a Firewall ID
+func ValidateFirewallID(i interface{}, k string) ([]string, []error) {
+	return validateResourceIDFormat(i, k, func(input string) error {
+		_, err := ParseFirewallID(input)
+		return err
+	})
+}
+
+// ImportFirewallID is a StateFunc which validates that the ID being imported is a Firewall ID
+func ImportFirewallID(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	return importResourceIDFormat(d, func(input string) error {
+		_, err := ParseFirewallID(input)
+		return err
+	})
+}
+
+const firewallApplicationRuleCollectionIDFormat = "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/azureFirewalls/{firewall}/applicationRuleCollections/{name}"
+
+// FirewallApplicationRuleCollectionID is the ID of a Firewall Application Rule Collection in the format `/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/azureFirewalls/{firewall}/applicationRuleCollections/{name}`
+type FirewallApplicationRuleCollectionID struct {
+	Subscription  string
+	ResourceGroup string
+	Firewall      string
+	Name          string
+}
+
+// NewFirewallApplicationRuleCollectionID returns a FirewallApplicationRuleCollectionID for the specified segments
+func NewFirewallApplicationRuleCollectionID(subscription, resourceGroup, firewall, name string) FirewallApplicationRuleCollectionID {
+	return FirewallApplicationRuleCollectionID{
+		Subscription:  subscription,
+		ResourceGroup: resourceGroup,
+		Firewall:      firewall,
+		Name:          name,
+	}
+}
+
+// ParseFirewallApplicationRuleCollectionID parses the specified Resource ID as a FirewallApplicationRuleCollectionID
+func ParseFirewallApplicationRuleCollectionID(input string) (*FirewallApplicationRuleCollectionID, error) {
+	segments, err := parseResourceIDFormat(firewallApplicationRuleCollectionIDFormat, input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a Firewall Application Rule Collection ID: %+v", input, err)
+	}
+
+	return &FirewallApplicationRuleCollectionID{
+		Subscription:  segments[0],
+		ResourceGroup: segments[1],
+		Firewall:      segments[2],
+		Name:          segments[3],
+	}, nil
+}
+
+// String returns the Firewall Application Rule Collection ID in the canonical casing
+func (id FirewallApplicationRuleCollectionID) String() string {
+	return formatResourceID(firewallApplicationRuleCollectionIDFormat, id.Subscription, id.ResourceGroup, id.Firewall, id.Name)
+}
+
+// ValidateFirewallApplicationRuleCollectionID is a SchemaValidateFunc which validates that the value is a Firewall Application Rule Collection ID
+func ValidateFirewallApplicationRuleCollectionID(i interface{}, k string) ([]string, []error) {
+	return validateResourceIDFormat(i, k, func(input string) error {
+		_, err := ParseFirewallApplicationRuleCollectionID(input)
+		return err
+	})
+}
+
+// ImportFirewallApplicationRuleCollectionID is a StateFunc which validates that the ID being imported is a Firewall Application Rule Collection ID
+func ImportFirewallApplicationRuleCollectionID(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	return importResourceIDFormat(d, func(input string) error {
+		_, err := ParseFirewallApplicationRuleCollectionID(input)
+		return err
+	})
+}
+
+const firewallNetworkRuleCollectionIDFormat = "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/azureFirewalls/{firewall}/networkRuleCollections/{name}"
+
+// FirewallNetworkRuleCollectionID is the ID of a Firewall Network Rule Collection in the format `/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/azureFirewalls/{firewall}/networkRuleCollections/{name}`
+type FirewallNetworkRuleCollectionID struct {
+	Subscription  string
+	ResourceGroup string
+	Firewall      string
+	Name          string
+}
+
+// NewFirewallNetworkRuleCollectionID returns a FirewallNetworkRuleCollectionID for the specified segments
+func NewFirewallNetworkRuleCollectionID(subscription, resourceGroup, firewall, name string) FirewallNetworkRuleCollectionID {
+	return FirewallNetworkRuleCollectionID{
+		Subscription:  subscription,
+		ResourceGroup: resourceGroup,
+		Firewall:      firewall,
+		Name:          name,
+	}
+}
+
+// ParseFirewallNetworkRuleCollectionID parses the specified Resource ID as a FirewallNetworkRuleCollectionID
+func ParseFirewallNetworkRuleCollectionID(input string) (*FirewallNetworkRuleCollectionID, error) {
+	segments, err := parseResourceIDFormat(firewallNetworkRuleCollectionIDFormat, input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a Firewall Network Rule Collection ID: %+v", input, err)
+	}
+
+	return &FirewallNetworkRuleCollectionID{
+		Subscription:  segments[0],
+		ResourceGroup: segments[1],
+		Firewall:      segments[2],
+		Name:          segments[3],
+	}, nil
+}
+
+// String returns the Firewall Network Rule Collection ID in the canonical casing
+func (id FirewallNetworkRuleCollectionID) String() string {
+	return formatResourceID(firewallNetworkRuleCollectionIDFormat, id.Subscription, id.ResourceGroup, id.Firewall, id.Name)
+}
+
+// ValidateFirewallNetworkRuleCollectionID is a SchemaValidateFunc which validates that the value is a Firewall Network Rule Collection ID
+func ValidateFirewallNetworkRuleCollectionID(i interface{}, k string) ([]string, []error) {
+	return validateResourceIDFormat(i, k, func(input string) error {
+		_, err := ParseFirewallNetworkRuleCollectionID(input)
+		return err
+	})
+}
+
+// ImportFirewallNetworkRuleCollectionID is a StateFunc which validates that the ID being imported is a Firewall Network Rule Collection ID
+func ImportFirewallNetworkRuleCollectionID(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	return importResourceIDFormat(d, func(input string) error {
+		_, err := ParseFirewallNetworkRuleCollectionID(input)
+		return err
+	})
+}
+
+const loadBalancerIDFormat = "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/loadBalancers/{name}"
+
+// LoadBalancerID is the ID of a Load Balancer in the format `/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/loadBalancers/{name}`
+type LoadBalancerID struct {
+	Subscription  string
+	ResourceGroup string
+	Name          string
+}
+
+// NewLoadBalancerID returns a LoadBalancerID for the specified segments
+func NewLoadBalancerID(subscription, resourceGroup, name string) LoadBalancerID {
+	return LoadBalancerID{
+		Subscription:  subscription,
+		ResourceGroup: resourceGroup,
+		Name:          name,
+	}
+}
+
+// ParseLoadBalancerID parses the specified Resource ID as a LoadBalancerID
+func ParseLoadBalancerID(input string) (*LoadBalancerID, error) {
+	segments, err := parseResourceIDFormat(loadBalancerIDFormat, input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a Load Balancer ID: %+v", input, err)
+	}
+
+	return &LoadBalancerID{
+		Subscription:  segments[0],
+		ResourceGroup: segments[1],
+		Name:          segments[2],
+	}, nil
+}
+
+// String returns the Load Balancer ID in the canonical casing
+func (id LoadBalancerID) String() string {
+	return formatResourceID(loadBalancerIDFormat, id.Subscription, id.ResourceGroup, id.Name)
+}
+
+// ValidateLoadBalancerID is a SchemaValidateFunc which validates that the value is a Load Balancer ID
+func ValidateLoadBalancerID(i interface{}, k string) ([]string, []error) {
+	return validateResourceIDFormat(i, k, func(input string) error {
+		_, err := ParseLoadBalancerID(input)
+		return err
+	})
+}
+
+// ImportLoadBalancerID is a StateFunc which validates that the ID being imported is a Load Balancer ID
+func ImportLoadBalancerID(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	return importResourceIDFormat(d, func(input string) error {
+		_, err := ParseLoadBalancerID(input)
+		return err
+	})
+}
+
+const loadBalancerBackendAddressPoolIDFormat = "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/loadBalancers/{loadBalancer}/backendAddressPools/{name}"
+
+// LoadBalancerBackendAddressPoolID is the ID of a Load Balancer Backend Address Pool in the format `/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/loadBalancers/{loadBalancer}/backendAddressPools/{name}`
+type LoadBalancerBackendAddressPoolID struct {
+	Subscription  string
+	ResourceGroup string
+	LoadBalancer  string
+	Name          string
+}
+
+// NewLoadBalancerBackendAddressPoolID returns a LoadBalancerBackendAddressPoolID for the specified segments
+func NewLoadBalancerBackendAddressPoolID(subscription, resourceGroup, loadBalancer, name string) LoadBalancerBackendAddressPoolID {
+	return LoadBalancerBackendAddressPoolID{
+		Subscription:  subscription,
+		ResourceGroup: resourceGroup,
+		LoadBalancer:  loadBalancer,
+		Name:          name,
+	}
+}
+
+// ParseLoadBalancerBackendAddressPoolID parses the specified Resource ID as a LoadBalancerBackendAddressPoolID
+func ParseLoadBalancerBackendAddressPoolID(input string) (*LoadBalancerBackendAddressPoolID, error) {
+	segments, err := parseResourceIDFormat(loadBalancerBackendAddressPoolIDFormat, input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a Load Balancer Backend Address Pool ID: %+v", input, err)
+	}
+
+	return &LoadBalancerBackendAddressPoolID{
+		Subscription:  segments[0],
+		ResourceGroup: segments[1],
+		LoadBalancer:  segments[2],
+		Name:          segments[3],
+	}, nil
+}
+
+// String returns the Load Balancer Backend Address Pool ID in the canonical casing
+func (id LoadBalancerBackendAddressPoolID) String() string {
+	return formatResourceID(loadBalancerBackendAddressPoolIDFormat, id.Subscription, id.ResourceGroup, id.LoadBalancer, id.Name)
+}
+
+// ValidateLoadBalancerBackendAddressPoolID is a SchemaValidateFunc which validates that the value is a Load Balancer Backend Address Pool ID
+func ValidateLoadBalancerBackendAddressPoolID(i interface{}, k string) ([]string, []error) {
+	return validateResourceIDFormat(i, k, func(input string) error {
+		_, err := ParseLoadBalancerBackendAddressPoolID(input)
+		return err
+	})
+}
+
+// ImportLoadBalancerBackendAddressPoolID is a StateFunc which validates that the ID being imported is a Load Balancer Backend Address Pool ID
+func ImportLoadBalancerBackendAddressPoolID(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	return importResourceIDFormat(d, func(input string) error {
+		_, err := ParseLoadBalancerBackendAddressPoolID(input)
+		return err
+	})
+}
+
+const loadBalancerFrontendIPConfigurationIDFormat = "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/loadBalancers/{loadBalancer}/frontendIPConfigurations/{name}"
+
+// LoadBalancerFrontendIPConfigurationID is the ID of a Load Balancer Frontend IP Configuration in the format `/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/loadBalancers/{loadBalancer}/frontendIPConfigurations/{name}`
+type LoadBalancerFrontendIPConfigurationID struct {
+	Subscription  string
+	ResourceGroup string
+	LoadBalancer  string
+	Name          string
+}
+
+// NewLoadBalancerFrontendIPConfigurationID returns a LoadBalancerFrontendIPConfigurationID for the specified segments
+func NewLoadBalancerFrontendIPConfigurationID(subscription, resourceGroup, loadBalancer, name string) LoadBalancerFrontendIPConfigurationID {
+	return LoadBalancerFrontendIPConfigurationID{
+		Subscription:  subscription,
+		ResourceGroup: resourceGroup,
+		LoadBalancer:  loadBalancer,
+		Name:          name,
+	}
+}
+
+// ParseLoadBalancerFrontendIPConfigurationID parses the specified Resource ID as a LoadBalancerFrontendIPConfigurationID
+func ParseLoadBalancerFrontendIPConfigurationID(input string) (*LoadBalancerFrontendIPConfigurationID, error) {
+	segments, err := parseResourceIDFormat(loadBalancerFrontendIPConfigurationIDFormat, input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a Load Balancer Frontend IP Configuration ID: %+v", input, err)
+	}
+
+	return &LoadBalancerFrontendIPConfigurationID{
+		Subscription:  segments[0],
+		ResourceGroup: segments[1],
+		LoadBalancer:  segments[2],
+		Name:          segments[3],
+	}, nil
+}
+
+// String returns the Load Balancer Frontend IP Configuration ID in the canonical casing
+func (id LoadBalancerFrontendIPConfigurationID) String() string {
+	return formatResourceID(loadBalancerFrontendIPConfigurationIDFormat, id.Subscription, id.ResourceGroup, id.LoadBalancer, id.Name)
+}
+
+// ValidateLoadBalancerFrontendIPConfigurationID is a SchemaValidateFunc which validates that the value is a Load Balancer Frontend IP Configuration ID
+func ValidateLoadBalancerFrontendIPConfigurationID(i interface{}, k string) ([]string, []error) {
+	return validateResourceIDFormat(i, k, func(input string) error {
+		_, err := ParseLoadBalancerFrontendIPConfigurationID(input)
+		return err
+	})
+}
+
+// ImportLoadBalancerFrontendIPConfigurationID is a StateFunc which validates that the ID being imported is a Load Balancer Frontend IP Configuration ID
+func ImportLoadBalancerFrontendIPConfigurationID(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	return importResourceIDFormat(d, func(input string) error {
+		_, err := ParseLoadBalancerFrontendIPConfigurationID(input)
+		return err
+	})
+}
+
+const loadBalancerNatPoolIDFormat = "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/loadBalancers/{loadBalancer}/inboundNatPools/{name}"
+
+// LoadBalancerNatPoolID is the ID of a Load Balancer Nat Pool in the format `/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/loadBalancers/{loadBalancer}/inboundNatPools/{name}`
+type LoadBalancerNatPoolID struct {
+	Subscription  string
+	ResourceGroup string
+	LoadBalancer  string
+	Name          string
+}
+
+// NewLoadBalancerNatPoolID returns a LoadBalancerNatPoolID for the specified segments
+func NewLoadBalancerNatPoolID(subscription, resourceGroup, loadBalancer, name string) LoadBalancerNatPoolID {
+	return LoadBalancerNatPoolID{
+		Subscription:  subscription,
+		ResourceGroup: resourceGroup,
+		LoadBalancer:  loadBalancer,
+		Name:          name,
+	}
+}
+
+// ParseLoadBalancerNatPoolID parses the specified Resource ID as a LoadBalancerNatPoolID
+func ParseLoadBalancerNatPoolID(input string) (*LoadBalancerNatPoolID, error) {
+	segments, err := parseResourceIDFormat(loadBalancerNatPoolIDFormat, input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a Load Balancer Nat Pool ID: %+v", input, err)
+	}
+
+	return &LoadBalancerNatPoolID{
+		Subscription:  segments[0],
+		ResourceGroup: segments[1],
+		LoadBalancer:  segments[2],
+		Name:          segments[3],
+	}, nil
+}
+
+// String returns the Load Balancer Nat Pool ID in the canonical casing
+func (id LoadBalancerNatPoolID) String() string {
+	return formatResourceID(loadBalancerNatPoolIDFormat, id.Subscription, id.ResourceGroup, id.LoadBalancer, id.Name)
+}
+
+// ValidateLoadBalancerNatPoolID is a SchemaValidateFunc which validates that the value is a Load Balancer Nat Pool ID
+func ValidateLoadBalancerNatPoolID(i interface{}, k string) ([]string, []error) {
+	return validateResourceIDFormat(i, k, func(input string) error {
+		_, err := ParseLoadBalancerNatPoolID(input)
+		return err
+	})
+}
+
+// ImportLoadBalancerNatPoolID is a StateFunc which validates that the ID being imported is a Load Balancer Nat Pool ID
+func ImportLoadBalancerNatPoolID(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	return importResourceIDFormat(d, func(input string) error {
+		_, err := ParseLoadBalancerNatPoolID(input)
+		return err
+	})
+}
+
+const loadBalancerNatRuleIDFormat = "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/loadBalancers/{loadBalancer}/inboundNatRules/{name}"
+
+// LoadBalancerNatRuleID is the ID of a Load Balancer Nat Rule in the format `/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/loadBalancers/{loadBalancer}/inboundNatRules/{name}`
+type LoadBalancerNatRuleID struct {
+	Subscription  string
+	ResourceGroup string
+	LoadBalancer  string
+	Name          string
+}
+
+// NewLoadBalancerNatRuleID returns a LoadBalancerNatRuleID for the specified segments
+func NewLoadBalancerNatRuleID(subscription, resourceGroup, loadBalancer, name string) LoadBalancerNatRuleID {
+	return LoadBalancerNatRuleID{
+		Subscription:  subscription,
+		ResourceGroup: resourceGroup,
+		LoadBalancer:  loadBalancer,
+		Name:          name,
+	}
+}
+
+// ParseLoadBalancerNatRuleID parses the specified Resource ID as a LoadBalancerNatRuleID
+func ParseLoadBalancerNatRuleID(input string) (*LoadBalancerNatRuleID, error) {
+	segments, err := parseResourceIDFormat(loadBalancerNatRuleIDFormat, input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a Load Balancer Nat Rule ID: %+v", input, err)
+	}
+
+	return &LoadBalancerNatRuleID{
+		Subscription:  segments[0],
+		ResourceGroup: segments[1],
+		LoadBalancer:  segments[2],
+		Name:          segments[3],
+	}, nil
+}
+
+// String returns the Load Balancer Nat Rule ID in the canonical casing
+func (id LoadBalancerNatRuleID) String() string {
+	return formatResourceID(loadBalancerNatRuleIDFormat, id.Subscription, id.ResourceGroup, id.LoadBalancer, id.Name)
+}
+
+// ValidateLoadBalancerNatRuleID is a SchemaValidateFunc which validates that the value is a Load Balancer Nat Rule ID
+func ValidateLoadBalancerNatRuleID(i interface{}, k string) ([]string, []error) {
+	return validateResourceIDFormat(i, k, func(input string) error {
+		_, err := ParseLoadBalancerNatRuleID(input)
+		return err
+	})
+}
+
+// ImportLoadBalancerNatRuleID is a StateFunc which validates that the ID being imported is a Load Balancer Nat Rule ID
+func ImportLoadBalancerNatRuleID(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	return importResourceIDFormat(d, func(input string) error {
+		_, err := ParseLoadBalancerNatRuleID(input)
+		return err
+	})
+}
+
+const loadBalancerProbeIDFormat = "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/loadBalancers/{loadBalancer}/probes/{name}"
+
+// LoadBalancerProbeID is the ID of a Load Balancer Probe in the format `/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/loadBalancers/{loadBalancer}/probes/{name}`
+type LoadBalancerProbeID struct {
+	Subscription  string
+	ResourceGroup string
+	LoadBalancer  string
+	Name          string
+}
+
+// NewLoadBalancerProbeID returns a LoadBalancerProbeID for the specified segments
+func NewLoadBalancerProbeID(subscription, resourceGroup, loadBalancer, name string) LoadBalancerProbeID {
+	return LoadBalancerProbeID{
+		Subscription:  subscription,
+		ResourceGroup: resourceGroup,
+		LoadBalancer:  loadBalancer,
+		Name:          name,
+	}
+}
+
+// ParseLoadBalancerProbeID parses the specified Resource ID as a LoadBalancerProbeID
+func ParseLoadBalancerProbeID(input string) (*LoadBalancerProbeID, error) {
+	segments, err := parseResourceIDFormat(loadBalancerProbeIDFormat, input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a Load Balancer Probe ID: %+v", input, err)
+	}
+
+	return &LoadBalancerProbeID{
+		Subscription:  segments[0],
+		ResourceGroup: segments[1],
+		LoadBalancer:  segments[2],
+		Name:          segments[3],
+	}, nil
+}
+
+// String returns the Load Balancer Probe ID in the canonical casing
+func (id LoadBalancerProbeID) String() string {
+	return formatResourceID(loadBalancerProbeIDFormat, id.Subscription, id.ResourceGroup, id.LoadBalancer, id.Name)
+}
+
+// ValidateLoadBalancerProbeID is a SchemaValidateFunc which validates that the value is a Load Balancer Probe ID
+func ValidateLoadBalancerProbeID(i interface{}, k string) ([]string, []error) {
+	return validateResourceIDFormat(i, k, func(input string) error {
+		_, err := ParseLoadBalancerProbeID(input)
+		return err
+	})
+}
+
+// ImportLoadBalancerProbeID is a StateFunc which validates that the ID being imported is a Load Balancer Probe ID
+func ImportLoadBalancerProbeID(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	return importResourceIDFormat(d, func(input string) error {
+		_, err := ParseLoadBalancerProbeID(input)
+		return err
+	})
+}
+
+const loadBalancerRuleIDFormat = "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/loadBalancers/{loadBalancer}/loadBalancingRules/{name}"
+
+// LoadBalancerRuleID is the ID of a Load Balancer Rule in the format `/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/loadBalancers/{loadBalancer}/loadBalancingRules/{name}`
+type LoadBalancerRuleID struct {
+	Subscription  string
+	ResourceGroup string
+	LoadBalancer  string
+	Name          string
+}
+
+// NewLoadBalancerRuleID returns a LoadBalancerRuleID for the specified segments
+func NewLoadBalancerRuleID(subscription, resourceGroup, loadBalancer, name string) LoadBalancerRuleID {
+	return LoadBalancerRuleID{
+		Subscription:  subscription,
+		ResourceGroup: resourceGroup,
+		LoadBalancer:  loadBalancer,
+		Name:          name,
+	}
+}
+
+// ParseLoadBalancerRuleID parses the specified Resource ID as a LoadBalancerRuleID
+func ParseLoadBalancerRuleID(input string) (*LoadBalancerRuleID, error) {
+	segments, err := parseResourceIDFormat(loadBalancerRuleIDFormat, input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a Load Balancer Rule ID: %+v", input, err)
+	}
+
+	return &LoadBalancerRuleID{
+		Subscription:  segments[0],
+		ResourceGroup: segments[1],
+		LoadBalancer:  segments[2],
+		Name:          segments[3],
+	}, nil
+}
+
+// String returns the Load Balancer Rule ID in the canonical casing
+func (id LoadBalancerRuleID) String() string {
+	return formatResourceID(loadBalancerRuleIDFormat, id.Subscription, id.ResourceGroup, id.LoadBalancer, id.Name)
+}
+
+// ValidateLoadBalancerRuleID is a SchemaValidateFunc which validates that the value is a Load Balancer Rule ID
+func ValidateLoadBalancerRuleID(i interface{}, k string) ([]string, []error) {
+	return validateResourceIDFormat(i, k, func(input string) error {
+		_, err := ParseLoadBalancerRuleID(input)
+		return err
+	})
+}
+
+// ImportLoadBalancerRuleID is a StateFunc which validates that the ID being imported is a Load Balancer Rule ID
+func ImportLoadBalancerRuleID(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	return importResourceIDFormat(d, func(input string) error {
+		_, err := ParseLoadBalancerRuleID(input)
+		return err
+	})
+}
+
+const localNetworkGatewayIDFormat = "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/localNetworkGateways/{name}"
+
+// LocalNetworkGatewayID is the ID of a Local Network Gateway in the format `/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/localNetworkGateways/{name}`
+type LocalNetworkGatewayID struct {
+	Subscription  string
+	ResourceGroup string
+	Name          string
+}
+
+// NewLocalNetworkGatewayID returns a LocalNetworkGatewayID for the specified segments
+func NewLocalNetworkGatewayID(subscription, resourceGroup, name string) LocalNetworkGatewayID {
+	return LocalNetworkGatewayID{
+		Subscription:  subscription,
+		ResourceGroup: resourceGroup,
+		Name:          name,
+	}
+}
+
+// ParseLocalNetworkGatewayID parses the specified Resource ID as a LocalNetworkGatewayID
+func ParseLocalNetworkGatewayID(input string) (*LocalNetworkGatewayID, error) {
+	segments, err := parseResourceIDFormat(localNetworkGatewayIDFormat, input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a Local Network Gateway ID: %+v", input, err)
+	}
+
+	return &LocalNetworkGatewayID{
+		Subscription:  segments[0],
+		ResourceGroup: segments[1],
+		Name:          segments[2],
+	}, nil
+}
+
+// String returns the Local Network Gateway ID in the canonical casing
+func (id LocalNetworkGatewayID) String() string {
+	return formatResourceID(localNetworkGatewayIDFormat, id.Subscription, id.ResourceGroup, id.Name)
+}
+
+// ValidateLocalNetworkGatewayID is a SchemaValidateFunc which validates that the value is a Local Network Gateway ID
+func ValidateLocalNetworkGatewayID(i interface{}, k string) ([]string, []error) {
+	return validateResourceIDFormat(i, k, func(input string) error {
+		_, err := ParseLocalNetworkGatewayID(input)
+		return err
+	})
+}
+
+// ImportLocalNetworkGatewayID is a StateFunc which validates that the ID being imported is a Local Network Gateway ID
+func ImportLocalNetworkGatewayID(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	return importResourceIDFormat(d, func(input string) error {
+		_, err := ParseLocalNetworkGatewayID(input)
+		return err
+	})
+}
+
+const networkInterfaceIDFormat = "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/networkInterfaces/{name}"
+
+// NetworkInterfaceID is the ID of a Network Interface in the format `/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/networkInterfaces/{name}`
+type NetworkInterfaceID struct {
+	Subscription  string
+	ResourceGroup string
+	Name          string
+}
+
+// NewNetworkInterfaceID returns a NetworkInterfaceID for the specified segments
+func NewNetworkInterfaceID(subscription, resourceGroup, name string) NetworkInterfaceID {
+	return NetworkInterfaceID{
+		Subscription:  subscription,
+		ResourceGroup: resourceGroup,
+		Name:          name,
+	}
+}
+
+// ParseNetworkInterfaceID parses the specified Resource ID as a NetworkInterfaceID
+func ParseNetworkInterfaceID(input string) (*NetworkInterfaceID, error) {
+	segments, err := parseResourceIDFormat(networkInterfaceIDFormat, input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a Network Interface ID: %+v", input, err)
+	}
+
+	return &NetworkInterfaceID{
+		Subscription:  segments[0],
+		ResourceGroup: segments[1],
+		Name:          segments[2],
+	}, nil
+}
+
+// String returns the Network Interface ID in the canonical casing
+func (id NetworkInterfaceID) String() string {
+	return formatResourceID(networkInterfaceIDFormat, id.Subscription, id.ResourceGroup, id.Name)
+}
+
+// ValidateNetworkInterfaceID is a SchemaValidateFunc which validates that the value is a Network Interface ID
+func ValidateNetworkInterfaceID(i interface{}, k string) ([]string, []error) {
+	return validateResourceIDFormat(i, k, func(input string) error {
+		_, err := ParseNetworkInterfaceID(input)
+		return err
+	})
+}
+
+// ImportNetworkInterfaceID is a StateFunc which validates that the ID being imported is a Network Interface ID
+func ImportNetworkInterfaceID(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	return importResourceIDFormat(d, func(input string) error {
+		_, err := ParseNetworkInterfaceID(input)
+		return err
+	})
+}
+
+const networkSecurityGroupIDFormat = "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/networkSecurityGroups/{name}"
+
+// NetworkSecurityGroupID is the ID of a Network Security Group in the format `/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/networkSecurityGroups/{name}`
+type NetworkSecurityGroupID struct {
+	Subscription  string
+	ResourceGroup string
+	Name          string
+}
+
+// NewNetworkSecurityGroupID returns a NetworkSecurityGroupID for the specified segments
+func NewNetworkSecurityGroupID(subscription, resourceGroup, name string) NetworkSecurityGroupID {
+	return NetworkSecurityGroupID{
+		Subscription:  subscription,
+		ResourceGroup: resourceGroup,
+		Name:          name,
+	}
+}
+
+// ParseNetworkSecurityGroupID parses the specified Resource ID as a NetworkSecurityGroupID
+func ParseNetworkSecurityGroupID(input string) (*NetworkSecurityGroupID, error) {
+	segments, err := parseResourceIDFormat(networkSecurityGroupIDFormat, input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a Network Security Group ID: %+v", input, err)
+	}
+
+	return &NetworkSecurityGroupID{
+		Subscription:  segments[0],
+		ResourceGroup: segments[1],
+		Name:          segments[2],
+	}, nil
+}
+
+// String returns the Network Security Group ID in the canonical casing
+func (id NetworkSecurityGroupID) String() string {
+	return formatResourceID(networkSecurityGroupIDFormat, id.Subscription, id.ResourceGroup, id.Name)
+}
+
+// ValidateNetworkSecurityGroupID is a SchemaValidateFunc which validates that the value is a Network Security Group ID
+func ValidateNetworkSecurityGroupID(i interface{}, k string) ([]string, []error) {
+	return validateResourceIDFormat(i, k, func(input string) error {
+		_, err := ParseNetworkSecurityGroupID(input)
+		return err
+	})
+}
+
+// ImportNetworkSecurityGroupID is a StateFunc which validates that the ID being imported is a Network Security Group ID
+func ImportNetworkSecurityGroupID(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	return importResourceIDFormat(d, func(input string) error {
+		_, err := ParseNetworkSecurityGroupID(input)
+		return err
+	})
+}
+
+const networkSecurityRuleIDFormat = "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/networkSecurityGroups/{networkSecurityGroup}/securityRules/{name}"
+
+// NetworkSecurityRuleID is the ID of a Network Security Rule in the format `/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/networkSecurityGroups/{networkSecurityGroup}/securityRules/{name}`
+type NetworkSecurityRuleID struct {
+	Subscription         string
+	ResourceGroup        string
+	NetworkSecurityGroup string
+	Name                 string
+}
+
+// NewNetworkSecurityRuleID returns a NetworkSecurityRuleID for the specified segments
+func NewNetworkSecurityRuleID(subscription, resourceGroup, networkSecurityGroup, name string) NetworkSecurityRuleID {
+	return NetworkSecurityRuleID{
+		Subscription:         subscription,
+		ResourceGroup:        resourceGroup,
+		NetworkSecurityGroup: networkSecurityGroup,
+		Name:                 name,
+	}
+}
+
+// ParseNetworkSecurityRuleID parses the specified Resource ID as a NetworkSecurityRuleID
+func ParseNetworkSecurityRuleID(input string) (*NetworkSecurityRuleID, error) {
+	segments, err := parseResourceIDFormat(networkSecurityRuleIDFormat, input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a Network Security Rule ID: %+v", input, err)
+	}
+
+	return &NetworkSecurityRuleID{
+		Subscription:         segments[0],
+		ResourceGroup:        segments[1],
+		NetworkSecurityGroup: segments[2],
+		Name:                 segments[3],
+	}, nil
+}
+
+// String returns the Network Security Rule ID in the canonical casing
+func (id NetworkSecurityRuleID) String() string {
+	return formatResourceID(networkSecurityRuleIDFormat, id.Subscription, id.ResourceGroup, id.NetworkSecurityGroup, id.Name)
+}
+
+// ValidateNetworkSecurityRuleID is a SchemaValidateFunc which validates that the value is a Network Security Rule ID
+func ValidateNetworkSecurityRuleID(i interface{}, k string) ([]string, []error) {
+	return validateResourceIDFormat(i, k, func(input string) error {
+		_, err := ParseNetworkSecurityRuleID(input)
+		return err
+	})
+}
+
+// ImportNetworkSecurityRuleID is a StateFunc which validates that the ID being imported is a Network Security Rule ID
+func ImportNetworkSecurityRuleID(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	return importResourceIDFormat(d, func(input string) error {
+		_, err := ParseNetworkSecurityRuleID(input)
+		return err
+	})
+}
+
+const networkWatcherIDFormat = "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/networkWatchers/{name}"
+
+// NetworkWatcherID is the ID of a Network Watcher in the format `/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/networkWatchers/{name}`
+type NetworkWatcherID struct {
+	Subscription  string
+	ResourceGroup string
+	Name          string
+}
+
+// NewNetworkWatcherID returns a NetworkWatcherID for the specified segments
+func NewNetworkWatcherID(subscription, resourceGroup, name string) NetworkWatcherID {
+	return NetworkWatcherID{
+		Subscription:  subscription,
+		ResourceGroup: resourceGroup,
+		Name:          name,
+	}
+}
+
+// ParseNetworkWatcherID parses the specified Resource ID as a NetworkWatcherID
+func ParseNetworkWatcherID(input string) (*NetworkWatcherID, error) {
+	segments, err := parseResourceIDFormat(networkWatcherIDFormat, input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a Network Watcher ID: %+v", input, err)
+	}
+
+	return &NetworkWatcherID{
+		Subscription:  segments[0],
+		ResourceGroup: segments[1],
+		Name:          segments[2],
+	}, nil
+}
+
+// String returns the Network Watcher ID in the canonical casing
+func (id NetworkWatcherID) String() string {
+	return formatResourceID(networkWatcherIDFormat, id.Subscription, id.ResourceGroup, id.Name)
+}
+
+// ValidateNetworkWatcherID is a SchemaValidateFunc which validates that the value is a Network Watcher ID
+func ValidateNetworkWatcherID(i interface{}, k string) ([]string, []error) {
+	return validateResourceIDFormat(i, k, func(input string) error {
+		_, err := ParseNetworkWatcherID(input)
+		return err
+	})
+}
+
+// ImportNetworkWatcherID is a StateFunc which validates that the ID being imported is a Network Watcher ID
+func ImportNetworkWatcherID(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	return importResourceIDFormat(d, func(input string) error {
+		_, err := ParseNetworkWatcherID(input)
+		return err
+	})
+}
+
+const packetCaptureIDFormat = "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/networkWatchers/{networkWatcher}/packetCaptures/{name}"
+
+// PacketCaptureID is the ID of a Packet Capture in the format `/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/networkWatchers/{networkWatcher}/packetCaptures/{name}`
+type PacketCaptureID struct {
+	Subscription   string
+	ResourceGroup  string
+	NetworkWatcher string
+	Name           string
+}
+
+// NewPacketCaptureID returns a PacketCaptureID for the specified segments
+func NewPacketCaptureID(subscription, resourceGroup, networkWatcher, name string) PacketCaptureID {
+	return PacketCaptureID{
+		Subscription:   subscription,
+		ResourceGroup:  resourceGroup,
+		NetworkWatcher: networkWatcher,
+		Name:           name,
+	}
+}
+
+// ParsePacketCaptureID parses the specified Resource ID as a PacketCaptureID
+func ParsePacketCaptureID(input string) (*PacketCaptureID, error) {
+	segments, err := parseResourceIDFormat(packetCaptureIDFormat, input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a Packet Capture ID: %+v", input, err)
+	}
+
+	return &PacketCaptureID{
+		Subscription:   segments[0],
+		ResourceGroup:  segments[1],
+		NetworkWatcher: segments[2],
+		Name:           segments[3],
+	}, nil
+}
+
+// String returns the Packet Capture ID in the canonical casing
+func (id PacketCaptureID) String() string {
+	return formatResourceID(packetCaptureIDFormat, id.Subscription, id.ResourceGroup, id.NetworkWatcher, id.Name)
+}
+
+// ValidatePacketCaptureID is a SchemaValidateFunc which validates that the value is a Packet Capture ID
+func ValidatePacketCaptureID(i interface{}, k string) ([]string, []error) {
+	return validateResourceIDFormat(i, k, func(input string) error {
+		_, err := ParsePacketCaptureID(input)
+		return err
+	})
+}
+
+// ImportPacketCaptureID is a StateFunc which validates that the ID being imported is a Packet Capture ID
+func ImportPacketCaptureID(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	return importResourceIDFormat(d, func(input string) error {
+		_, err := ParsePacketCaptureID(input)
+		return err
+	})
+}
+
+const publicIPAddressIDFormat = "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/publicIPAddresses/{name}"
+
+// PublicIPAddressID is the ID of a Public IP Address in the format `/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/publicIPAddresses/{name}`
+type PublicIPAddressID struct {
+	Subscription  string
+	ResourceGroup string
+	Name          string
+}
+
+// NewPublicIPAddressID returns a PublicIPAddressID for the specified segments
+func NewPublicIPAddressID(subscription, resourceGroup, name string) PublicIPAddressID {
+	return PublicIPAddressID{
+		Subscription:  subscription,
+		ResourceGroup: resourceGroup,
+		Name:          name,
+	}
+}
+
+// ParsePublicIPAddressID parses the specified Resource ID as a PublicIPAddressID
+func ParsePublicIPAddressID(input string) (*PublicIPAddressID, error) {
+	segments, err := parseResourceIDFormat(publicIPAddressIDFormat, input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a Public IP Address ID: %+v", input, err)
+	}
+
+	return &PublicIPAddressID{
+		Subscription:  segments[0],
+		ResourceGroup: segments[1],
+		Name:          segments[2],
+	}, nil
+}
+
+// String returns the Public IP Address ID in the canonical casing
+func (id PublicIPAddressID) String() string {
+	return formatResourceID(publicIPAddressIDFormat, id.Subscription, id.ResourceGroup, id.Name)
+}
+
+// ValidatePublicIPAddressID is a SchemaValidateFunc which validates that the value is a Public IP Address ID
+func ValidatePublicIPAddressID(i interface{}, k string) ([]string, []error) {
+	return validateResourceIDFormat(i, k, func(input string) error {
+		_, err := ParsePublicIPAddressID(input)
+		return err
+	})
+}
+
+// ImportPublicIPAddressID is a StateFunc which validates that the ID being imported is a Public IP Address ID
+func ImportPublicIPAddressID(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	return importResourceIDFormat(d, func(input string) error {
+		_, err := ParsePublicIPAddressID(input)
+		return err
+	})
+}
+
+const routeIDFormat = "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/routeTables/{routeTable}/routes/{name}"
+
+// RouteID is the ID of a Route in the format `/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/routeTables/{routeTable}/routes/{name}`
+type RouteID struct {
+	Subscription  string
+	ResourceGroup string
+	RouteTable    string
+	Name          string
+}
+
+// NewRouteID returns a RouteID for the specified segments
+func NewRouteID(subscription, resourceGroup, routeTable, name string) RouteID {
+	return RouteID{
+		Subscription:  subscription,
+		ResourceGroup: resourceGroup,
+		RouteTable:    routeTable,
+		Name:          name,
+	}
+}
+
+// ParseRouteID parses the specified Resource ID as a RouteID
+func ParseRouteID(input string) (*RouteID, error) {
+	segments, err := parseResourceIDFormat(routeIDFormat, input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a Route ID: %+v", input, err)
+	}
+
+	return &RouteID{
+		Subscription:  segments[0],
+		ResourceGroup: segments[1],
+		RouteTable:    segments[2],
+		Name:          segments[3],
+	}, nil
+}
+
+// String returns the Route ID in the canonical casing
+func (id RouteID) String() string {
+	return formatResourceID(routeIDFormat, id.Subscription, id.ResourceGroup, id.RouteTable, id.Name)
+}
+
+// ValidateRouteID is a SchemaValidateFunc which validates that the value is a Route ID
+func ValidateRouteID(i interface{}, k string) ([]string, []error) {
+	return validateResourceIDFormat(i, k, func(input string) error {
+		_, err := ParseRouteID(input)
+		return err
+	})
+}
+
+// ImportRouteID is a StateFunc which validates that the ID being imported is a Route ID
+func ImportRouteID(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	return importResourceIDFormat(d, func(input string) error {
+		_, err := ParseRouteID(input)
+		return err
+	})
+}
+
+const routeTableIDFormat = "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/routeTables/{name}"
+
+// RouteTableID is the ID of a Route Table in the format `/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/routeTables/{name}`
+type RouteTableID struct {
+	Subscription  string
+	ResourceGroup string
+	Name          string
+}
+
+// NewRouteTableID returns a RouteTableID for the specified segments
+func NewRouteTableID(subscription, resourceGroup, name string) RouteTableID {
+	return RouteTableID{
+		Subscription:  subscription,
+		ResourceGroup: resourceGroup,
+		Name:          name,
+	}
+}
+
+// ParseRouteTableID parses the specified Resource ID as a RouteTableID
+func ParseRouteTableID(input string) (*RouteTableID, error) {
+	segments, err := parseResourceIDFormat(routeTableIDFormat, input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a Route Table ID: %+v", input, err)
+	}
+
+	return &RouteTableID{
+		Subscription:  segments[0],
+		ResourceGroup: segments[1],
+		Name:          segments[2],
+	}, nil
+}
+
+// String returns the Route Table ID in the canonical casing
+func (id RouteTableID) String() string {
+	return formatResourceID(routeTableIDFormat, id.Subscription, id.ResourceGroup, id.Name)
+}
+
+// ValidateRouteTableID is a SchemaValidateFunc which validates that the value is a Route Table ID
+func ValidateRouteTableID(i interface{}, k string) ([]string, []error) {
+	return validateResourceIDFormat(i, k, func(input string) error {
+		_, err := ParseRouteTableID(input)
+		return err
+	})
+}
+
+// ImportRouteTableID is a StateFunc which validates that the ID being imported is a Route Table ID
+func ImportRouteTableID(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	return importResourceIDFormat(d, func(input string) error {
+		_, err := ParseRouteTableID(input)
+		return err
+	})
+}
+
+const subnetIDFormat = "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/virtualNetworks/{virtualNetwork}/subnets/{name}"
+
+// SubnetID is the ID of a Subnet in the format `/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/virtualNetworks/{virtualNetwork}/subnets/{name}`
+type SubnetID struct {
+	Subscription   string
+	ResourceGroup  string
+	VirtualNetwork string
+	Name           string
+}
+
+// NewSubnetID returns a SubnetID for the specified segments
+func NewSubnetID(subscription, resourceGroup, virtualNetwork, name string) SubnetID {
+	return SubnetID{
+		Subscription:   subscription,
+		ResourceGroup:  resourceGroup,
+		VirtualNetwork: virtualNetwork,
+		Name:           name,
+	}
+}
+
+// ParseSubnetID parses the specified Resource ID as a SubnetID
+func ParseSubnetID(input string) (*SubnetID, error) {
+	segments, err := parseResourceIDFormat(subnetIDFormat, input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a Subnet ID: %+v", input, err)
+	}
+
+	return &SubnetID{
+		Subscription:   segments[0],
+		ResourceGroup:  segments[1],
+		VirtualNetwork: segments[2],
+		Name:           segments[3],
+	}, nil
+}
+
+// String returns the Subnet ID in the canonical casing
+func (id SubnetID) String() string {
+	return formatResourceID(subnetIDFormat, id.Subscription, id.ResourceGroup, id.VirtualNetwork, id.Name)
+}
+
+// ValidateSubnetID is a SchemaValidateFunc which validates that the value is a Subnet ID
+func ValidateSubnetID(i interface{}, k string) ([]string, []error) {
+	return validateResourceIDFormat(i, k, func(input string) error {
+		_, err := ParseSubnetID(input)
+		return err
+	})
+}
+
+// ImportSubnetID is a StateFunc which validates that the ID being imported is a Subnet ID
+func ImportSubnetID(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	return importResourceIDFormat(d, func(input string) error {
+		_, err := ParseSubnetID(input)
+		return err
+	})
+}
+
+const trafficManagerProfileIDFormat = "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/trafficManagerProfiles/{name}"
+
+// TrafficManagerProfileID is the ID of a Traffic Manager Profile in the format `/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/trafficManagerProfiles/{name}`
+type TrafficManagerProfileID struct {
+	Subscription  string
+	ResourceGroup string
+	Name          string
+}
+
+// NewTrafficManagerProfileID returns a TrafficManagerProfileID for the specified segments
+func NewTrafficManagerProfileID(subscription, resourceGroup, name string) TrafficManagerProfileID {
+	return TrafficManagerProfileID{
+		Subscription:  subscription,
+		ResourceGroup: resourceGroup,
+		Name:          name,
+	}
+}
+
+// ParseTrafficManagerProfileID parses the specified Resource ID as a TrafficManagerProfileID
+func ParseTrafficManagerProfileID(input string) (*TrafficManagerProfileID, error) {
+	segments, err := parseResourceIDFormat(trafficManagerProfileIDFormat, input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a Traffic Manager Profile ID: %+v", input, err)
+	}
+
+	return &TrafficManagerProfileID{
+		Subscription:  segments[0],
+		ResourceGroup: segments[1],
+		Name:          segments[2],
+	}, nil
+}
+
+// String returns the Traffic Manager Profile ID in the canonical casing
+func (id TrafficManagerProfileID) String() string {
+	return formatResourceID(trafficManagerProfileIDFormat, id.Subscription, id.ResourceGroup, id.Name)
+}
+
+// ValidateTrafficManagerProfileID is a SchemaValidateFunc which validates that the value is a Traffic Manager Profile ID
+func ValidateTrafficManagerProfileID(i interface{}, k string) ([]string, []error) {
+	return validateResourceIDFormat(i, k, func(input string) error {
+		_, err := ParseTrafficManagerProfileID(input)
+		return err
+	})
+}
+
+// ImportTrafficManagerProfileID is a StateFunc which validates that the ID being imported is a Traffic Manager Profile ID
+func ImportTrafficManagerProfileID(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	return importResourceIDFormat(d, func(input string) error {
+		_, err := ParseTrafficManagerProfileID(input)
+		return err
+	})
+}
+
+const virtualHubIDFormat = "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/virtualHubs/{name}"
+
+// VirtualHubID is the ID of a Virtual Hub in the format `/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/virtualHubs/{name}`
+type VirtualHubID struct {
+	Subscription  string
+	ResourceGroup string
+	Name          string
+}
+
+// NewVirtualHubID returns a VirtualHubID for the specified segments
+func NewVirtualHubID(subscription, resourceGroup, name string) VirtualHubID {
+	return VirtualHubID{
+		Subscription:  subscription,
+		ResourceGroup: resourceGroup,
+		Name:          name,
+	}
+}
+
+// ParseVirtualHubID parses the specified Resource ID as a VirtualHubID
+func ParseVirtualHubID(input string) (*VirtualHubID, error) {
+	segments, err := parseResourceIDFormat(virtualHubIDFormat, input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a Virtual Hub ID: %+v", input, err)
+	}
+
+	return &VirtualHubID{
+		Subscription:  segments[0],
+		ResourceGroup: segments[1],
+		Name:          segments[2],
+	}, nil
+}
+
+// String returns the Virtual Hub ID in the canonical casing
+func (id VirtualHubID) String() string {
+	return formatResourceID(virtualHubIDFormat, id.Subscription, id.ResourceGroup, id.Name)
+}
+
+// ValidateVirtualHubID is a SchemaValidateFunc which validates that the value is a Virtual Hub ID
+func ValidateVirtualHubID(i interface{}, k string) ([]string, []error) {
+	return validateResourceIDFormat(i, k, func(input string) error {
+		_, err := ParseVirtualHubID(input)
+		return err
+	})
+}
+
+// ImportVirtualHubID is a StateFunc which validates that the ID being imported is a Virtual Hub ID
+func ImportVirtualHubID(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	return importResourceIDFormat(d, func(input string) error {
+		_, err := ParseVirtualHubID(input)
+		return err
+	})
+}
+
+const virtualHubConnectionIDFormat = "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/virtualHubs/{virtualHub}/hubVirtualNetworkConnections/{name}"
+
+// VirtualHubConnectionID is the ID of a Virtual Hub Connection in the format `/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/virtualHubs/{virtualHub}/hubVirtualNetworkConnections/{name}`
+type VirtualHubConnectionID struct {
+	Subscription  string
+	ResourceGroup string
+	VirtualHub    string
+	Name          string
+}
+
+// NewVirtualHubConnectionID returns a VirtualHubConnectionID for the specified segments
+func NewVirtualHubConnectionID(subscription, resourceGroup, virtualHub, name string) VirtualHubConnectionID {
+	return VirtualHubConnectionID{
+		Subscription:  subscription,
+		ResourceGroup: resourceGroup,
+		VirtualHub:    virtualHub,
+		Name:          name,
+	}
+}
+
+// ParseVirtualHubConnectionID parses the specified Resource ID as a VirtualHubConnectionID
+func ParseVirtualHubConnectionID(input string) (*VirtualHubConnectionID, error) {
+	segments, err := parseResourceIDFormat(virtualHubConnectionIDFormat, input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a Virtual Hub Connection ID: %+v", input, err)
+	}
+
+	return &VirtualHubConnectionID{
+		Subscription:  segments[0],
+		ResourceGroup: segments[1],
+		VirtualHub:    segments[2],
+		Name:          segments[3],
+	}, nil
+}
+
+// String returns the Virtual Hub Connection ID in the canonical casing
+func (id VirtualHubConnectionID) String() string {
+	return formatResourceID(virtualHubConnectionIDFormat, id.Subscription, id.ResourceGroup, id.VirtualHub, id.Name)
+}
+
+// ValidateVirtualHubConnectionID is a SchemaValidateFunc which validates that the value is a Virtual Hub Connection ID
+func ValidateVirtualHubConnectionID(i interface{}, k string) ([]string, []error) {
+	return validateResourceIDFormat(i, k, func(input string) error {
+		_, err := ParseVirtualHubConnectionID(input)
+		return err
+	})
+}
+
+// ImportVirtualHubConnectionID is a StateFunc which validates that the ID being imported is a Virtual Hub Connection ID
+func ImportVirtualHubConnectionID(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	return importResourceIDFormat(d, func(input string) error {
+		_, err := ParseVirtualHubConnectionID(input)
+		return err
+	})
+}
+
+const virtualNetworkIDFormat = "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/virtualNetworks/{name}"
+
+// VirtualNetworkID is the ID of a Virtual Network in the format `/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/virtualNetworks/{name}`
+type VirtualNetworkID struct {
+	Subscription  string
+	ResourceGroup string
+	Name          string
+}
+
+// NewVirtualNetworkID returns a VirtualNetworkID for the specified segments
+func NewVirtualNetworkID(subscription, resourceGroup, name string) VirtualNetworkID {
+	return VirtualNetworkID{
+		Subscription:  subscription,
+		ResourceGroup: resourceGroup,
+		Name:          name,
+	}
+}
+
+// ParseVirtualNetworkID parses the specified Resource ID as a VirtualNetworkID
+func ParseVirtualNetworkID(input string) (*VirtualNetworkID, error) {
+	segments, err := parseResourceIDFormat(virtualNetworkIDFormat, input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a Virtual Network ID: %+v", input, err)
+	}
+
+	return &VirtualNetworkID{
+		Subscription:  segments[0],
+		ResourceGroup: segments[1],
+		Name:          segments[2],
+	}, nil
+}
+
+// String returns the Virtual Network ID in the canonical casing
+func (id VirtualNetworkID) String() string {
+	return formatResourceID(virtualNetworkIDFormat, id.Subscription, id.ResourceGroup, id.Name)
+}
+
+// ValidateVirtualNetworkID is a SchemaValidateFunc which validates that the value is a Virtual Network ID
+func ValidateVirtualNetworkID(i interface{}, k string) ([]string, []error) {
+	return validateResourceIDFormat(i, k, func(input string) error {
+		_, err := ParseVirtualNetworkID(input)
+		return err
+	})
+}
+
+// ImportVirtualNetworkID is a StateFunc which validates that the ID being imported is a Virtual Network ID
+func ImportVirtualNetworkID(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	return importResourceIDFormat(d, func(input string) error {
+		_, err := ParseVirtualNetworkID(input)
+		return err
+	})
+}
+
+const virtualNetworkGatewayIDFormat = "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/virtualNetworkGateways/{name}"
+
+// VirtualNetworkGatewayID is the ID of a Virtual Network Gateway in the format `/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/virtualNetworkGateways/{name}`
+type VirtualNetworkGatewayID struct {
+	Subscription  string
+	ResourceGroup string
+	Name          string
+}
+
+// NewVirtualNetworkGatewayID returns a VirtualNetworkGatewayID for the specified segments
+func NewVirtualNetworkGatewayID(subscription, resourceGroup, name string) VirtualNetworkGatewayID {
+	return VirtualNetworkGatewayID{
+		Subscription:  subscription,
+		ResourceGroup: resourceGroup,
+		Name:          name,
+	}
+}
+
+// ParseVirtualNetworkGatewayID parses the specified Resource ID as a VirtualNetworkGatewayID
+func ParseVirtualNetworkGatewayID(input string) (*VirtualNetworkGatewayID, error) {
+	segments, err := parseResourceIDFormat(virtualNetworkGatewayIDFormat, input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a Virtual Network Gateway ID: %+v", input, err)
+	}
+
+	return &VirtualNetworkGatewayID{
+		Subscription:  segments[0],
+		ResourceGroup: segments[1],
+		Name:          segments[2],
+	}, nil
+}
+
+// String returns the Virtual Network Gateway ID in the canonical casing
+func (id VirtualNetworkGatewayID) String() string {
+	return formatResourceID(virtualNetworkGatewayIDFormat, id.Subscription, id.ResourceGroup, id.Name)
+}
+
+// ValidateVirtualNetworkGatewayID is a SchemaValidateFunc which validates that the value is a Virtual Network Gateway ID
+func ValidateVirtualNetworkGatewayID(i interface{}, k string) ([]string, []error) {
+	return validateResourceIDFormat(i, k, func(input string) error {
+		_, err := ParseVirtualNetworkGatewayID(input)
+		return err
+	})
+}
+
+// ImportVirtualNetworkGatewayID is a StateFunc which validates that the ID being imported is a Virtual Network Gateway ID
+func ImportVirtualNetworkGatewayID(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	return importResourceIDFormat(d, func(input string) error {
+		_, err := ParseVirtualNetworkGatewayID(input)
+		return err
+	})
+}
+
+const virtualNetworkGatewayConnectionIDFormat = "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/connections/{name}"
+
+// VirtualNetworkGatewayConnectionID is the ID of a Virtual Network Gateway Connection in the format `/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/connections/{name}`
+type VirtualNetworkGatewayConnectionID struct {
+	Subscription  string
+	ResourceGroup string
+	Name          string
+}
+
+// NewVirtualNetworkGatewayConnectionID returns a VirtualNetworkGatewayConnectionID for the specified segments
+func NewVirtualNetworkGatewayConnectionID(subscription, resourceGroup, name string) VirtualNetworkGatewayConnectionID {
+	return VirtualNetworkGatewayConnectionID{
+		Subscription:  subscription,
+		ResourceGroup: resourceGroup,
+		Name:          name,
+	}
+}
+
+// ParseVirtualNetworkGatewayConnectionID parses the specified Resource ID as a VirtualNetworkGatewayConnectionID
+func ParseVirtualNetworkGatewayConnectionID(input string) (*VirtualNetworkGatewayConnectionID, error) {
+	segments, err := parseResourceIDFormat(virtualNetworkGatewayConnectionIDFormat, input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a Virtual Network Gateway Connection ID: %+v", input, err)
+	}
+
+	return &VirtualNetworkGatewayConnectionID{
+		Subscription:  segments[0],
+		ResourceGroup: segments[1],
+		Name:          segments[2],
+	}, nil
+}
+
+// String returns the Virtual Network Gateway Connection ID in the canonical casing
+func (id VirtualNetworkGatewayConnectionID) String() string {
+	return formatResourceID(virtualNetworkGatewayConnectionIDFormat, id.Subscription, id.ResourceGroup, id.Name)
+}
+
+// ValidateVirtualNetworkGatewayConnectionID is a SchemaValidateFunc which validates that the value is a Virtual Network Gateway Connection ID
+func ValidateVirtualNetworkGatewayConnectionID(i interface{}, k string) ([]string, []error) {
+	return validateResourceIDFormat(i, k, func(input string) error {
+		_, err := ParseVirtualNetworkGatewayConnectionID(input)
+		return err
+	})
+}
+
+// ImportVirtualNetworkGatewayConnectionID is a StateFunc which validates that the ID being imported is a Virtual Network Gateway Connection ID
+func ImportVirtualNetworkGatewayConnectionID(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	return importResourceIDFormat(d, func(input string) error {
+		_, err := ParseVirtualNetworkGatewayConnectionID(input)
+		return err
+	})
+}
+
+const virtualNetworkPeeringIDFormat = "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/virtualNetworks/{virtualNetwork}/virtualNetworkPeerings/{name}"
+
+// VirtualNetworkPeeringID is the ID of a Virtual Network Peering in the format `/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/virtualNetworks/{virtualNetwork}/virtualNetworkPeerings/{name}`
+type VirtualNetworkPeeringID struct {
+	Subscription   string
+	ResourceGroup  string
+	VirtualNetwork string
+	Name           string
+}
+
+// NewVirtualNetworkPeeringID returns a VirtualNetworkPeeringID for the specified segments
+func NewVirtualNetworkPeeringID(subscription, resourceGroup, virtualNetwork, name string) VirtualNetworkPeeringID {
+	return VirtualNetworkPeeringID{
+		Subscription:   subscription,
+		ResourceGroup:  resourceGroup,
+		VirtualNetwork: virtualNetwork,
+		Name:           name,
+	}
+}
+
+// ParseVirtualNetworkPeeringID parses the specified Resource ID as a VirtualNetworkPeeringID
+func ParseVirtualNetworkPeeringID(input string) (*VirtualNetworkPeeringID, error) {
+	segments, err := parseResourceIDFormat(virtualNetworkPeeringIDFormat, input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a Virtual Network Peering ID: %+v", input, err)
+	}
+
+	return &VirtualNetworkPeeringID{
+		Subscription:   segments[0],
+		ResourceGroup:  segments[1],
+		VirtualNetwork: segments[2],
+		Name:           segments[3],
+	}, nil
+}
+
+// String returns the Virtual Network Peering ID in the canonical casing
+func (id VirtualNetworkPeeringID) String() string {
+	return formatResourceID(virtualNetworkPeeringIDFormat, id.Subscription, id.ResourceGroup, id.VirtualNetwork, id.Name)
+}
+
+// ValidateVirtualNetworkPeeringID is a SchemaValidateFunc which validates that the value is a Virtual Network Peering ID
+func ValidateVirtualNetworkPeeringID(i interface{}, k string) ([]string, []error) {
+	return validateResourceIDFormat(i, k, func(input string) error {
+		_, err := ParseVirtualNetworkPeeringID(input)
+		return err
+	})
+}
+
+// ImportVirtualNetworkPeeringID is a StateFunc which validates that the ID being imported is a Virtual Network Peering ID
+func ImportVirtualNetworkPeeringID(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	return importResourceIDFormat(d, func(input string) error {
+		_, err := ParseVirtualNetworkPeeringID(input)
+		return err
+	})
+}
+
+const virtualWanIDFormat = "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/virtualWans/{name}"
+
+// VirtualWanID is the ID of a Virtual WAN in the format `/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/virtualWans/{name}`
+type VirtualWanID struct {
+	Subscription  string
+	ResourceGroup string
+	Name          string
+}
+
+// NewVirtualWanID returns a VirtualWanID for the specified segments
+func NewVirtualWanID(subscription, resourceGroup, name string) VirtualWanID {
+	return VirtualWanID{
+		Subscription:  subscription,
+		ResourceGroup: resourceGroup,
+		Name:          name,
+	}
+}
+
+// ParseVirtualWanID parses the specified Resource ID as a VirtualWanID
+func ParseVirtualWanID(input string) (*VirtualWanID, error) {
+	segments, err := parseResourceIDFormat(virtualWanIDFormat, input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a Virtual WAN ID: %+v", input, err)
+	}
+
+	return &VirtualWanID{
+		Subscription:  segments[0],
+		ResourceGroup: segments[1],
+		Name:          segments[2],
+	}, nil
+}
+
+// String returns the Virtual WAN ID in the canonical casing
+func (id VirtualWanID) String() string {
+	return formatResourceID(virtualWanIDFormat, id.Subscription, id.ResourceGroup, id.Name)
+}
+
+// ValidateVirtualWanID is a SchemaValidateFunc which validates that the value is a Virtual WAN ID
+func ValidateVirtualWanID(i interface{}, k string) ([]string, []error) {
+	return validateResourceIDFormat(i, k, func(input string) error {
+		_, err := ParseVirtualWanID(input)
+		return err
+	})
+}
+
+// ImportVirtualWanID is a StateFunc which validates that the ID being imported is a Virtual WAN ID
+func ImportVirtualWanID(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	return importResourceIDFormat(d, func(input string) error {
+		_, err := ParseVirtualWanID(input)
+		return err
+	})
+}
+
+const vpnGatewayIDFormat = "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/vpnGateways/{name}"
+
+// VpnGatewayID is the ID of a VPN Gateway in the format `/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/vpnGateways/{name}`
+type VpnGatewayID struct {
+	Subscription  string
+	ResourceGroup string
+	Name          string
+}
+
+// NewVpnGatewayID returns a VpnGatewayID for the specified segments
+func NewVpnGatewayID(subscription, resourceGroup, name string) VpnGatewayID {
+	return VpnGatewayID{
+		Subscription:  subscription,
+		ResourceGroup: resourceGroup,
+		Name:          name,
+	}
+}
+
+// ParseVpnGatewayID parses the specified Resource ID as a VpnGatewayID
+func ParseVpnGatewayID(input string) (*VpnGatewayID, error) {
+	segments, err := parseResourceIDFormat(vpnGatewayIDFormat, input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a VPN Gateway ID: %+v", input, err)
+	}
+
+	return &VpnGatewayID{
+		Subscription:  segments[0],
+		ResourceGroup: segments[1],
+		Name:          segments[2],
+	}, nil
+}
+
+// String returns the VPN Gateway ID in the canonical casing
+func (id VpnGatewayID) String() string {
+	return formatResourceID(vpnGatewayIDFormat, id.Subscription, id.ResourceGroup, id.Name)
+}
+
+// ValidateVpnGatewayID is a SchemaValidateFunc which validates that the value is a VPN Gateway ID
+func ValidateVpnGatewayID(i interface{}, k string) ([]string, []error) {
+	return validateResourceIDFormat(i, k, func(input string) error {
+		_, err := ParseVpnGatewayID(input)
+		return err
+	})
+}
+
+// ImportVpnGatewayID is a StateFunc which validates that the ID being imported is a VPN Gateway ID
+func ImportVpnGatewayID(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	return importResourceIDFormat(d, func(input string) error {
+		_, err := ParseVpnGatewayID(input)
+		return err
+	})
+}
+
+const vpnSiteIDFormat = "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/vpnSites/{name}"
+
+// VpnSiteID is the ID of a VPN Site in the format `/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Network/vpnSites/{name}`
+type VpnSiteID struct {
+	Subscription  string
+	ResourceGroup string
+	Name          string
+}
+
+// NewVpnSiteID returns a VpnSiteID for the specified segments
+func NewVpnSiteID(subscription, resourceGroup, name string) VpnSiteID {
+	return VpnSiteID{
+		Subscription:  subscription,
+		ResourceGroup: resourceGroup,
+		Name:          name,
+	}
+}
+
+// ParseVpnSiteID parses the specified Resource ID as a VpnSiteID
+func ParseVpnSiteID(input string) (*VpnSiteID, error) {
+	segments, err := parseResourceIDFormat(vpnSiteIDFormat, input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a VPN Site ID: %+v", input, err)
+	}
+
+	return &VpnSiteID{
+		Subscription:  segments[0],
+		ResourceGroup: segments[1],
+		Name:          segments[2],
+	}, nil
+}
+
+// String returns the VPN Site ID in the canonical casing
+func (id VpnSiteID) String() string {
+	return formatResourceID(vpnSiteIDFormat, id.Subscription, id.ResourceGroup, id.Name)
+}
+
+// ValidateVpnSiteID is a SchemaValidateFunc which validates that the value is a VPN Site ID
+func ValidateVpnSiteID(i interface{}, k string) ([]string, []error) {
+	return validateResourceIDFormat(i, k, func(input string) error {
+		_, err := ParseVpnSiteID(input)
+		return err
+	})
+}
+
+// ImportVpnSiteID is a StateFunc which validates that the ID being imported is a VPN Site ID
+func ImportVpnSiteID(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	return importResourceIDFormat(d, func(input string) error {
+		_, err := ParseVpnSiteID(input)
+		return err
+	})
+}
+
+const notificationHubIDFormat = "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.NotificationHubs/namespaces/{namespace}/notificationHubs/{name}"
+
+// NotificationHubID is the ID of a Notification Hub in the format `/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.NotificationHubs/namespaces/{namespace}/notificationHubs/{name}`
+type NotificationHubID struct {
+	Subscription  string
+	ResourceGroup string
+	Namespace     string
+	Name          string
+}
+
+// NewNotificationHubID returns a NotificationHubID for the specified segments
+func NewNotificationHubID(subscription, resourceGroup, namespace, name string) NotificationHubID {
+	return NotificationHubID{
+		Subscription:  subscription,
+		ResourceGroup: resourceGroup,
+		Namespace:     namespace,
+		Name:          name,
+	}
+}
+
+// ParseNotificationHubID parses the specified Resource ID as a NotificationHubID
+func ParseNotificationHubID(input string) (*NotificationHubID, error) {
+	segments, err := parseResourceIDFormat(notificationHubIDFormat, input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a Notification Hub ID: %+v", input, err)
+	}
+
+	return &NotificationHubID{
+		Subscription:  segments[0],
+		ResourceGroup: segments[1],
+		Namespace:     segments[2],
+		Name:          segments[3],
+	}, nil
+}
+
+// String returns the Notification Hub ID in the canonical casing
+func (id NotificationHubID) String() string {
+	return formatResourceID(notificationHubIDFormat, id.Subscription, id.ResourceGroup, id.Namespace, id.Name)
+}
+
+// ValidateNotificationHubID is a SchemaValidateFunc which validates that the value is a Notification Hub ID
+func ValidateNotificationHubID(i interface{}, k string) ([]string, []error) {
+	return validateResourceIDFormat(i, k, func(input string) error {
+		_, err := ParseNotificationHubID(input)
+		return err
+	})
+}
+
+// ImportNotificationHubID is a StateFunc which validates that the ID being imported is a Notification Hub ID
+func ImportNotificationHubID(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	return importResourceIDFormat(d, func(input string) error {
+		_, err := ParseNotificationHubID(input)
+		return err
+	})
+}
+
+const notificationHubAuthorizationRuleIDFormat = "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.NotificationHubs/namespaces/{namespace}/notificationHubs/{notificationHub}/AuthorizationRules/{name}"
+
+// NotificationHubAuthorizationRuleID is the ID of a Notification Hub Authorization Rule in the format `/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.NotificationHubs/namespaces/{namespace}/notificationHubs/{notificationHub}/AuthorizationRules/{name}`
+type NotificationHubAuthorizationRuleID struct {
+	Subscription    string
+	ResourceGroup   string
+	Namespace       string
+	NotificationHub string
+	Name            string
+}
+
+// NewNotificationHubAuthorizationRuleID returns a NotificationHubAuthorizationRuleID for the specified segments
+func NewNotificationHubAuthorizationRuleID(subscription, resourceGroup, namespace, notificationHub, name string) NotificationHubAuthorizationRuleID {
+	return NotificationHubAuthorizationRuleID{
+		Subscription:    subscription,
+		ResourceGroup:   resourceGroup,
+		Namespace:       namespace,
+		NotificationHub: notificationHub,
+		Name:            name,
+	}
+}
+
+// ParseNotificationHubAuthorizationRuleID parses the specified Resource ID as a NotificationHubAuthorizationRuleID
+func ParseNotificationHubAuthorizationRuleID(input string) (*NotificationHubAuthorizationRuleID, error) {
+	segments, err := parseResourceIDFormat(notificationHubAuthorizationRuleIDFormat, input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a Notification Hub Authorization Rule ID: %+v", input, err)
+	}
+
+	return &NotificationHubAuthorizationRuleID{
+		Subscription:    segments[0],
+		ResourceGroup:   segments[1],
+		Namespace:       segments[2],
+		NotificationHub: segments[3],
+		Name:            segments[4],
+	}, nil
+}
+
+// String returns the Notification Hub Authorization Rule ID in the canonical casing
+func (id NotificationHubAuthorizationRuleID) String() string {
+	return formatResourceID(notificationHubAuthorizationRuleIDFormat, id.Subscription, id.ResourceGroup, id.Namespace, id.NotificationHub, id.Name)
+}
+
+// ValidateNotificationHubAuthorizationRuleID is a SchemaValidateFunc which validates that the value is a Notification Hub Authorization Rule ID
+func ValidateNotificationHubAuthorizationRuleID(i interface{}, k string) ([]string, []error) {
+	return validateResourceIDFormat(i, k, func(input string) error {
+		_, err := ParseNotificationHubAuthorizationRuleID(input)
+		return err
+	})
+}
+
+// ImportNotificationHubAuthorizationRuleID is a StateFunc which validates that the ID being imported is a Notification Hub Authorization Rule ID
+func ImportNotificationHubAuthorizationRuleID(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	return importResourceIDFormat(d, func(input string) error {
+		_, err := ParseNotificationHubAuthorizationRuleID(input)
+		return err
+	})
+}
+
+const notificationHubNamespaceIDFormat = "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.NotificationHubs/namespaces/{name}"
+
+// NotificationHubNamespaceID is the ID of a Notification Hub Namespace in the format `/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.NotificationHubs/namespaces/{name}`
+type NotificationHubNamespaceID struct {
+	Subscription  string
+	ResourceGroup string
+	Name          string
+}
+
+// NewNotificationHubNamespaceID returns a NotificationHubNamespaceID for the specified segments
+func NewNotificationHubNamespaceID(subscription, resourceGroup, name string) NotificationHubNamespaceID {
+	return NotificationHubNamespaceID{
+		Subscription:  subscription,
+		ResourceGroup: resourceGroup,
+		Name:          name,
+	}
+}
+
+// ParseNotificationHubNamespaceID parses the specified Resource ID as a NotificationHubNamespaceID
+func ParseNotificationHubNamespaceID(input string) (*NotificationHubNamespaceID, error) {
+	segments, err := parseResourceIDFormat(notificationHubNamespaceIDFormat, input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a Notification Hub Namespace ID: %+v", input, err)
+	}
+
+	return &NotificationHubNamespaceID{
+		Subscription:  segments[0],
+		ResourceGroup: segments[1],
+		Name:          segments[2],
+	}, nil
+}
+
+// String returns the Notification Hub Namespace ID in the canonical casing
+func (id NotificationHubNamespaceID) String() string {
+	return formatResourceID(notificationHubNamespaceIDFormat, id.Subscription, id.ResourceGroup, id.Name)
+}
+
+// ValidateNotificationHubNamespaceID is a SchemaValidateFunc which validates that the value is a Notification Hub Namespace ID
+func ValidateNotificationHubNamespaceID(i interface{}, k string) ([]string, []error) {
+	return validateResourceIDFormat(i, k, func(input string) error {
+		_, err := ParseNotificationHubNamespaceID(input)
+		return err
+	})
+}
+
+// ImportNotificationHubNamespaceID is a StateFunc which validates that the ID being imported is a Notification Hub Namespace ID
+func ImportNotificationHubNamespaceID(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	return importResourceIDFormat(d, func(input string) error {
+		_, err := ParseNotificationHubNamespaceID(input)
+		return err
+	})
+}
+
+const policyDefinitionIDFormat = "/subscriptions/{subscription}/providers/Microsoft.Authorization/policyDefinitions/{name}"
+
+// PolicyDefinitionID is the ID of a Policy Definition in the format `/subscriptions/{subscription}/providers/Microsoft.Authorization/policyDefinitions/{name}`
+type PolicyDefinitionID struct {
+	Subscription string
+	Name         string
+}
+
+// NewPolicyDefinitionID returns a PolicyDefinitionID for the specified segments
+func NewPolicyDefinitionID(subscription, name string) PolicyDefinitionID {
+	return PolicyDefinitionID{
+		Subscription: subscription,
+		Name:         name,
+	}
+}
+
+// ParsePolicyDefinitionID parses the specified Resource ID as a PolicyDefinitionID
+func ParsePolicyDefinitionID(input string) (*PolicyDefinitionID, error) {
+	segments, err := parseResourceIDFormat(policyDefinitionIDFormat, input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a Policy Definition ID: %+v", input, err)
+	}
+
+	return &PolicyDefinitionID{
+		Subscription: segments[0],
+		Name:         segments[1],
+	}, nil
+}
+
+// String returns the Policy Definition ID in the canonical casing
+func (id PolicyDefinitionID) String() string {
+	return formatResourceID(policyDefinitionIDFormat, id.Subscription, id.Name)
+}
+
+// ValidatePolicyDefinitionID is a SchemaValidateFunc which validates that the value is a Policy Definition ID
+func ValidatePolicyDefinitionID(i interface{}, k string) ([]string, []error) {
+	return validateResourceIDFormat(i, k, func(input string) error {
+		_, err := ParsePolicyDefinitionID(input)
+		return err
+	})
+}
+
+// ImportPolicyDefinitionID is a StateFunc which validates that the ID being imported is a Policy Definition ID
+func ImportPolicyDefinitionID(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	return importResourceIDFormat(d, func(input string) error {
+		_, err := ParsePolicyDefinitionID(input)
+		return err
+	})
+}
+
+const postgreSqlConfigurationIDFormat = "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.DBforPostgreSQL/servers/{server}/configurations/{name}"
+
+// PostgreSqlConfigurationID is the ID of a PostgreSQL Configuration in the format `/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.DBforPostgreSQL/servers/{server}/configurations/{name}`
+type PostgreSqlConfigurationID struct {
+	Subscription  string
+	ResourceGroup string
+	Server        string
+	Name          string
+}
+
+// NewPostgreSqlConfigurationID returns a PostgreSqlConfigurationID for the specified segments
+func NewPostgreSqlConfigurationID(subscription, resourceGroup, server, name string) PostgreSqlConfigurationID {
+	return PostgreSqlConfigurationID{
+		Subscription:  subscription,
+		ResourceGroup: resourceGroup,
+		Server:        server,
+		Name:          name,
+	}
+}
+
+// ParsePostgreSqlConfigurationID parses the specified Resource ID as a PostgreSqlConfigurationID
+func ParsePostgreSqlConfigurationID(input string) (*PostgreSqlConfigurationID, error) {
+	segments, err := parseResourceIDFormat(postgreSqlConfigurationIDFormat, input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a PostgreSQL Configuration ID: %+v", input, err)
+	}
+
+	return &PostgreSqlConfigurationID{
+		Subscription:  segments[0],
+		ResourceGroup: segments[1],
+		Server:        segments[2],
+		Name:          segments[3],
+	}, nil
+}
+
+// String returns the PostgreSQL Configuration ID in the canonical casing
+func (id PostgreSqlConfigurationID) String() string {
+	return formatResourceID(postgreSqlConfigurationIDFormat, id.Subscription, id.ResourceGroup, id.Server, id.Name)
+}
+
+// ValidatePostgreSqlConfigurationID is a SchemaValidateFunc which validates that the value is a PostgreSQL Configuration ID
+func ValidatePostgreSqlConfigurationID(i interface{}, k string) ([]string, []error) {
+	return validateResourceIDFormat(i, k, func(input string) error {
+		_, err := ParsePostgreSqlConfigurationID(input)
+		return err
+	})
+}
+
+// ImportPostgreSqlConfigurationID is a StateFunc which validates that the ID being imported is a PostgreSQL Configuration ID
+func ImportPostgreSqlConfigurationID(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	return importResourceIDFormat(d, func(input string) error {
+		_, err := ParsePostgreSqlConfigurationID(input)
+		return err
+	})
+}
+
+const postgreSqlDatabaseIDFormat = "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.DBforPostgreSQL/servers/{server}/databases/{name}"
+
+// PostgreSqlDatabaseID is the ID of a PostgreSQL Database in the format `/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.DBforPostgreSQL/servers/{server}/databases/{name}`
+type PostgreSqlDatabaseID struct {
+	Subscription  string
+	ResourceGroup string
+	Server        string
+	Name          string
+}
+
+// NewPostgreSqlDatabaseID returns a PostgreSqlDatabaseID for the specified segments
+func NewPostgreSqlDatabaseID(subscription, resourceGroup, server, name string) PostgreSqlDatabaseID {
+	return PostgreSqlDatabaseID{
+		Subscription:  subscription,
+		ResourceGroup: resourceGroup,
+		Server:        server,
+		Name:          name,
+	}
+}
+
+// ParsePostgreSqlDatabaseID parses the specified Resource ID as a PostgreSqlDatabaseID
+func ParsePostgreSqlDatabaseID(input string) (*PostgreSqlDatabaseID, error) {
+	segments, err := parseResourceIDFormat(postgreSqlDatabaseIDFormat, input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a PostgreSQL Database ID: %+v", input, err)
+	}
+
+	return &PostgreSqlDatabaseID{
+		Subscription:  segments[0],
+		ResourceGroup: segments[1],
+		Server:        segments[2],
+		Name:          segments[3],
+	}, nil
+}
+
+// String returns the PostgreSQL Database ID in the canonical casing
+func (id PostgreSqlDatabaseID) String() string {
+	return formatResourceID(postgreSqlDatabaseIDFormat, id.Subscription, id.ResourceGroup, id.Server, id.Name)
+}
+
+// ValidatePostgreSqlDatabaseID is a SchemaValidateFunc which validates that the value is a PostgreSQL Database ID
+func ValidatePostgreSqlDatabaseID(i interface{}, k string) ([]string, []error) {
+	return validateResourceIDFormat(i, k, func(input string) error {
+		_, err := ParsePostgreSqlDatabaseID(input)
+		return err
+	})
+}
+
+// ImportPostgreSqlDatabaseID is a StateFunc which validates that the ID being imported is a PostgreSQL Database ID
+func ImportPostgreSqlDatabaseID(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	return importResourceIDFormat(d, func(input string) error {
+		_, err := ParsePostgreSqlDatabaseID(input)
+		return err
+	})
+}
+
+const postgreSqlFirewallRuleIDFormat = "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.DBforPostgreSQL/servers/{server}/firewallRules/{name}"
+
+// PostgreSqlFirewallRuleID is the ID of a PostgreSQL Firewall Rule in the format `/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.DBforPostgreSQL/servers/{server}/firewallRules/{name}`
+type PostgreSqlFirewallRuleID struct {
+	Subscription  string
+	ResourceGroup string
+	Server        string
+	Name          string
+}
+
+// NewPostgreSqlFirewallRuleID returns a PostgreSqlFirewallRuleID for the specified segments
+func NewPostgreSqlFirewallRuleID(subscription, resourceGroup, server, name string) PostgreSqlFirewallRuleID {
+	return PostgreSqlFirewallRuleID{
+		Subscription:  subscription,
+		ResourceGroup: resourceGroup,
+		Server:        server,
+		Name:          name,
+	}
+}
+
+// ParsePostgreSqlFirewallRuleID parses the specified Resource ID as a PostgreSqlFirewallRuleID
+func ParsePostgreSqlFirewallRuleID(input string) (*PostgreSqlFirewallRuleID, error) {
+	segments, err := parseResourceIDFormat(postgreSqlFirewallRuleIDFormat, input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a PostgreSQL Firewall Rule ID: %+v", input, err)
+	}
+
+	return &PostgreSqlFirewallRuleID{
+		Subscription:  segments[0],
+		ResourceGroup: segments[1],
+		Server:        segments[2],
+		Name:          segments[3],
+	}, nil
+}
+
+// String returns the PostgreSQL Firewall Rule ID in the canonical casing
+func (id PostgreSqlFirewallRuleID) String() string {
+	return formatResourceID(postgreSqlFirewallRuleIDFormat, id.Subscription, id.ResourceGroup, id.Server, id.Name)
+}
+
+// ValidatePostgreSqlFirewallRuleID is a SchemaValidateFunc which validates that the value is a PostgreSQL Firewall Rule ID
+func ValidatePostgreSqlFirewallRuleID(i interface{}, k string) ([]string, []error) {
+	return validateResourceIDFormat(i, k, func(input string) error {
+		_, err := ParsePostgreSqlFirewallRuleID(input)
+		return err
+	})
+}
+
+// ImportPostgreSqlFirewallRuleID is a StateFunc which validates that the ID being imported is a PostgreSQL Firewall Rule ID
+func ImportPostgreSqlFirewallRuleID(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	return importResourceIDFormat(d, func(input string) error {
+		_, err := ParsePostgreSqlFirewallRuleID(input)
+		return err
+	})
+}
+
+const postgreSqlServerIDFormat = "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.DBforPostgreSQL/servers/{name}"
+
+// PostgreSqlServerID is the ID of a PostgreSQL Server in the format `/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.DBforPostgreSQL/servers/{name}`
+type PostgreSqlServerID struct {
+	Subscription  string
+	ResourceGroup string
+	Name          string
+}
+
+// NewPostgreSqlServerID returns a PostgreSqlServerID for the specified segments
+func NewPostgreSqlServerID(subscription, resourceGroup, name string) PostgreSqlServerID {
+	return PostgreSqlServerID{
+		Subscription:  subscription,
+		ResourceGroup: resourceGroup,
+		Name:          name,
+	}
+}
+
+// ParsePostgreSqlServerID parses the specified Resource ID as a PostgreSqlServerID
+func ParsePostgreSqlServerID(input string) (*PostgreSqlServerID, error) {
+	segments, err := parseResourceIDFormat(postgreSqlServerIDFormat, input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a PostgreSQL Server ID: %+v", input, err)
+	}
+
+	return &PostgreSqlServerID{
+		Subscription:  segments[0],
+		ResourceGroup: segments[1],
+		Name:          segments[2],
+	}, nil
+}
+
+// String returns the PostgreSQL Server ID in the canonical casing
+func (id PostgreSqlServerID) String() string {
+	return formatResourceID(postgreSqlServerIDFormat, id.Subscription, id.ResourceGroup, id.Name)
+}
+
+// ValidatePostgreSqlServerID is a SchemaValidateFunc which validates that the value is a PostgreSQL Server ID
+func ValidatePostgreSqlServerID(i interface{}, k string) ([]string, []error) {
+	return validateResourceIDFormat(i, k, func(input string) error {
+		_, err := ParsePostgreSqlServerID(input)
+		return err
+	})
+}
+
+// ImportPostgreSqlServerID is a StateFunc which validates that the ID being imported is a PostgreSQL Server ID
+func ImportPostgreSqlServerID(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	return importResourceIDFormat(d, func(input string) error {
+		_, err := ParsePostgreSqlServerID(input)
+		return err
+	})
+}
+
+const postgreSqlVirtualNetworkRuleIDFormat = "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.DBforPostgreSQL/servers/{server}/virtualNetworkRules/{name}"
+
+// PostgreSqlVirtualNetworkRuleID is the ID of a PostgreSQL Virtual Network Rule in the format `/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.DBforPostgreSQL/servers/{server}/virtualNetworkRules/{name}`
+type PostgreSqlVirtualNetworkRuleID struct {
+	Subscription  string
+	ResourceGroup string
+	Server        string
+	Name          string
+}
+
+// NewPostgreSqlVirtualNetworkRuleID returns a PostgreSqlVirtualNetworkRuleID for the specified segments
+func NewPostgreSqlVirtualNetworkRuleID(subscription, resourceGroup, server, name string) PostgreSqlVirtualNetworkRuleID {
+	return PostgreSqlVirtualNetworkRuleID{
+		Subscription:  subscription,
+		ResourceGroup: resourceGroup,
+		Server:        server,
+		Name:          name,
+	}
+}
+
+// ParsePostgreSqlVirtualNetworkRuleID parses the specified Resource ID as a PostgreSqlVirtualNetworkRuleID
+func ParsePostgreSqlVirtualNetworkRuleID(input string) (*PostgreSqlVirtualNetworkRuleID, error) {
+	segments, err := parseResourceIDFormat(postgreSqlVirtualNetworkRuleIDFormat, input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a PostgreSQL Virtual Network Rule ID: %+v", input, err)
+	}
+
+	return &PostgreSqlVirtualNetworkRuleID{
+		Subscription:  segments[0],
+		ResourceGroup: segments[1],
+		Server:        segments[2],
+		Name:          segments[3],
+	}, nil
+}
+
+// String returns the PostgreSQL Virtual Network Rule ID in the canonical casing
+func (id PostgreSqlVirtualNetworkRuleID) String() string {
+	return formatResourceID(postgreSqlVirtualNetworkRuleIDFormat, id.Subscription, id.ResourceGroup, id.Server, id.Name)
+}
+
+// ValidatePostgreSqlVirtualNetworkRuleID is a SchemaValidateFunc which validates that the value is a PostgreSQL Virtual Network Rule ID
+func ValidatePostgreSqlVirtualNetworkRuleID(i interface{}, k string) ([]string, []error) {
+	return validateResourceIDFormat(i, k, func(input string) error {
+		_, err := ParsePostgreSqlVirtualNetworkRuleID(input)
+		return err
+	})
+}
+
+// ImportPostgreSqlVirtualNetworkRuleID is a StateFunc which validates that the ID being imported is a PostgreSQL Virtual Network Rule ID
+func ImportPostgreSqlVirtualNetworkRuleID(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	return importResourceIDFormat(d, func(input string) error {
+		_, err := ParsePostgreSqlVirtualNetworkRuleID(input)
+		return err
+	})
+}
+
+const recoveryServicesVaultIDFormat = "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.RecoveryServices/vaults/{name}"
+
+// RecoveryServicesVaultID is the ID of a Recovery Services Vault in the format `/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.RecoveryServices/vaults/{name}`
+type RecoveryServicesVaultID struct {
+	Subscription  string
+	ResourceGroup string
+	Name          string
+}
+
+// NewRecoveryServicesVaultID returns a RecoveryServicesVaultID for the specified segments
+func NewRecoveryServicesVaultID(subscription, resourceGroup, name string) RecoveryServicesVaultID {
+	return RecoveryServicesVaultID{
+		Subscription:  subscription,
+		ResourceGroup: resourceGroup,
+		Name:          name,
+	}
+}
+
+// ParseRecoveryServicesVaultID parses the specified Resource ID as a RecoveryServicesVaultID
+func ParseRecoveryServicesVaultID(input string) (*RecoveryServicesVaultID, error) {
+	segments, err := parseResourceIDFormat(recoveryServicesVaultIDFormat, input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a Recovery Services Vault ID: %+v", input, err)
+	}
+
+	return &RecoveryServicesVaultID{
+		Subscription:  segments[0],
+		ResourceGroup: segments[1],
+		Name:          segments[2],
+	}, nil
+}
+
+// String returns the Recovery Services Vault ID in the canonical casing
+func (id RecoveryServicesVaultID) String() string {
+	return formatResourceID(recoveryServicesVaultIDFormat, id.Subscription, id.ResourceGroup, id.Name)
+}
+
+// ValidateRecoveryServicesVaultID is a SchemaValidateFunc which validates that the value is a Recovery Services Vault ID
+func ValidateRecoveryServicesVaultID(i interface{}, k string) ([]string, []error) {
+	return validateResourceIDFormat(i, k, func(input string) error {
+		_, err := ParseRecoveryServicesVaultID(input)
+		return err
+	})
+}
+
+// ImportRecoveryServicesVaultID is a StateFunc which validates that the ID being imported is a Recovery Services Vault ID
+func ImportRecoveryServicesVaultID(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	return importResourceIDFormat(d, func(input string) error {
+		_, err := ParseRecoveryServicesVaultID(input)
+		return err
+	})
+}
+
+const redisCacheIDFormat = "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Cache/Redis/{name}"
+
+// RedisCacheID is the ID of a Redis Cache in the format `/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Cache/Redis/{name}`
+type RedisCacheID struct {
+	Subscription  string
+	ResourceGroup string
+	Name          string
+}
+
+// NewRedisCacheID returns a RedisCacheID for the specified segments
+func NewRedisCacheID(subscription, resourceGroup, name string) RedisCacheID {
+	return RedisCacheID{
+		Subscription:  subscription,
+		ResourceGroup: resourceGroup,
+		Name:          name,
+	}
+}
+
+// ParseRedisCacheID parses the specified Resource ID as a RedisCacheID
+func ParseRedisCacheID(input string) (*RedisCacheID, error) {
+	segments, err := parseResourceIDFormat(redisCacheIDFormat, input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a Redis Cache ID: %+v", input, err)
+	}
+
+	return &RedisCacheID{
+		Subscription:  segments[0],
+		ResourceGroup: segments[1],
+		Name:          segments[2],
+	}, nil
+}
+
+// String returns the Redis Cache ID in the canonical casing
+func (id RedisCacheID) String() string {
+	return formatResourceID(redisCacheIDFormat, id.Subscription, id.ResourceGroup, id.Name)
+}
+
+// ValidateRedisCacheID is a SchemaValidateFunc which validates that the value is a Redis Cache ID
+func ValidateRedisCacheID(i interface{}, k string) ([]string, []error) {
+	return validateResourceIDFormat(i, k, func(input string) error {
+		_, err := ParseRedisCacheID(input)
+		return err
+	})
+}
+
+// ImportRedisCacheID is a StateFunc which validates that the ID being imported is a Redis Cache ID
+func ImportRedisCacheID(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	return importResourceIDFormat(d, func(input string) error {
+		_, err := ParseRedisCacheID(input)
+		return err
+	})
+}
+
+const redisFirewallRuleIDFormat = "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Cache/Redis/{cache}/firewallRules/{name}"
+
+// RedisFirewallRuleID is the ID of a Redis Firewall Rule in the format `/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Cache/Redis/{cache}/firewallRules/{name}`
+type RedisFirewallRuleID struct {
+	Subscription  string
+	ResourceGroup string
+	Cache         string
+	Name          string
+}
+
+// NewRedisFirewallRuleID returns a RedisFirewallRuleID for the specified segments
+func NewRedisFirewallRuleID(subscription, resourceGroup, cache, name string) RedisFirewallRuleID {
+	return RedisFirewallRuleID{
+		Subscription:  subscription,
+		ResourceGroup: resourceGroup,
+		Cache:         cache,
+		Name:          name,
+	}
+}
+
+// ParseRedisFirewallRuleID parses the specified Resource ID as a RedisFirewallRuleID
+func ParseRedisFirewallRuleID(input string) (*RedisFirewallRuleID, error) {
+	segments, err := parseResourceIDFormat(redisFirewallRuleIDFormat, input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a Redis Firewall Rule ID: %+v", input, err)
+	}
+
+	return &RedisFirewallRuleID{
+		Subscription:  segments[0],
+		ResourceGroup: segments[1],
+		Cache:         segments[2],
+		Name:          segments[3],
+	}, nil
+}
+
+// String returns the Redis Firewall Rule ID in the canonical casing
+func (id RedisFirewallRuleID) String() string {
+	return formatResourceID(redisFirewallRuleIDFormat, id.Subscription, id.ResourceGroup, id.Cache, id.Name)
+}
+
+// ValidateRedisFirewallRuleID is a SchemaValidateFunc which validates that the value is a Redis Firewall Rule ID
+func ValidateRedisFirewallRuleID(i interface{}, k string) ([]string, []error) {
+	return validateResourceIDFormat(i, k, func(input string) error {
+		_, err := ParseRedisFirewallRuleID(input)
+		return err
+	})
+}
+
+// ImportRedisFirewallRuleID is a StateFunc which validates that the ID being imported is a Redis Firewall Rule ID
+func ImportRedisFirewallRuleID(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	return importResourceIDFormat(d, func(input string) error {
+		_, err := ParseRedisFirewallRuleID(input)
+		return err
+	})
+}
+
+const relayNamespaceIDFormat = "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Relay/namespaces/{name}"
+
+// RelayNamespaceID is the ID of a Relay Namespace in the format `/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Relay/namespaces/{name}`
+type RelayNamespaceID struct {
+	Subscription  string
+	ResourceGroup string
+	Name          string
+}
+
+// NewRelayNamespaceID returns a RelayNamespaceID for the specified segments
+func NewRelayNamespaceID(subscription, resourceGroup, name string) RelayNamespaceID {
+	return RelayNamespaceID{
+		Subscription:  subscription,
+		ResourceGroup: resourceGroup,
+		Name:          name,
+	}
+}
+
+// ParseRelayNamespaceID parses the specified Resource ID as a RelayNamespaceID
+func ParseRelayNamespaceID(input string) (*RelayNamespaceID, error) {
+	segments, err := parseResourceIDFormat(relayNamespaceIDFormat, input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a Relay Namespace ID: %+v", input, err)
+	}
+
+	return &RelayNamespaceID{
+		Subscription:  segments[0],
+		ResourceGroup: segments[1],
+		Name:          segments[2],
+	}, nil
+}
+
+// String returns the Relay Namespace ID in the canonical casing
+func (id RelayNamespaceID) String() string {
+	return formatResourceID(relayNamespaceIDFormat, id.Subscription, id.ResourceGroup, id.Name)
+}
+
+// ValidateRelayNamespaceID is a SchemaValidateFunc which validates that the value is a Relay Namespace ID
+func ValidateRelayNamespaceID(i interface{}, k string) ([]string, []error) {
+	return validateResourceIDFormat(i, k, func(input string) error {
+		_, err := ParseRelayNamespaceID(input)
+		return err
+	})
+}
+
+// ImportRelayNamespaceID is a StateFunc which validates that the ID being imported is a Relay Namespace ID
+func ImportRelayNamespaceID(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	return importResourceIDFormat(d, func(input string) error {
+		_, err := ParseRelayNamespaceID(input)
+		return err
+	})
+}
+
+const resourceGroupIDFormat = "/subscriptions/{subscription}/resourceGroups/{name}"
+
+// ResourceGroupID is the ID of a Resource Group in the format `/subscriptions/{subscription}/resourceGroups/{name}`
+type ResourceGroupID struct {
+	Subscription string
+	Name         string
+}
+
+// NewResourceGroupID returns a ResourceGroupID for the specified segments
+func NewResourceGroupID(subscription, name string) ResourceGroupID {
+	return ResourceGroupID{
+		Subscription: subscription,
+		Name:         name,
+	}
+}
+
+// ParseResourceGroupID parses the specified Resource ID as a ResourceGroupID
+func ParseResourceGroupID(input string) (*ResourceGroupID, error) {
+	segments, err := parseResourceIDFormat(resourceGroupIDFormat, input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a Resource Group ID: %+v", input, err)
+	}
+
+	return &ResourceGroupID{
+		Subscription: segments[0],
+		Name:         segments[1],
+	}, nil
+}
+
+// String returns the Resource Group ID in the canonical casing
+func (id ResourceGroupID) String() string {
+	return formatResourceID(resourceGroupIDFormat, id.Subscription, id.Name)
+}
+
+// ValidateResourceGroupID is a SchemaValidateFunc which validates that the value is a Resource Group ID
+func ValidateResourceGroupID(i interface{}, k string) ([]string, []error) {
+	return validateResourceIDFormat(i, k, func(input string) error {
+		_, err := ParseResourceGroupID(input)
+		return err
+	})
+}
+
+// ImportResourceGroupID is a StateFunc which validates that the ID being imported is a Resource Group ID
+func ImportResourceGroupID(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	return importResourceIDFormat(d, func(input string) error {
+		_, err := ParseResourceGroupID(input)
+		return err
+	})
+}
+
+const templateDeploymentIDFormat = "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Resources/deployments/{name}"
+
+// TemplateDeploymentID is the ID of a Template Deployment in the format `/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Resources/deployments/{name}`
+type TemplateDeploymentID struct {
+	Subscription  string
+	ResourceGroup string
+	Name          string
+}
+
+// NewTemplateDeploymentID returns a TemplateDeploymentID for the specified segments
+func NewTemplateDeploymentID(subscription, resourceGroup, name string) TemplateDeploymentID {
+	return TemplateDeploymentID{
+		Subscription:  subscription,
+		ResourceGroup: resourceGroup,
+		Name:          name,
+	}
+}
+
+// ParseTemplateDeploymentID parses the specified Resource ID as a TemplateDeploymentID
+func ParseTemplateDeploymentID(input string) (*TemplateDeploymentID, error) {
+	segments, err := parseResourceIDFormat(templateDeploymentIDFormat, input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a Template Deployment ID: %+v", input, err)
+	}
+
+	return &TemplateDeploymentID{
+		Subscription:  segments[0],
+		ResourceGroup: segments[1],
+		Name:          segments[2],
+	}, nil
+}
+
+// String returns the Template Deployment ID in the canonical casing
+func (id TemplateDeploymentID) String() string {
+	return formatResourceID(templateDeploymentIDFormat, id.Subscription, id.ResourceGroup, id.Name)
+}
+
+// ValidateTemplateDeploymentID is a SchemaValidateFunc which validates that the value is a Template Deployment ID
+func ValidateTemplateDeploymentID(i interface{}, k string) ([]string, []error) {
+	return validateResourceIDFormat(i, k, func(input string) error {
+		_, err := ParseTemplateDeploymentID(input)
+		return err
+	})
+}
+
+// ImportTemplateDeploymentID is a StateFunc which validates that the ID being imported is a Template Deployment ID
+func ImportTemplateDeploymentID(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	return importResourceIDFormat(d, func(input string) error {
+		_, err := ParseTemplateDeploymentID(input)
+		return err
+	})
+}
+
+const schedulerJobIDFormat = "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Scheduler/jobCollections/{jobCollection}/jobs/{name}"
+
+// SchedulerJobID is the ID of a Scheduler Job in the format `/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Scheduler/jobCollections/{jobCollection}/jobs/{name}`
+type SchedulerJobID struct {
+	Subscription  string
+	ResourceGroup string
+	JobCollection string
+	Name          string
+}
+
+// NewSchedulerJobID returns a SchedulerJobID for the specified segments
+func NewSchedulerJobID(subscription, resourceGroup, jobCollection, name string) SchedulerJobID {
+	return SchedulerJobID{
+		Subscription:  subscription,
+		ResourceGroup: resourceGroup,
+		JobCollection: jobCollection,
+		Name:          name,
+	}
+}
+
+// ParseSchedulerJobID parses the specified Resource ID as a SchedulerJobID
+func ParseSchedulerJobID(input string) (*SchedulerJobID, error) {
+	segments, err := parseResourceIDFormat(schedulerJobIDFormat, input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a Scheduler Job ID: %+v", input, err)
+	}
+
+	return &SchedulerJobID{
+		Subscription:  segments[0],
+		ResourceGroup: segments[1],
+		JobCollection: segments[2],
+		Name:          segments[3],
+	}, nil
+}
+
+// String returns the Scheduler Job ID in the canonical casing
+func (id SchedulerJobID) String() string {
+	return formatResourceID(schedulerJobIDFormat, id.Subscription, id.ResourceGroup, id.JobCollection, id.Name)
+}
+
+// ValidateSchedulerJobID is a SchemaValidateFunc which validates that the value is a Scheduler Job ID
+func ValidateSchedulerJobID(i interface{}, k string) ([]string, []error) {
+	return validateResourceIDFormat(i, k, func(input string) error {
+		_, err := ParseSchedulerJobID(input)
+		return err
+	})
+}
+
+// ImportSchedulerJobID is a StateFunc which validates that the ID being imported is a Scheduler Job ID
+func ImportSchedulerJobID(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	return importResourceIDFormat(d, func(input string) error {
+		_, err := ParseSchedulerJobID(input)
+		return err
+	})
+}
+
+const schedulerJobCollectionIDFormat = "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Scheduler/jobCollections/{name}"
+
+// SchedulerJobCollectionID is the ID of a Scheduler Job Collection in the format `/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Scheduler/jobCollections/{name}`
+type SchedulerJobCollectionID struct {
+	Subscription  string
+	ResourceGroup string
+	Name          string
+}
+
+// NewSchedulerJobCollectionID returns a SchedulerJobCollectionID for the specified segments
+func NewSchedulerJobCollectionID(subscription, resourceGroup, name string) SchedulerJobCollectionID {
+	return SchedulerJobCollectionID{
+		Subscription:  subscription,
+		ResourceGroup: resourceGroup,
+		Name:          name,
+	}
+}
+
+// ParseSchedulerJobCollectionID parses the specified Resource ID as a SchedulerJobCollectionID
+func ParseSchedulerJobCollectionID(input string) (*SchedulerJobCollectionID, error) {
+	segments, err := parseResourceIDFormat(schedulerJobCollectionIDFormat, input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a Scheduler Job Collection ID: %+v", input, err)
+	}
+
+	return &SchedulerJobCollectionID{
+		Subscription:  segments[0],
+		ResourceGroup: segments[1],
+		Name:          segments[2],
+	}, nil
+}
+
+// String returns the Scheduler Job Collection ID in the canonical casing
+func (id SchedulerJobCollectionID) String() string {
+	return formatResourceID(schedulerJobCollectionIDFormat, id.Subscription, id.ResourceGroup, id.Name)
+}
+
+// ValidateSchedulerJobCollectionID is a SchemaValidateFunc which validates that the value is a Scheduler Job Collection ID
+func ValidateSchedulerJobCollectionID(i interface{}, k string) ([]string, []error) {
+	return validateResourceIDFormat(i, k, func(input string) error {
+		_, err := ParseSchedulerJobCollectionID(input)
+		return err
+	})
+}
+
+// ImportSchedulerJobCollectionID is a StateFunc which validates that the ID being imported is a Scheduler Job Collection ID
+func ImportSchedulerJobCollectionID(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	return importResourceIDFormat(d, func(input string) error {
+		_, err := ParseSchedulerJobCollectionID(input)
+		return err
+	})
+}
+
+const searchServiceIDFormat = "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Search/searchServices/{name}"
+
+// SearchServiceID is the ID of a Search Service in the format `/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Search/searchServices/{name}`
+type SearchServiceID struct {
+	Subscription  string
+	ResourceGroup string
+	Name          string
+}
+
+// NewSearchServiceID returns a SearchServiceID for the specified segments
+func NewSearchServiceID(subscription, resourceGroup, name string) SearchServiceID {
+	return SearchServiceID{
+		Subscription:  subscription,
+		ResourceGroup: resourceGroup,
+		Name:          name,
+	}
+}
+
+// ParseSearchServiceID parses the specified Resource ID as a SearchServiceID
+func ParseSearchServiceID(input string) (*SearchServiceID, error) {
+	segments, err := parseResourceIDFormat(searchServiceIDFormat, input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a Search Service ID: %+v", input, err)
+	}
+
+	return &SearchServiceID{
+		Subscription:  segments[0],
+		ResourceGroup: segments[1],
+		Name:          segments[2],
+	}, nil
+}
+
+// String returns the Search Service ID in the canonical casing
+func (id SearchServiceID) String() string {
+	return formatResourceID(searchServiceIDFormat, id.Subscription, id.ResourceGroup, id.Name)
+}
+
+// ValidateSearchServiceID is a SchemaValidateFunc which validates that the value is a Search Service ID
+func ValidateSearchServiceID(i interface{}, k string) ([]string, []error) {
+	return validateResourceIDFormat(i, k, func(input string) error {
+		_, err := ParseSearchServiceID(input)
+		return err
+	})
+}
+
+// ImportSearchServiceID is a StateFunc which validates that the ID being imported is a Search Service ID
+func ImportSearchServiceID(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	return importResourceIDFormat(d, func(input string) error {
+		_, err := ParseSearchServiceID(input)
+		return err
+	})
+}
+
+const serviceBusNamespaceIDFormat = "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.ServiceBus/namespaces/{name}"
+
+// ServiceBusNamespaceID is the ID of a ServiceBus Namespace in the format `/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.ServiceBus/namespaces/{name}`
+type ServiceBusNamespaceID struct {
+	Subscription  string
+	ResourceGroup string
+	Name          string
+}
+
+// NewServiceBusNamespaceID returns a ServiceBusNamespaceID for the specified segments
+func NewServiceBusNamespaceID(subscription, resourceGroup, name string) ServiceBusNamespaceID {
+	return ServiceBusNamespaceID{
+		Subscription:  subscription,
+		ResourceGroup: resourceGroup,
+		Name:          name,
+	}
+}
+
+// ParseServiceBusNamespaceID parses the specified Resource ID as a ServiceBusNamespaceID
+func ParseServiceBusNamespaceID(input string) (*ServiceBusNamespaceID, error) {
+	segments, err := parseResourceIDFormat(serviceBusNamespaceIDFormat, input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a ServiceBus Namespace ID: %+v", input, err)
+	}
+
+	return &ServiceBusNamespaceID{
+		Subscription:  segments[0],
+		ResourceGroup: segments[1],
+		Name:          segments[2],
+	}, nil
+}
+
+// String returns the ServiceBus Namespace ID in the canonical casing
+func (id ServiceBusNamespaceID) String() string {
+	return formatResourceID(serviceBusNamespaceIDFormat, id.Subscription, id.ResourceGroup, id.Name)
+}
+
+// ValidateServiceBusNamespaceID is a SchemaValidateFunc which validates that the value is a ServiceBus Namespace ID
+func ValidateServiceBusNamespaceID(i interface{}, k string) ([]string, []error) {
+	return validateResourceIDFormat(i, k, func(input string) error {
+		_, err := ParseServiceBusNamespaceID(input)
+		return err
+	})
+}
+
+// ImportServiceBusNamespaceID is a StateFunc which validates that the ID being imported is a ServiceBus Namespace ID
+func ImportServiceBusNamespaceID(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	return importResourceIDFormat(d, func(input string) error {
+		_, err := ParseServiceBusNamespaceID(input)
+		return err
+	})
+}
+
+const serviceBusNamespaceAuthorizationRuleIDFormat = "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.ServiceBus/namespaces/{namespace}/AuthorizationRules/{name}"
+
+// ServiceBusNamespaceAuthorizationRuleID is the ID of a ServiceBus Namespace Authorization Rule in the format `/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.ServiceBus/namespaces/{namespace}/AuthorizationRules/{name}`
+type ServiceBusNamespaceAuthorizationRuleID struct {
+	Subscription  string
+	ResourceGroup string
+	Namespace     string
+	Name          string
+}
+
+// NewServiceBusNamespaceAuthorizationRuleID returns a ServiceBusNamespaceAuthorizationRuleID for the specified segments
+func NewServiceBusNamespaceAuthorizationRuleID(subscription, resourceGroup, namespace, name string) ServiceBusNamespaceAuthorizationRuleID {
+	return ServiceBusNamespaceAuthorizationRuleID{
+		Subscription:  subscription,
+		ResourceGroup: resourceGroup,
+		Namespace:     namespace,
+		Name:          name,
+	}
+}
+
+// ParseServiceBusNamespaceAuthorizationRuleID parses the specified Resource ID as a ServiceBusNamespaceAuthorizationRuleID
+func ParseServiceBusNamespaceAuthorizationRuleID(input string) (*ServiceBusNamespaceAuthorizationRuleID, error) {
+	segments, err := parseResourceIDFormat(serviceBusNamespaceAuthorizationRuleIDFormat, input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a ServiceBus Namespace Authorization Rule ID: %+v", input, err)
+	}
+
+	return &ServiceBusNamespaceAuthorizationRuleID{
+		Subscription:  segments[0],
+		ResourceGroup: segments[1],
+		Namespace:     segments[2],
+		Name:          segments[3],
+	}, nil
+}
+
+// String returns the ServiceBus Namespace Authorization Rule ID in the canonical casing
+func (id ServiceBusNamespaceAuthorizationRuleID) String() string {
+	return formatResourceID(serviceBusNamespaceAuthorizationRuleIDFormat, id.Subscription, id.ResourceGroup, id.Namespace, id.Name)
+}
+
+// ValidateServiceBusNamespaceAuthorizationRuleID is a SchemaValidateFunc which validates that the value is a ServiceBus Namespace Authorization Rule ID
+func ValidateServiceBusNamespaceAuthorizationRuleID(i interface{}, k string) ([]string, []error) {
+	return validateResourceIDFormat(i, k, func(input string) error {
+		_, err := ParseServiceBusNamespaceAuthorizationRuleID(input)
+		return err
+	})
+}
+
+// ImportServiceBusNamespaceAuthorizationRuleID is a StateFunc which validates that the ID being imported is a ServiceBus Namespace Authorization Rule ID
+func ImportServiceBusNamespaceAuthorizationRuleID(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	return importResourceIDFormat(d, func(input string) error {
+		_, err := ParseServiceBusNamespaceAuthorizationRuleID(input)
+		return err
+	})
+}
+
+const serviceBusQueueIDFormat = "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.ServiceBus/namespaces/{namespace}/queues/{name}"
+
+// ServiceBusQueueID is the ID of a ServiceBus Queue in the format `/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.ServiceBus/namespaces/{namespace}/queues/{name}`
+type ServiceBusQueueID struct {
+	Subscription  string
+	ResourceGroup string
+	Namespace     string
+	Name          string
+}
+
+// NewServiceBusQueueID returns a ServiceBusQueueID for the specified segments
+func NewServiceBusQueueID(subscription, resourceGroup, namespace, name string) ServiceBusQueueID {
+	return ServiceBusQueueID{
+		Subscription:  subscription,
+		ResourceGroup: resourceGroup,
+		Namespace:     namespace,
+		Name:          name,
+	}
+}
+
+// ParseServiceBusQueueID parses the specified Resource ID as a ServiceBusQueueID
+func ParseServiceBusQueueID(input string) (*ServiceBusQueueID, error) {
+	segments, err := parseResourceIDFormat(serviceBusQueueIDFormat, input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a ServiceBus Queue ID: %+v", input, err)
+	}
+
+	return &ServiceBusQueueID{
+		Subscription:  segments[0],
+		ResourceGroup: segments[1],
+		Namespace:     segments[2],
+		Name:          segments[3],
+	}, nil
+}
+
+// String returns the ServiceBus Queue ID in the canonical casing
+func (id ServiceBusQueueID) String() string {
+	return formatResourceID(serviceBusQueueIDFormat, id.Subscription, id.ResourceGroup, id.Namespace, id.Name)
+}
+
+// ValidateServiceBusQueueID is a SchemaValidateFunc which validates that the value is a ServiceBus Queue ID
+func ValidateServiceBusQueueID(i interface{}, k string) ([]string, []error) {
+	return validateResourceIDFormat(i, k, func(input string) error {
+		_, err := ParseServiceBusQueueID(input)
+		return err
+	})
+}
+
+// ImportServiceBusQueueID is a StateFunc which validates that the ID being imported is a ServiceBus Queue ID
+func ImportServiceBusQueueID(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	return importResourceIDFormat(d, func(input string) error {
+		_, err := ParseServiceBusQueueID(input)
+		return err
+	})
+}
+
+const serviceBusQueueAuthorizationRuleIDFormat = "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.ServiceBus/namespaces/{namespace}/queues/{queue}/authorizationRules/{name}"
+
+// ServiceBusQueueAuthorizationRuleID is the ID of a ServiceBus Queue Authorization Rule in the format `/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.ServiceBus/namespaces/{namespace}/queues/{queue}/authorizationRules/{name}`
+type ServiceBusQueueAuthorizationRuleID struct {
+	Subscription  string
+	ResourceGroup string
+	Namespace     string
+	Queue         string
+	Name          string
+}
+
+// NewServiceBusQueueAuthorizationRuleID returns a ServiceBusQueueAuthorizationRuleID for the specified segments
+func NewServiceBusQueueAuthorizationRuleID(subscription, resourceGroup, namespace, queue, name string) ServiceBusQueueAuthorizationRuleID {
+	return ServiceBusQueueAuthorizationRuleID{
+		Subscription:  subscription,
+		ResourceGroup: resourceGroup,
+		Namespace:     namespace,
+		Queue:         queue,
+		Name:          name,
+	}
+}
+
+// ParseServiceBusQueueAuthorizationRuleID parses the specified Resource ID as a ServiceBusQueueAuthorizationRuleID
+func ParseServiceBusQueueAuthorizationRuleID(input string) (*ServiceBusQueueAuthorizationRuleID, error) {
+	segments, err := parseResourceIDFormat(serviceBusQueueAuthorizationRuleIDFormat, input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a ServiceBus Queue Authorization Rule ID: %+v", input, err)
+	}
+
+	return &ServiceBusQueueAuthorizationRuleID{
+		Subscription:  segments[0],
+		ResourceGroup: segments[1],
+		Namespace:     segments[2],
+		Queue:         segments[3],
+		Name:          segments[4],
+	}, nil
+}
+
+// String returns the ServiceBus Queue Authorization Rule ID in the canonical casing
+func (id ServiceBusQueueAuthorizationRuleID) String() string {
+	return formatResourceID(serviceBusQueueAuthorizationRuleIDFormat, id.Subscription, id.ResourceGroup, id.Namespace, id.Queue, id.Name)
+}
+
+// ValidateServiceBusQueueAuthorizationRuleID is a SchemaValidateFunc which validates that the value is a ServiceBus Queue Authorization Rule ID
+func ValidateServiceBusQueueAuthorizationRuleID(i interface{}, k string) ([]string, []error) {
+	return validateResourceIDFormat(i, k, func(input string) error {
+		_, err := ParseServiceBusQueueAuthorizationRuleID(input)
+		return err
+	})
+}
+
+// ImportServiceBusQueueAuthorizationRuleID is a StateFunc which validates that the ID being imported is a ServiceBus Queue Authorization Rule ID
+func ImportServiceBusQueueAuthorizationRuleID(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	return importResourceIDFormat(d, func(input string) error {
+		_, err := ParseServiceBusQueueAuthorizationRuleID(input)
+		return err
+	})
+}
+
+const serviceBusSubscriptionIDFormat = "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.ServiceBus/namespaces/{namespace}/topics/{topic}/subscriptions/{name}"
+
+// ServiceBusSubscriptionID is the ID of a ServiceBus Subscription in the format `/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.ServiceBus/namespaces/{namespace}/topics/{topic}/subscriptions/{name}`
+type ServiceBusSubscriptionID struct {
+	Subscription  string
+	ResourceGroup string
+	Namespace     string
+	Topic         string
+	Name          string
+}
+
+// NewServiceBusSubscriptionID returns a ServiceBusSubscriptionID for the specified segments
+func NewServiceBusSubscriptionID(subscription, resourceGroup, namespace, topic, name string) ServiceBusSubscriptionID {
+	return ServiceBusSubscriptionID{
+		Subscription:  subscription,
+		ResourceGroup: resourceGroup,
+		Namespace:     namespace,
+		Topic:         topic,
+		Name:          name,
+	}
+}
+
+// ParseServiceBusSubscriptionID parses the specified Resource ID as a ServiceBusSubscriptionID
+func ParseServiceBusSubscriptionID(input string) (*ServiceBusSubscriptionID, error) {
+	segments, err := parseResourceIDFormat(serviceBusSubscriptionIDFormat, input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a ServiceBus Subscription ID: %+v", input, err)
+	}
+
+	return &ServiceBusSubscriptionID{
+		Subscription:  segments[0],
+		ResourceGroup: segments[1],
+		Namespace:     segments[2],
+		Topic:         segments[3],
+		Name:          segments[4],
+	}, nil
+}
+
+// String returns the ServiceBus Subscription ID in the canonical casing
+func (id ServiceBusSubscriptionID) String() string {
+	return formatResourceID(serviceBusSubscriptionIDFormat, id.Subscription, id.ResourceGroup, id.Namespace, id.Topic, id.Name)
+}
+
+// ValidateServiceBusSubscriptionID is a SchemaValidateFunc which validates that the value is a ServiceBus Subscription ID
+func ValidateServiceBusSubscriptionID(i interface{}, k string) ([]string, []error) {
+	return validateResourceIDFormat(i, k, func(input string) error {
+		_, err := ParseServiceBusSubscriptionID(input)
+		return err
+	})
+}
+
+// ImportServiceBusSubscriptionID is a StateFunc which validates that the ID being imported is a ServiceBus Subscription ID
+func ImportServiceBusSubscriptionID(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	return importResourceIDFormat(d, func(input string) error {
+		_, err := ParseServiceBusSubscriptionID(input)
+		return err
+	})
+}
+
+const serviceBusSubscriptionRuleIDFormat = "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.ServiceBus/namespaces/{namespace}/topics/{topic}/subscriptions/{topicSubscription}/rules/{name}"
+
+// ServiceBusSubscriptionRuleID is the ID of a ServiceBus Subscription Rule in the format `/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.ServiceBus/namespaces/{namespace}/topics/{topic}/subscriptions/{topicSubscription}/rules/{name}`
+type ServiceBusSubscriptionRuleID struct {
+	Subscription      string
+	ResourceGroup     string
+	Namespace         string
+	Topic             string
+	TopicSubscription string
+	Name              string
+}
+
+// NewServiceBusSubscriptionRuleID returns a ServiceBusSubscriptionRuleID for the specified segments
+func NewServiceBusSubscriptionRuleID(subscription, resourceGroup, namespace, topic, topicSubscription, name string) ServiceBusSubscriptionRuleID {
+	return ServiceBusSubscriptionRuleID{
+		Subscription:      subscription,
+		ResourceGroup:     resourceGroup,
+		Namespace:         namespace,
+		Topic:             topic,
+		TopicSubscription: topicSubscription,
+		Name:              name,
+	}
+}
+
+// ParseServiceBusSubscriptionRuleID parses the specified Resource ID as a ServiceBusSubscriptionRuleID
+func ParseServiceBusSubscriptionRuleID(input string) (*ServiceBusSubscriptionRuleID, error) {
+	segments, err := parseResourceIDFormat(serviceBusSubscriptionRuleIDFormat, input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a ServiceBus Subscription Rule ID: %+v", input, err)
+	}
+
+	return &ServiceBusSubscriptionRuleID{
+		Subscription:      segments[0],
+		ResourceGroup:     segments[1],
+		Namespace:         segments[2],
+		Topic:             segments[3],
+		TopicSubscription: segments[4],
+		Name:              segments[5],
+	}, nil
+}
+
+// String returns the ServiceBus Subscription Rule ID in the canonical casing
+func (id ServiceBusSubscriptionRuleID) String() string {
+	return formatResourceID(serviceBusSubscriptionRuleIDFormat, id.Subscription, id.ResourceGroup, id.Namespace, id.Topic, id.TopicSubscription, id.Name)
+}
+
+// ValidateServiceBusSubscriptionRuleID is a SchemaValidateFunc which validates that the value is a ServiceBus Subscription Rule ID
+func ValidateServiceBusSubscriptionRuleID(i interface{}, k string) ([]string, []error) {
+	return validateResourceIDFormat(i, k, func(input string) error {
+		_, err := ParseServiceBusSubscriptionRuleID(input)
+		return err
+	})
+}
+
+// ImportServiceBusSubscriptionRuleID is a StateFunc which validates that the ID being imported is a ServiceBus Subscription Rule ID
+func ImportServiceBusSubscriptionRuleID(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	return importResourceIDFormat(d, func(input string) error {
+		_, err := ParseServiceBusSubscriptionRuleID(input)
+		return err
+	})
+}
+
+const serviceBusTopicIDFormat = "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.ServiceBus/namespaces/{namespace}/topics/{name}"
+
+// ServiceBusTopicID is the ID of a ServiceBus Topic in the format `/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.ServiceBus/namespaces/{namespace}/topics/{name}`
+type ServiceBusTopicID struct {
+	Subscription  string
+	ResourceGroup string
+	Namespace     string
+	Name          string
+}
+
+// NewServiceBusTopicID returns a ServiceBusTopicID for the specified segments
+func NewServiceBusTopicID(subscription, resourceGroup, namespace, name string) ServiceBusTopicID {
+	return ServiceBusTopicID{
+		Subscription:  subscription,
+		ResourceGroup: resourceGroup,
+		Namespace:     namespace,
+		Name:          name,
+	}
+}
+
+// ParseServiceBusTopicID parses the specified Resource ID as a ServiceBusTopicID
+func ParseServiceBusTopicID(input string) (*ServiceBusTopicID, error) {
+	segments, err := parseResourceIDFormat(serviceBusTopicIDFormat, input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a ServiceBus Topic ID: %+v", input, err)
+	}
+
+	return &ServiceBusTopicID{
+		Subscription:  segments[0],
+		ResourceGroup: segments[1],
+		Namespace:     segments[2],
+		Name:          segments[3],
+	}, nil
+}
+
+// String returns the ServiceBus Topic ID in the canonical casing
+func (id ServiceBusTopicID) String() string {
+	return formatResourceID(serviceBusTopicIDFormat, id.Subscription, id.ResourceGroup, id.Namespace, id.Name)
+}
+
+// ValidateServiceBusTopicID is a SchemaValidateFunc which validates that the value is a ServiceBus Topic ID
+func ValidateServiceBusTopicID(i interface{}, k string) ([]string, []error) {
+	return validateResourceIDFormat(i, k, func(input string) error {
+		_, err := ParseServiceBusTopicID(input)
+		return err
+	})
+}
+
+// ImportServiceBusTopicID is a StateFunc which validates that the ID being imported is a ServiceBus Topic ID
+func ImportServiceBusTopicID(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	return importResourceIDFormat(d, func(input string) error {
+		_, err := ParseServiceBusTopicID(input)
+		return err
+	})
+}
+
+const serviceBusTopicAuthorizationRuleIDFormat = "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.ServiceBus/namespaces/{namespace}/topics/{topic}/authorizationRules/{name}"
+
+// ServiceBusTopicAuthorizationRuleID is the ID of a ServiceBus Topic Authorization Rule in the format `/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.ServiceBus/namespaces/{namespace}/topics/{topic}/authorizationRules/{name}`
+type ServiceBusTopicAuthorizationRuleID struct {
+	Subscription  string
+	ResourceGroup string
+	Namespace     string
+	Topic         string
+	Name          string
+}
+
+// NewServiceBusTopicAuthorizationRuleID returns a ServiceBusTopicAuthorizationRuleID for the specified segments
+func NewServiceBusTopicAuthorizationRuleID(subscription, resourceGroup, namespace, topic, name string) ServiceBusTopicAuthorizationRuleID {
+	return ServiceBusTopicAuthorizationRuleID{
+		Subscription:  subscription,
+		ResourceGroup: resourceGroup,
+		Namespace:     namespace,
+		Topic:         topic,
+		Name:          name,
+	}
+}
+
+// ParseServiceBusTopicAuthorizationRuleID parses the specified Resource ID as a ServiceBusTopicAuthorizationRuleID
+func ParseServiceBusTopicAuthorizationRuleID(input string) (*ServiceBusTopicAuthorizationRuleID, error) {
+	segments, err := parseResourceIDFormat(serviceBusTopicAuthorizationRuleIDFormat, input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a ServiceBus Topic Authorization Rule ID: %+v", input, err)
+	}
+
+	return &ServiceBusTopicAuthorizationRuleID{
+		Subscription:  segments[0],
+		ResourceGroup: segments[1],
+		Namespace:     segments[2],
+		Topic:         segments[3],
+		Name:          segments[4],
+	}, nil
+}
+
+// String returns the ServiceBus Topic Authorization Rule ID in the canonical casing
+func (id ServiceBusTopicAuthorizationRuleID) String() string {
+	return formatResourceID(serviceBusTopicAuthorizationRuleIDFormat, id.Subscription, id.ResourceGroup, id.Namespace, id.Topic, id.Name)
+}
+
+// ValidateServiceBusTopicAuthorizationRuleID is a SchemaValidateFunc which validates that the value is a ServiceBus Topic Authorization Rule ID
+func ValidateServiceBusTopicAuthorizationRuleID(i interface{}, k string) ([]string, []error) {
+	return validateResourceIDFormat(i, k, func(input string) error {
+		_, err := ParseServiceBusTopicAuthorizationRuleID(input)
+		return err
+	})
+}
+
+// ImportServiceBusTopicAuthorizationRuleID is a StateFunc which validates that the ID being imported is a ServiceBus Topic Authorization Rule ID
+func ImportServiceBusTopicAuthorizationRuleID(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	return importResourceIDFormat(d, func(input string) error {
+		_, err := ParseServiceBusTopicAuthorizationRuleID(input)
+		return err
+	})
+}
+
+const serviceFabricClusterIDFormat = "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.ServiceFabric/clusters/{name}"
+
+// ServiceFabricClusterID is the ID of a Service Fabric Cluster in the format `/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.ServiceFabric/clusters/{name}`
+type ServiceFabricClusterID struct {
+	Subscription  string
+	ResourceGroup string
+	Name          string
+}
+
+// NewServiceFabricClusterID returns a ServiceFabricClusterID for the specified segments
+func NewServiceFabricClusterID(subscription, resourceGroup, name string) ServiceFabricClusterID {
+	return ServiceFabricClusterID{
+		Subscription:  subscription,
+		ResourceGroup: resourceGroup,
+		Name:          name,
+	}
+}
+
+// ParseServiceFabricClusterID parses the specified Resource ID as a ServiceFabricClusterID
+func ParseServiceFabricClusterID(input string) (*ServiceFabricClusterID, error) {
+	segments, err := parseResourceIDFormat(serviceFabricClusterIDFormat, input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a Service Fabric Cluster ID: %+v", input, err)
+	}
+
+	return &ServiceFabricClusterID{
+		Subscription:  segments[0],
+		ResourceGroup: segments[1],
+		Name:          segments[2],
+	}, nil
+}
+
+// String returns the Service Fabric Cluster ID in the canonical casing
+func (id ServiceFabricClusterID) String() string {
+	return formatResourceID(serviceFabricClusterIDFormat, id.Subscription, id.ResourceGroup, id.Name)
+}
+
+// ValidateServiceFabricClusterID is a SchemaValidateFunc which validates that the value is a Service Fabric Cluster ID
+func ValidateServiceFabricClusterID(i interface{}, k string) ([]string, []error) {
+	return validateResourceIDFormat(i, k, func(input string) error {
+		_, err := ParseServiceFabricClusterID(input)
+		return err
+	})
+}
+
+// ImportServiceFabricClusterID is a StateFunc which validates that the ID being imported is a Service Fabric Cluster ID
+func ImportServiceFabricClusterID(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	return importResourceIDFormat(d, func(input string) error {
+		_, err := ParseServiceFabricClusterID(input)
+		return err
+	})
+}
+
+const sqlActiveDirectoryAdministratorIDFormat = "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Sql/servers/{server}/administrators/{name}"
+
+// SqlActiveDirectoryAdministratorID is the ID of a SQL Active Directory Administrator in the format `/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Sql/servers/{server}/administrators/{name}`
+type SqlActiveDirectoryAdministratorID struct {
+	Subscription  string
+	ResourceGroup string
+	Server        string
+	Name          string
+}
+
+// NewSqlActiveDirectoryAdministratorID returns a SqlActiveDirectoryAdministratorID for the specified segments
+func NewSqlActiveDirectoryAdministratorID(subscription, resourceGroup, server, name string) SqlActiveDirectoryAdministratorID {
+	return SqlActiveDirectoryAdministratorID{
+		Subscription:  subscription,
+		ResourceGroup: resourceGroup,
+		Server:        server,
+		Name:          name,
+	}
+}
+
+// ParseSqlActiveDirectoryAdministratorID parses the specified Resource ID as a SqlActiveDirectoryAdministratorID
+func ParseSqlActiveDirectoryAdministratorID(input string) (*SqlActiveDirectoryAdministratorID, error) {
+	segments, err := parseResourceIDFormat(sqlActiveDirectoryAdministratorIDFormat, input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a SQL Active Directory Administrator ID: %+v", input, err)
+	}
+
+	return &SqlActiveDirectoryAdministratorID{
+		Subscription:  segments[0],
+		ResourceGroup: segments[1],
+		Server:        segments[2],
+		Name:          segments[3],
+	}, nil
+}
+
+// String returns the SQL Active Directory Administrator ID in the canonical casing
+func (id SqlActiveDirectoryAdministratorID) String() string {
+	return formatResourceID(sqlActiveDirectoryAdministratorIDFormat, id.Subscription, id.ResourceGroup, id.Server, id.Name)
+}
+
+// ValidateSqlActiveDirectoryAdministratorID is a SchemaValidateFunc which validates that the value is a SQL Active Directory Administrator ID
+func ValidateSqlActiveDirectoryAdministratorID(i interface{}, k string) ([]string, []error) {
+	return validateResourceIDFormat(i, k, func(input string) error {
+		_, err := ParseSqlActiveDirectoryAdministratorID(input)
+		return err
+	})
+}
+
+// ImportSqlActiveDirectoryAdministratorID is a StateFunc which validates that the ID being imported is a SQL Active Directory Administrator ID
+func ImportSqlActiveDirectoryAdministratorID(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	return importResourceIDFormat(d, func(input string) error {
+		_, err := ParseSqlActiveDirectoryAdministratorID(input)
+		return err
+	})
+}
+
+const sqlDatabaseIDFormat = "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Sql/servers/{server}/databases/{name}"
+
+// SqlDatabaseID is the ID of a SQL Database in the format `/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Sql/servers/{server}/databases/{name}`
+type SqlDatabaseID struct {
+	Subscription  string
+	ResourceGroup string
+	Server        string
+	Name          string
+}
+
+// NewSqlDatabaseID returns a SqlDatabaseID for the specified segments
+func NewSqlDatabaseID(subscription, resourceGroup, server, name string) SqlDatabaseID {
+	return SqlDatabaseID{
+		Subscription:  subscription,
+		ResourceGroup: resourceGroup,
+		Server:        server,
+		Name:          name,
+	}
+}
+
+// ParseSqlDatabaseID parses the specified Resource ID as a SqlDatabaseID
+func ParseSqlDatabaseID(input string) (*SqlDatabaseID, error) {
+	segments, err := parseResourceIDFormat(sqlDatabaseIDFormat, input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a SQL Database ID: %+v", input, err)
+	}
+
+	return &SqlDatabaseID{
+		Subscription:  segments[0],
+		ResourceGroup: segments[1],
+		Server:        segments[2],
+		Name:          segments[3],
+	}, nil
+}
+
+// String returns the SQL Database ID in the canonical casing
+func (id SqlDatabaseID) String() string {
+	return formatResourceID(sqlDatabaseIDFormat, id.Subscription, id.ResourceGroup, id.Server, id.Name)
+}
+
+// ValidateSqlDatabaseID is a SchemaValidateFunc which validates that the value is a SQL Database ID
+func ValidateSqlDatabaseID(i interface{}, k string) ([]string, []error) {
+	return validateResourceIDFormat(i, k, func(input string) error {
+		_, err := ParseSqlDatabaseID(input)
+		return err
+	})
+}
+
+// ImportSqlDatabaseID is a StateFunc which validates that the ID being imported is a SQL Database ID
+func ImportSqlDatabaseID(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	return importResourceIDFormat(d, func(input string) error {
+		_, err := ParseSqlDatabaseID(input)
+		return err
+	})
+}
+
+const sqlElasticPoolIDFormat = "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Sql/servers/{server}/elasticPools/{name}"
+
+// SqlElasticPoolID is the ID of a SQL Elastic Pool in the format `/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Sql/servers/{server}/elasticPools/{name}`
+type SqlElasticPoolID struct {
+	Subscription  string
+	ResourceGroup string
+	Server        string
+	Name          string
+}
+
+// NewSqlElasticPoolID returns a SqlElasticPoolID for the specified segments
+func NewSqlElasticPoolID(subscription, resourceGroup, server, name string) SqlElasticPoolID {
+	return SqlElasticPoolID{
+		Subscription:  subscription,
+		ResourceGroup: resourceGroup,
+		Server:        server,
+		Name:          name,
+	}
+}
+
+// ParseSqlElasticPoolID parses the specified Resource ID as a SqlElasticPoolID
+func ParseSqlElasticPoolID(input string) (*SqlElasticPoolID, error) {
+	segments, err := parseResourceIDFormat(sqlElasticPoolIDFormat, input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a SQL Elastic Pool ID: %+v", input, err)
+	}
+
+	return &SqlElasticPoolID{
+		Subscription:  segments[0],
+		ResourceGroup: segments[1],
+		Server:        segments[2],
+		Name:          segments[3],
+	}, nil
+}
+
+// String returns the SQL Elastic Pool ID in the canonical casing
+func (id SqlElasticPoolID) String() string {
+	return formatResourceID(sqlElasticPoolIDFormat, id.Subscription, id.ResourceGroup, id.Server, id.Name)
+}
+
+// ValidateSqlElasticPoolID is a SchemaValidateFunc which validates that the value is a SQL Elastic Pool ID
+func ValidateSqlElasticPoolID(i interface{}, k string) ([]string, []error) {
+	return validateResourceIDFormat(i, k, func(input string) error {
+		_, err := ParseSqlElasticPoolID(input)
+		return err
+	})
+}
+
+// ImportSqlElasticPoolID is a StateFunc which validates that the ID being imported is a SQL Elastic Pool ID
+func ImportSqlElasticPoolID(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	return importResourceIDFormat(d, func(input string) error {
+		_, err := ParseSqlElasticPoolID(input)
+		return err
+	})
+}
+
+const sqlFirewallRuleIDFormat = "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Sql/servers/{server}/firewallRules/{name}"
+
+// SqlFirewallRuleID is the ID of a SQL Firewall Rule in the format `/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Sql/servers/{server}/firewallRules/{name}`
+type SqlFirewallRuleID struct {
+	Subscription  string
+	ResourceGroup string
+	Server        string
+	Name          string
+}
+
+// NewSqlFirewallRuleID returns a SqlFirewallRuleID for the specified segments
+func NewSqlFirewallRuleID(subscription, resourceGroup, server, name string) SqlFirewallRuleID {
+	return SqlFirewallRuleID{
+		Subscription:  subscription,
+		ResourceGroup: resourceGroup,
+		Server:        server,
+		Name:          name,
+	}
+}
+
+// ParseSqlFirewallRuleID parses the specified Resource ID as a SqlFirewallRuleID
+func ParseSqlFirewallRuleID(input string) (*SqlFirewallRuleID, error) {
+	segments, err := parseResourceIDFormat(sqlFirewallRuleIDFormat, input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a SQL Firewall Rule ID: %+v", input, err)
+	}
+
+	return &SqlFirewallRuleID{
+		Subscription:  segments[0],
+		ResourceGroup: segments[1],
+		Server:        segments[2],
+		Name:          segments[3],
+	}, nil
+}
+
+// String returns the SQL Firewall Rule ID in the canonical casing
+func (id SqlFirewallRuleID) String() string {
+	return formatResourceID(sqlFirewallRuleIDFormat, id.Subscription, id.ResourceGroup, id.Server, id.Name)
+}
+
+// ValidateSqlFirewallRuleID is a SchemaValidateFunc which validates that the value is a SQL Firewall Rule ID
+func ValidateSqlFirewallRuleID(i interface{}, k string) ([]string, []error) {
+	return validateResourceIDFormat(i, k, func(input string) error {
+		_, err := ParseSqlFirewallRuleID(input)
+		return err
+	})
+}
+
+// ImportSqlFirewallRuleID is a StateFunc which validates that the ID being imported is a SQL Firewall Rule ID
+func ImportSqlFirewallRuleID(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	return importResourceIDFormat(d, func(input string) error {
+		_, err := ParseSqlFirewallRuleID(input)
+		return err
+	})
+}
+
+const sqlServerIDFormat = "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Sql/servers/{name}"
+
+// SqlServerID is the ID of a SQL Server in the format `/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Sql/servers/{name}`
+type SqlServerID struct {
+	Subscription  string
+	ResourceGroup string
+	Name          string
+}
+
+// NewSqlServerID returns a SqlServerID for the specified segments
+func NewSqlServerID(subscription, resourceGroup, name string) SqlServerID {
+	return SqlServerID{
+		Subscription:  subscription,
+		ResourceGroup: resourceGroup,
+		Name:          name,
+	}
+}
+
+// ParseSqlServerID parses the specified Resource ID as a SqlServerID
+func ParseSqlServerID(input string) (*SqlServerID, error) {
+	segments, err := parseResourceIDFormat(sqlServerIDFormat, input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a SQL Server ID: %+v", input, err)
+	}
+
+	return &SqlServerID{
+		Subscription:  segments[0],
+		ResourceGroup: segments[1],
+		Name:          segments[2],
+	}, nil
+}
+
+// String returns the SQL Server ID in the canonical casing
+func (id SqlServerID) String() string {
+	return formatResourceID(sqlServerIDFormat, id.Subscription, id.ResourceGroup, id.Name)
+}
+
+// ValidateSqlServerID is a SchemaValidateFunc which validates that the value is a SQL Server ID
+func ValidateSqlServerID(i interface{}, k string) ([]string, []error) {
+	return validateResourceIDFormat(i, k, func(input string) error {
+		_, err := ParseSqlServerID(input)
+		return err
+	})
+}
+
+// ImportSqlServerID is a StateFunc which validates that the ID being imported is a SQL Server ID
+func ImportSqlServerID(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	return importResourceIDFormat(d, func(input string) error {
+		_, err := ParseSqlServerID(input)
+		return err
+	})
+}
+
+const sqlVirtualNetworkRuleIDFormat = "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Sql/servers/{server}/virtualNetworkRules/{name}"
+
+// SqlVirtualNetworkRuleID is the ID of a SQL Virtual Network Rule in the format `/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Sql/servers/{server}/virtualNetworkRules/{name}`
+type SqlVirtualNetworkRuleID struct {
+	Subscription  string
+	ResourceGroup string
+	Server        string
+	Name          string
+}
+
+// NewSqlVirtualNetworkRuleID returns a SqlVirtualNetworkRuleID for the specified segments
+func NewSqlVirtualNetworkRuleID(subscription, resourceGroup, server, name string) SqlVirtualNetworkRuleID {
+	return SqlVirtualNetworkRuleID{
+		Subscription:  subscription,
+		ResourceGroup: resourceGroup,
+		Server:        server,
+		Name:          name,
+	}
+}
+
+// ParseSqlVirtualNetworkRuleID parses the specified Resource ID as a SqlVirtualNetworkRuleID
+func ParseSqlVirtualNetworkRuleID(input string) (*SqlVirtualNetworkRuleID, error) {
+	segments, err := parseResourceIDFormat(sqlVirtualNetworkRuleIDFormat, input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a SQL Virtual Network Rule ID: %+v", input, err)
+	}
+
+	return &SqlVirtualNetworkRuleID{
+		Subscription:  segments[0],
+		ResourceGroup: segments[1],
+		Server:        segments[2],
+		Name:          segments[3],
+	}, nil
+}
+
+// String returns the SQL Virtual Network Rule ID in the canonical casing
+func (id SqlVirtualNetworkRuleID) String() string {
+	return formatResourceID(sqlVirtualNetworkRuleIDFormat, id.Subscription, id.ResourceGroup, id.Server, id.Name)
+}
+
+// ValidateSqlVirtualNetworkRuleID is a SchemaValidateFunc which validates that the value is a SQL Virtual Network Rule ID
+func ValidateSqlVirtualNetworkRuleID(i interface{}, k string) ([]string, []error) {
+	return validateResourceIDFormat(i, k, func(input string) error {
+		_, err := ParseSqlVirtualNetworkRuleID(input)
+		return err
+	})
+}
+
+// ImportSqlVirtualNetworkRuleID is a StateFunc which validates that the ID being imported is a SQL Virtual Network Rule ID
+func ImportSqlVirtualNetworkRuleID(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	return importResourceIDFormat(d, func(input string) error {
+		_, err := ParseSqlVirtualNetworkRuleID(input)
+		return err
+	})
+}
+
+const storageAccountIDFormat = "/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Storage/storageAccounts/{name}"
+
+// StorageAccountID is the ID of a Storage Account in the format `/subscriptions/{subscription}/resourceGroups/{resourceGroup}/providers/Microsoft.Storage/storageAccounts/{name}`
+type StorageAccountID struct {
+	Subscription  string
+	ResourceGroup string
+	Name          string
+}
+
+// NewStorageAccountID returns a StorageAccountID for the specified segments
+func NewStorageAccountID(subscription, resourceGroup, name string) StorageAccountID {
+	return StorageAccountID{
+		Subscription:  subscription,
+		ResourceGroup: resourceGroup,
+		Name:          name,
+	}
+}
+
+// ParseStorageAccountID parses the specified Resource ID as a StorageAccountID
+func ParseStorageAccountID(input string) (*StorageAccountID, error) {
+	segments, err := parseResourceIDFormat(storageAccountIDFormat, input)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing %q as a Storage Account ID: %+v", input, err)
+	}
+
+	return &StorageAccountID{
+		Subscription:  segments[0],
+		ResourceGroup: segments[1],
+		Name:          segments[2],
+	}, nil
+}
+
+// String returns the Storage Account ID in the canonical casing
+func (id StorageAccountID) String() string {
+	return formatResourceID(storageAccountIDFormat, id.Subscription, id.ResourceGroup, id.Name)
+}
+
+// ValidateStorageAccountID is a SchemaValidateFunc which validates that the value is a Storage Account ID
+func ValidateStorageAccountID(i interface{}, k string) ([]string, []error) {
+	return validateResourceIDFormat(i, k, func(input string) error {
+		_, err := ParseStorageAccountID(input)
+		return err
+	})
+}
+
+// ImportStorageAccountID is a StateFunc which validates that the ID being imported is a Storage Account ID
+func ImportStorageAccountID(d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	return importResourceIDFormat(d, func(input string) error {
+		_, err := ParseStorageAccountID(input)
+		return err
+	})
+}
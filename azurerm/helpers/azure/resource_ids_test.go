@@ -0,0 +1,228 @@
+package azure
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func TestParseSubnetID(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Input    string
+		Expected *SubnetID
+	}{
+		{
+			Name:  "Empty",
+			Input: "",
+		},
+		{
+			Name:  "Resource Group ID",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1",
+		},
+		{
+			Name:  "Virtual Network ID",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1",
+		},
+		{
+			Name:  "Network Security Group ID",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/networkSecurityGroups/group1",
+		},
+		{
+			Name:  "Wrong Provider",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.ClassicNetwork/virtualNetworks/network1/subnets/subnet1",
+		},
+		{
+			Name:  "Missing Name",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1/subnets/",
+		},
+		{
+			Name:  "Empty Virtual Network",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks//subnets/subnet1",
+		},
+		{
+			Name:  "Segments in the wrong order",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/subnets/subnet1/virtualNetworks/network1",
+		},
+		{
+			Name:  "Nested Resource",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1/subnets/subnet1/providers/Microsoft.Authorization/locks/lock1",
+		},
+		{
+			Name:  "Query String",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1/subnets/subnet1?api-version=2018-04-01",
+		},
+		{
+			Name:  "Subnet ID",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1/subnets/subnet1",
+			Expected: &SubnetID{
+				Subscription:   "00000000-0000-0000-0000-000000000000",
+				ResourceGroup:  "group1",
+				VirtualNetwork: "network1",
+				Name:           "subnet1",
+			},
+		},
+		{
+			Name:  "Subnet ID with alternative casing",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/Group1/providers/microsoft.network/VirtualNetworks/Network1/Subnets/Subnet1/",
+			Expected: &SubnetID{
+				Subscription:   "00000000-0000-0000-0000-000000000000",
+				ResourceGroup:  "Group1",
+				VirtualNetwork: "Network1",
+				Name:           "Subnet1",
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Run(v.Name, func(t *testing.T) {
+			actual, err := ParseSubnetID(v.Input)
+			if err != nil {
+				if v.Expected == nil {
+					return
+				}
+
+				t.Fatalf("Expected no error but got: %+v", err)
+			}
+
+			if v.Expected == nil {
+				t.Fatalf("Expected an error but got: %+v", actual)
+			}
+
+			if !reflect.DeepEqual(*v.Expected, *actual) {
+				t.Fatalf("Expected %+v but got %+v", *v.Expected, *actual)
+			}
+		})
+	}
+}
+
+func TestParseServiceBusSubscriptionRuleID(t *testing.T) {
+	// the `subscriptions` segment appears twice, so must be parsed by position rather than by key
+	input := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.ServiceBus/namespaces/namespace1/topics/topic1/subscriptions/subscription1/rules/rule1"
+
+	actual, err := ParseServiceBusSubscriptionRuleID(input)
+	if err != nil {
+		t.Fatalf("Expected no error but got: %+v", err)
+	}
+
+	expected := ServiceBusSubscriptionRuleID{
+		Subscription:      "00000000-0000-0000-0000-000000000000",
+		ResourceGroup:     "group1",
+		Namespace:         "namespace1",
+		Topic:             "topic1",
+		TopicSubscription: "subscription1",
+		Name:              "rule1",
+	}
+	if !reflect.DeepEqual(expected, *actual) {
+		t.Fatalf("Expected %+v but got %+v", expected, *actual)
+	}
+}
+
+func TestResourceIDString(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Input    interface{ String() string }
+		Expected string
+	}{
+		{
+			Name:     "Resource Group",
+			Input:    NewResourceGroupID("00000000-0000-0000-0000-000000000000", "group1"),
+			Expected: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1",
+		},
+		{
+			// the segments must be in the order defined by the API - rather than sorted alphabetically
+			Name:     "Subnet",
+			Input:    NewSubnetID("00000000-0000-0000-0000-000000000000", "group1", "network1", "subnet1"),
+			Expected: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1/subnets/subnet1",
+		},
+		{
+			Name:     "Load Balancer Rule",
+			Input:    NewLoadBalancerRuleID("00000000-0000-0000-0000-000000000000", "group1", "lb1", "rule1"),
+			Expected: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/loadBalancers/lb1/loadBalancingRules/rule1",
+		},
+		{
+			Name:     "Policy Definition",
+			Input:    NewPolicyDefinitionID("00000000-0000-0000-0000-000000000000", "definition1"),
+			Expected: "/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.Authorization/policyDefinitions/definition1",
+		},
+	}
+
+	for _, v := range testData {
+		t.Run(v.Name, func(t *testing.T) {
+			if actual := v.Input.String(); actual != v.Expected {
+				t.Fatalf("Expected %q but got %q", v.Expected, actual)
+			}
+		})
+	}
+}
+
+func TestResourceIDStringNormalizesCasing(t *testing.T) {
+	id, err := ParseManagedDiskID("/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/Group1/providers/microsoft.compute/disks/Disk1")
+	if err != nil {
+		t.Fatalf("Expected no error but got: %+v", err)
+	}
+
+	expected := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/Group1/providers/Microsoft.Compute/disks/Disk1"
+	if actual := id.String(); actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+}
+
+func TestValidateVirtualNetworkID(t *testing.T) {
+	testData := []struct {
+		Input  interface{}
+		Errors int
+	}{
+		{
+			Input:  1,
+			Errors: 1,
+		},
+		{
+			Input:  "",
+			Errors: 1,
+		},
+		{
+			Input:  "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1/subnets/subnet1",
+			Errors: 1,
+		},
+		{
+			Input:  "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1",
+			Errors: 0,
+		},
+	}
+
+	for _, v := range testData {
+		_, errors := ValidateVirtualNetworkID(v.Input, "virtual_network_id")
+		if len(errors) != v.Errors {
+			t.Fatalf("Expected %d errors for %+v but got %d: %+v", v.Errors, v.Input, len(errors), errors)
+		}
+	}
+}
+
+func TestImportPublicIPAddressID(t *testing.T) {
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+
+	d := r.TestResourceData()
+	d.SetId("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/networkInterfaces/nic1")
+	if _, err := ImportPublicIPAddressID(d, nil); err == nil {
+		t.Fatalf("Expected an error importing a Network Interface ID as a Public IP Address but didn't get one")
+	}
+
+	d.SetId("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/publicIPAddresses/ip1")
+	results, err := ImportPublicIPAddressID(d, nil)
+	if err != nil {
+		t.Fatalf("Expected no error but got: %+v", err)
+	}
+
+	if len(results) != 1 || results[0].Id() != d.Id() {
+		t.Fatalf("Expected the Resource Data to be returned unchanged but got: %+v", results)
+	}
+}
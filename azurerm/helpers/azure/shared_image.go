@@ -0,0 +1,56 @@
+package azure
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+)
+
+func ValidateSharedImageGalleryName() schema.SchemaValidateFunc {
+	return validation.StringMatch(
+		regexp.MustCompile(`^[A-Za-z0-9]([A-Za-z0-9_.]{0,78}[A-Za-z0-9])?$`),
+		"The Shared Image Gallery name can contain only letters, numbers, periods (.) and underscores (_), up to 80 characters - and it must begin and end with a letter or number.",
+	)
+}
+
+func ValidateSharedImageName() schema.SchemaValidateFunc {
+	return validation.StringMatch(
+		regexp.MustCompile(`^[A-Za-z0-9]([-A-Za-z0-9_.]{0,78}[A-Za-z0-9])?$`),
+		"The Shared Image name can contain only letters, numbers, periods (.), hyphens (-) and underscores (_), up to 80 characters - and it must begin and end with a letter or number.",
+	)
+}
+
+func ValidateSharedImageVersionName() schema.SchemaValidateFunc {
+	return validation.StringMatch(
+		regexp.MustCompile(`^[0-9]+\.[0-9]+\.[0-9]+$`),
+		"The Shared Image Version name must be in the format `Major.Minor.Patch`, e.g. `1.0.0`.",
+	)
+}
+
+// ValidateImageReferenceID validates that the value is the ID of an Image which a Virtual Machine (or Virtual Machine
+// Scale Set) can be provisioned from - either a Managed Image, a Shared Image (to use the latest version) or a
+// Shared Image Version
+func ValidateImageReferenceID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := ParseImageID(v); err == nil {
+		return
+	}
+
+	if _, err := ParseSharedImageID(v); err == nil {
+		return
+	}
+
+	if _, err := ParseSharedImageVersionID(v); err == nil {
+		return
+	}
+
+	errors = append(errors, fmt.Errorf("%q must be the ID of an Image, Shared Image or Shared Image Version - got %q", k, v))
+	return
+}
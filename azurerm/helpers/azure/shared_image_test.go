@@ -0,0 +1,176 @@
+package azure
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestValidateSharedImageGalleryName(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		valid bool
+	}{
+		{
+			name:  "Single character",
+			input: "a",
+			valid: true,
+		},
+		{
+			name:  "Periods and underscores",
+			input: "my_gallery.prod",
+			valid: true,
+		},
+		{
+			name:  "Hyphens",
+			input: "my-gallery",
+			valid: false,
+		},
+		{
+			name:  "Ends with a period",
+			input: "gallery.",
+			valid: false,
+		},
+		{
+			name:  "Maximum length",
+			input: strings.Repeat("a", 80),
+			valid: true,
+		},
+		{
+			name:  "Too long",
+			input: strings.Repeat("a", 81),
+			valid: false,
+		},
+	}
+
+	validate := ValidateSharedImageGalleryName()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, errors := validate(tt.input, "name")
+			if valid := len(errors) == 0; valid != tt.valid {
+				t.Fatalf("Expected %t for %q but got %t", tt.valid, tt.input, valid)
+			}
+		})
+	}
+}
+
+func TestValidateSharedImageName(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		valid bool
+	}{
+		{
+			name:  "Hyphens",
+			input: "ubuntu-16.04_base",
+			valid: true,
+		},
+		{
+			name:  "Starts with a hyphen",
+			input: "-image",
+			valid: false,
+		},
+		{
+			name:  "Too long",
+			input: strings.Repeat("a", 81),
+			valid: false,
+		},
+	}
+
+	validate := ValidateSharedImageName()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, errors := validate(tt.input, "name")
+			if valid := len(errors) == 0; valid != tt.valid {
+				t.Fatalf("Expected %t for %q but got %t", tt.valid, tt.input, valid)
+			}
+		})
+	}
+}
+
+func TestValidateSharedImageVersionName(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		valid bool
+	}{
+		{
+			name:  "Semantic Version",
+			input: "1.0.0",
+			valid: true,
+		},
+		{
+			name:  "Large Numbers",
+			input: "2018.10.123",
+			valid: true,
+		},
+		{
+			name:  "Two Segments",
+			input: "1.0",
+			valid: false,
+		},
+		{
+			name:  "Latest",
+			input: "latest",
+			valid: false,
+		},
+	}
+
+	validate := ValidateSharedImageVersionName()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, errors := validate(tt.input, "name")
+			if valid := len(errors) == 0; valid != tt.valid {
+				t.Fatalf("Expected %t for %q but got %t", tt.valid, tt.input, valid)
+			}
+		})
+	}
+}
+
+func TestValidateImageReferenceID(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		valid bool
+	}{
+		{
+			name:  "Empty",
+			input: "",
+			valid: false,
+		},
+		{
+			name:  "Image",
+			input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Compute/images/image1",
+			valid: true,
+		},
+		{
+			name:  "Shared Image",
+			input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Compute/galleries/gallery1/images/image1",
+			valid: true,
+		},
+		{
+			name:  "Shared Image Version",
+			input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Compute/galleries/gallery1/images/image1/versions/1.0.0",
+			valid: true,
+		},
+		{
+			name:  "Shared Image Gallery",
+			input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Compute/galleries/gallery1",
+			valid: false,
+		},
+		{
+			name:  "Managed Disk",
+			input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Compute/disks/disk1",
+			valid: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, errors := ValidateImageReferenceID(tt.input, "id")
+			if valid := len(errors) == 0; valid != tt.valid {
+				t.Fatalf("Expected %t for %q but got %t", tt.valid, tt.input, valid)
+			}
+		})
+	}
+}
@@ -0,0 +1,341 @@
+package logging
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"log"
+	"mime"
+	"net/http"
+	"net/http/httputil"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// RedactedValue is the placeholder written in place of any sensitive value
+const RedactedValue = "***REDACTED***"
+
+// UnredactedEnvVar is the Environment Variable which can be set to disable redaction of the debug logs
+const UnredactedEnvVar = "ARM_LOG_UNREDACTED"
+
+// sensitiveHeaders are the HTTP Headers whose values are never logged
+var sensitiveHeaders = []string{
+	"Authorization",
+	"Cookie",
+	"Ocp-Apim-Subscription-Key",
+	"Set-Cookie",
+	"X-Ms-Authorization-Auxiliary",
+	"X-Ms-Encryption-Key",
+}
+
+// sensitiveQueryParameters are the URL Query String parameters whose values are never logged
+// e.g. the signature of a Shared Access Signature
+var sensitiveQueryParameters = map[string]bool{
+	"code": true,
+	"sig":  true,
+}
+
+// sensitiveFormFields are the fields within a form-encoded body whose values are never logged
+// e.g. the Client Secret sent when requesting an OAuth token
+var sensitiveFormFields = map[string]bool{
+	"access_token":     true,
+	"client_assertion": true,
+	"client_secret":    true,
+	"password":         true,
+	"refresh_token":    true,
+}
+
+// sensitiveProperties are the (lower-cased) names of JSON properties whose values are never logged
+var sensitiveProperties = map[string]bool{
+	// OAuth Token Responses
+	"access_token":  true,
+	"id_token":      true,
+	"refresh_token": true,
+	"client_secret": true,
+
+	// credentials sent in requests
+	"adminpassword":                true,
+	"administratorloginpassword":   true,
+	"administrator_login_password": true,
+	"password":                     true,
+	"secret":                       true,
+	"sharedkey":                    true,
+	"authorizationkey":             true,
+	"protectedsettings":            true,
+	"storageaccountkey":            true,
+	"storageaccesskey":             true,
+
+	// keys & connection strings returned in responses
+	"primarykey":                     true,
+	"secondarykey":                   true,
+	"primarymasterkey":               true,
+	"secondarymasterkey":             true,
+	"primaryreadonlymasterkey":       true,
+	"secondaryreadonlymasterkey":     true,
+	"primaryaccesskey":               true,
+	"secondaryaccesskey":             true,
+	"primaryconnectionstring":        true,
+	"secondaryconnectionstring":      true,
+	"aliasprimaryconnectionstring":   true,
+	"aliassecondaryconnectionstring": true,
+	"connectionstring":               true,
+}
+
+// secretValueOperations are the (lower-cased) URL path segments of operations which return
+// a secret within a generic `value` property - such as the Storage Account ListKeys operation
+// or retrieving a Secret from a Key Vault
+var secretValueOperations = []string{
+	"/listkeys",
+	"/regeneratekey",
+	"/listconnectionstrings",
+	"/listcredentials",
+	"/listcredential",
+	"/secrets/",
+}
+
+var warnUnredacted sync.Once
+
+// Unredacted returns whether redaction has been disabled via the `ARM_LOG_UNREDACTED` Environment Variable
+func Unredacted() bool {
+	v, err := strconv.ParseBool(os.Getenv(UnredactedEnvVar))
+	if err != nil || !v {
+		return false
+	}
+
+	warnUnredacted.Do(func() {
+		log.Printf("[WARN] %s is set - sensitive values (such as Access Tokens, Passwords and Keys) will be written to the debug logs!", UnredactedEnvVar)
+	})
+	return true
+}
+
+// URL returns the specified URL as a string with the values of any sensitive Query String parameters redacted
+func URL(u *url.URL) string {
+	if u == nil {
+		return ""
+	}
+
+	if Unredacted() {
+		return u.String()
+	}
+
+	return redactURL(u).String()
+}
+
+// DumpRequest returns the wire-format of the specified (outgoing) HTTP Request, with any
+// sensitive Headers, Query String parameters and Body values redacted
+func DumpRequest(r *http.Request) ([]byte, error) {
+	if Unredacted() {
+		return httputil.DumpRequestOut(r, true)
+	}
+
+	body, err := readBody(&r.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	redactedBody := Body(r.URL, r.Header.Get("Content-Type"), body)
+
+	clone := *r
+	clone.URL = redactURL(r.URL)
+	clone.Header = Headers(r.Header)
+	clone.Body = nil
+	clone.ContentLength = int64(len(redactedBody))
+	if len(redactedBody) > 0 {
+		clone.Body = ioutil.NopCloser(bytes.NewReader(redactedBody))
+	}
+	return httputil.DumpRequestOut(&clone, true)
+}
+
+// DumpResponse returns the wire-format of the specified HTTP Response, with any
+// sensitive Headers and Body values redacted
+func DumpResponse(resp *http.Response) ([]byte, error) {
+	if Unredacted() {
+		return httputil.DumpResponse(resp, true)
+	}
+
+	body, err := readBody(&resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var requestUrl *url.URL
+	if resp.Request != nil {
+		requestUrl = resp.Request.URL
+	}
+	redactedBody := Body(requestUrl, resp.Header.Get("Content-Type"), body)
+
+	clone := *resp
+	clone.Header = Headers(resp.Header)
+	clone.Body = ioutil.NopCloser(bytes.NewReader(redactedBody))
+	clone.ContentLength = int64(len(redactedBody))
+	clone.TransferEncoding = nil
+	return httputil.DumpResponse(&clone, true)
+}
+
+// Headers returns a copy of the specified HTTP Headers with the values of any sensitive Headers redacted
+func Headers(input http.Header) http.Header {
+	output := make(http.Header, len(input))
+	for k, v := range input {
+		output[k] = v
+	}
+
+	for _, name := range sensitiveHeaders {
+		if output.Get(name) != "" {
+			output.Set(name, RedactedValue)
+		}
+	}
+
+	return output
+}
+
+// Body returns a copy of the specified HTTP Body with the values of any sensitive fields redacted,
+// based on the Content Type of the Body and the URL of the Request it relates to
+func Body(u *url.URL, contentType string, body []byte) []byte {
+	if len(body) == 0 {
+		return body
+	}
+
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		mediaType = ""
+	}
+
+	if mediaType == "application/x-www-form-urlencoded" {
+		return redactForm(body)
+	}
+
+	// the Content Type isn't always set (or accurate) - so attempt to parse anything else as JSON
+	redactGenericValues := isSecretValueOperation(u)
+	var v interface{}
+	if err := json.Unmarshal(body, &v); err != nil {
+		return body
+	}
+
+	if !redactJSON(v, redactGenericValues) {
+		return body
+	}
+
+	output, err := json.Marshal(v)
+	if err != nil {
+		// we've been unable to serialize the redacted body, so the safest thing is to return none of it
+		return []byte(RedactedValue)
+	}
+	return output
+}
+
+func readBody(body *io.ReadCloser) ([]byte, error) {
+	if *body == nil || *body == http.NoBody {
+		return nil, nil
+	}
+
+	b, err := ioutil.ReadAll(*body)
+	(*body).Close()
+	if err != nil {
+		return nil, err
+	}
+
+	// put the body back so that it can be consumed by the caller
+	*body = ioutil.NopCloser(bytes.NewReader(b))
+	return b, nil
+}
+
+func redactURL(input *url.URL) *url.URL {
+	if input == nil {
+		return nil
+	}
+
+	output := *input
+	values := output.Query()
+	changed := false
+	for k := range values {
+		if sensitiveQueryParameters[strings.ToLower(k)] {
+			values.Set(k, RedactedValue)
+			changed = true
+		}
+	}
+
+	if changed {
+		output.RawQuery = values.Encode()
+	}
+
+	return &output
+}
+
+func redactForm(body []byte) []byte {
+	// the fields are redacted in-place (rather than re-encoding the form) to retain their order
+	fields := strings.Split(string(body), "&")
+	for i, field := range fields {
+		key := strings.SplitN(field, "=", 2)[0]
+		name, err := url.QueryUnescape(key)
+		if err != nil {
+			// if we can't parse it we can't tell what's in it
+			return []byte(RedactedValue)
+		}
+
+		if sensitiveFormFields[strings.ToLower(name)] {
+			fields[i] = key + "=" + RedactedValue
+		}
+	}
+
+	return []byte(strings.Join(fields, "&"))
+}
+
+// redactJSON walks the specified (unmarshalled) JSON document, replacing the values of any sensitive
+// properties in-place - and returns whether any values were redacted
+func redactJSON(input interface{}, redactGenericValues bool) bool {
+	changed := false
+
+	switch v := input.(type) {
+	case map[string]interface{}:
+		for key, value := range v {
+			name := strings.ToLower(key)
+			if sensitiveProperties[name] {
+				if value != nil {
+					v[key] = RedactedValue
+					changed = true
+				}
+				continue
+			}
+
+			if redactGenericValues && name == "value" {
+				if _, ok := value.(string); ok {
+					v[key] = RedactedValue
+					changed = true
+					continue
+				}
+			}
+
+			if redactJSON(value, redactGenericValues) {
+				changed = true
+			}
+		}
+
+	case []interface{}:
+		for _, value := range v {
+			if redactJSON(value, redactGenericValues) {
+				changed = true
+			}
+		}
+	}
+
+	return changed
+}
+
+func isSecretValueOperation(u *url.URL) bool {
+	if u == nil {
+		return false
+	}
+
+	path := strings.ToLower(u.Path)
+	for _, operation := range secretValueOperations {
+		if strings.Contains(path, operation) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,258 @@
+package logging
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestBody(t *testing.T) {
+	testCases := []struct {
+		Name        string
+		URL         string
+		ContentType string
+		SourceFile  string
+		Redacted    []string
+		Retained    []string
+	}{
+		{
+			Name:        "OAuth Token Request",
+			URL:         "https://login.microsoftonline.com/00000000-0000-0000-0000-000000000000/oauth2/token",
+			ContentType: "application/x-www-form-urlencoded",
+			SourceFile:  "oauth_token_request.txt",
+			Redacted:    []string{"s3cr3t-Cl1ent-S3cret"},
+			Retained:    []string{"client_credentials", "00000000-0000-0000-0000-000000000000"},
+		},
+		{
+			Name:        "OAuth Token Response",
+			URL:         "https://login.microsoftonline.com/00000000-0000-0000-0000-000000000000/oauth2/token",
+			ContentType: "application/json; charset=utf-8",
+			SourceFile:  "oauth_token_response.json",
+			Redacted:    []string{"s3cr3t-access-token", "s3cr3t-refresh-token"},
+			Retained:    []string{"Bearer", "https://management.azure.com/"},
+		},
+		{
+			Name:        "Storage Account ListKeys Response",
+			URL:         "https://management.azure.com/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example/providers/Microsoft.Storage/storageAccounts/example/listKeys?api-version=2017-10-01",
+			ContentType: "application/json",
+			SourceFile:  "storage_account_list_keys_response.json",
+			Redacted:    []string{"s3cr3tKey1", "s3cr3tKey2"},
+			Retained:    []string{"key1", "key2", "FULL"},
+		},
+		{
+			Name:        "Key Vault Secret Response",
+			URL:         "https://acctestkv.vault.azure.net/secrets/example/4a8e0e8d0d3c4d3c9d7b6a5f4e3d2c1b?api-version=2016-10-01",
+			ContentType: "application/json; charset=utf-8",
+			SourceFile:  "key_vault_secret_response.json",
+			Redacted:    []string{"s3cr3t-kv-value"},
+			Retained:    []string{"text/plain", "Production", "Purgeable"},
+		},
+		{
+			Name:        "SQL Server Request",
+			URL:         "https://management.azure.com/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example/providers/Microsoft.Sql/servers/example?api-version=2015-05-01-preview",
+			ContentType: "application/json; charset=utf-8",
+			SourceFile:  "sql_server_request.json",
+			Redacted:    []string{"s3cr3t-P@ssw0rd!"},
+			Retained:    []string{"mradministrator", "westeurope", "12.0"},
+		},
+		{
+			Name:        "Missing Content Type",
+			URL:         "https://management.azure.com/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example/providers/Microsoft.Sql/servers/example?api-version=2015-05-01-preview",
+			ContentType: "",
+			SourceFile:  "sql_server_request.json",
+			Redacted:    []string{"s3cr3t-P@ssw0rd!"},
+			Retained:    []string{"mradministrator"},
+		},
+		{
+			Name:        "Generic List Response",
+			URL:         "https://management.azure.com/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups?api-version=2018-05-01",
+			ContentType: "application/json; charset=utf-8",
+			SourceFile:  "resource_group_list_response.json",
+			Retained:    []string{"example", "westeurope", "Succeeded"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			input, err := ioutil.ReadFile(filepath.Join("testdata", tc.SourceFile))
+			if err != nil {
+				t.Fatalf("Error loading %q: %+v", tc.SourceFile, err)
+			}
+
+			u, err := url.Parse(tc.URL)
+			if err != nil {
+				t.Fatalf("Error parsing URL %q: %+v", tc.URL, err)
+			}
+
+			output := string(Body(u, tc.ContentType, input))
+
+			for _, v := range tc.Redacted {
+				if strings.Contains(output, v) {
+					t.Fatalf("Expected %q to be redacted but got: %s", v, output)
+				}
+			}
+
+			for _, v := range tc.Retained {
+				if !strings.Contains(output, v) {
+					t.Fatalf("Expected %q to be retained but got: %s", v, output)
+				}
+			}
+
+			if len(tc.Redacted) > 0 && !strings.Contains(output, RedactedValue) {
+				t.Fatalf("Expected the output to contain %q but got: %s", RedactedValue, output)
+			}
+
+			if len(tc.Redacted) == 0 && output != string(input) {
+				t.Fatalf("Expected the body to be unchanged but got: %s", output)
+			}
+		})
+	}
+}
+
+func TestHeaders(t *testing.T) {
+	input := http.Header{}
+	input.Set("Authorization", "Bearer s3cr3t-access-token")
+	input.Set("x-ms-authorization-auxiliary", "Bearer s3cr3t-auxiliary-token")
+	input.Set("Content-Type", "application/json")
+
+	output := Headers(input)
+
+	if v := output.Get("Authorization"); v != RedactedValue {
+		t.Fatalf("Expected the Authorization header to be redacted but got %q", v)
+	}
+
+	if v := output.Get("x-ms-authorization-auxiliary"); v != RedactedValue {
+		t.Fatalf("Expected the Auxiliary Authorization header to be redacted but got %q", v)
+	}
+
+	if v := output.Get("Content-Type"); v != "application/json" {
+		t.Fatalf("Expected the Content-Type header to be retained but got %q", v)
+	}
+
+	if v := input.Get("Authorization"); v != "Bearer s3cr3t-access-token" {
+		t.Fatalf("Expected the original headers to be unchanged but got %q", v)
+	}
+}
+
+func TestURL(t *testing.T) {
+	u, err := url.Parse("https://example.blob.core.windows.net/vhds/example.vhd?sv=2017-04-17&sr=b&sig=s3cr3t-signature&se=2018-10-11T00%3A00%3A00Z")
+	if err != nil {
+		t.Fatalf("Error parsing URL: %+v", err)
+	}
+
+	output := URL(u)
+	if strings.Contains(output, "s3cr3t-signature") {
+		t.Fatalf("Expected the signature to be redacted but got %q", output)
+	}
+
+	if !strings.Contains(output, "sv=2017-04-17") {
+		t.Fatalf("Expected the remaining query string to be retained but got %q", output)
+	}
+}
+
+func TestDumpRequest(t *testing.T) {
+	input, err := ioutil.ReadFile(filepath.Join("testdata", "sql_server_request.json"))
+	if err != nil {
+		t.Fatalf("Error loading the request: %+v", err)
+	}
+
+	r, err := http.NewRequest(http.MethodPut, "https://management.azure.com/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example/providers/Microsoft.Sql/servers/example?api-version=2015-05-01-preview", bytes.NewReader(input))
+	if err != nil {
+		t.Fatalf("Error building the request: %+v", err)
+	}
+	r.Header.Set("Authorization", "Bearer s3cr3t-access-token")
+	r.Header.Set("Content-Type", "application/json; charset=utf-8")
+
+	dump, err := DumpRequest(r)
+	if err != nil {
+		t.Fatalf("Error dumping the request: %+v", err)
+	}
+
+	for _, v := range []string{"s3cr3t-access-token", "s3cr3t-P@ssw0rd!"} {
+		if strings.Contains(string(dump), v) {
+			t.Fatalf("Expected %q to be redacted but got: %s", v, dump)
+		}
+	}
+
+	// the original request should be untouched, so that it can still be sent
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		t.Fatalf("Error reading the request body: %+v", err)
+	}
+	if !bytes.Equal(body, input) {
+		t.Fatalf("Expected the request body to be unchanged but got: %s", body)
+	}
+	if v := r.Header.Get("Authorization"); v != "Bearer s3cr3t-access-token" {
+		t.Fatalf("Expected the Authorization header to be unchanged but got %q", v)
+	}
+}
+
+func TestDumpResponse(t *testing.T) {
+	input, err := ioutil.ReadFile(filepath.Join("testdata", "storage_account_list_keys_response.json"))
+	if err != nil {
+		t.Fatalf("Error loading the response: %+v", err)
+	}
+
+	r, err := http.NewRequest(http.MethodPost, "https://management.azure.com/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example/providers/Microsoft.Storage/storageAccounts/example/listKeys?api-version=2017-10-01", nil)
+	if err != nil {
+		t.Fatalf("Error building the request: %+v", err)
+	}
+
+	resp := &http.Response{
+		Status:        "200 OK",
+		StatusCode:    http.StatusOK,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        http.Header{"Content-Type": []string{"application/json"}},
+		Body:          ioutil.NopCloser(bytes.NewReader(input)),
+		ContentLength: int64(len(input)),
+		Request:       r,
+	}
+
+	dump, err := DumpResponse(resp)
+	if err != nil {
+		t.Fatalf("Error dumping the response: %+v", err)
+	}
+
+	if strings.Contains(string(dump), "s3cr3tKey1") {
+		t.Fatalf("Expected the keys to be redacted but got: %s", dump)
+	}
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatalf("Error reading the response body: %+v", err)
+	}
+	if !bytes.Equal(body, input) {
+		t.Fatalf("Expected the response body to be unchanged but got: %s", body)
+	}
+}
+
+func TestUnredacted(t *testing.T) {
+	original := os.Getenv(UnredactedEnvVar)
+	defer os.Setenv(UnredactedEnvVar, original)
+
+	testCases := []struct {
+		Value    string
+		Expected bool
+	}{
+		{Value: "", Expected: false},
+		{Value: "false", Expected: false},
+		{Value: "invalid", Expected: false},
+		{Value: "true", Expected: true},
+		{Value: "1", Expected: true},
+	}
+
+	for _, tc := range testCases {
+		os.Setenv(UnredactedEnvVar, tc.Value)
+
+		if v := Unredacted(); v != tc.Expected {
+			t.Fatalf("Expected Unredacted to be %t for %q but got %t", tc.Expected, tc.Value, v)
+		}
+	}
+}
@@ -0,0 +1,123 @@
+package polling
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+// inProgressPollInterval is how often the Provisioning State is checked whilst waiting for an in-flight operation
+var inProgressPollInterval = 15 * time.Second
+
+// StartOrAttach starts a Long Running Operation by calling `start`.
+//
+// Where Azure returns a Conflict because another operation is already in progress for this resource (for example
+// one started by a previous run of Terraform which was interrupted, such as an update to a Virtual Machine Scale Set)
+// this waits for the in-flight operation to complete - using `provisioningState` to check the Provisioning State of
+// the resource - and then calls `start` again, rather than failing. As such where the request is built from the
+// current state of the resource (for example a Virtual Hub, which contains its Connections) it should be retrieved
+// within `start`, so that a retry includes any changes made by the in-flight operation.
+func StartOrAttach(ctx context.Context, start func() error, provisioningState func() (string, error)) error {
+	for {
+		err := start()
+		if err == nil || !wasConflict(err) {
+			return err
+		}
+
+		state, stateErr := provisioningState()
+		if stateErr != nil {
+			return fmt.Errorf("%+v\n\nError retrieving the Provisioning State to check for an in-flight operation: %+v", err, stateErr)
+		}
+
+		if !isInProgressState(state) {
+			// the conflict isn't due to an in-flight operation, so there's nothing to wait for
+			return err
+		}
+
+		log.Printf("[INFO] Another operation is in progress for this resource (Provisioning State %q) - waiting for it to complete before retrying", state)
+		for isInProgressState(state) {
+			select {
+			case <-ctx.Done():
+				return fmt.Errorf("Error waiting for the in-flight operation to complete (Provisioning State %q): %+v", state, ctx.Err())
+			case <-time.After(inProgressPollInterval):
+			}
+
+			state, stateErr = provisioningState()
+			if stateErr != nil {
+				return fmt.Errorf("Error retrieving the Provisioning State whilst waiting for the in-flight operation to complete: %+v", stateErr)
+			}
+			log.Printf("[DEBUG] Waiting for the in-flight operation to complete (Provisioning State %q)", state)
+		}
+
+		log.Printf("[INFO] The in-flight operation completed with the Provisioning State %q - retrying", state)
+	}
+}
+
+// wasConflict returns whether the error returned from the SDK was due to a Conflict (e.g. another operation being
+// in progress for this resource). Where the initial response of a Long Running Operation is an error the SDK discards
+// the response (and the Status Code isn't set when the SDK checks the error for Resource Provider registration) - as
+// such in these cases the error code is checked instead.
+func wasConflict(err error) bool {
+	v, ok := err.(autorest.DetailedError)
+	if !ok {
+		return false
+	}
+
+	switch original := v.Original.(type) {
+	case azure.RequestError:
+		return wasConflictRequestError(original)
+	case *azure.RequestError:
+		return wasConflictRequestError(*original)
+	case *azure.ServiceError:
+		if isConflictErrorCode(original.Code) {
+			return true
+		}
+	}
+
+	if isConflictStatusCode(v.StatusCode) {
+		return true
+	}
+
+	return v.Response != nil && v.Response.StatusCode == http.StatusConflict
+}
+
+func wasConflictRequestError(err azure.RequestError) bool {
+	if err.StatusCode != nil {
+		return isConflictStatusCode(err.StatusCode)
+	}
+
+	return err.ServiceError != nil && isConflictErrorCode(err.ServiceError.Code)
+}
+
+func isConflictStatusCode(statusCode interface{}) bool {
+	code, ok := statusCode.(int)
+	return ok && code == http.StatusConflict
+}
+
+// isConflictErrorCode returns whether the error code is one which Azure returns (with a Status Code of 409)
+// when another operation is in progress for the resource
+func isConflictErrorCode(code string) bool {
+	for _, v := range []string{"AnotherOperationInProgress", "Conflict", "OperationNotAllowed"} {
+		if strings.EqualFold(code, v) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isInProgressState returns whether the Provisioning State is a non-terminal state (such as `Creating` or `Updating`)
+func isInProgressState(state string) bool {
+	switch strings.ToLower(state) {
+	case "", "succeeded", "failed", "canceled":
+		return false
+	}
+
+	return true
+}
@@ -0,0 +1,150 @@
+package polling
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-04-01/network"
+)
+
+// sdkErrorsForStatusCode returns the errors returned from the SDK when Azure returns the specified Status Code and
+// error - for both Long Running Operations (with and without the SDK's Resource Provider registration) and requests
+func sdkErrorsForStatusCode(t *testing.T, statusCode int, code string) map[string]error {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		fmt.Fprintf(w, `{"error":{"code":%q,"message":"Another operation on this or dependent resource is in progress."}}`, code)
+	}))
+	defer server.Close()
+
+	client := network.NewVirtualHubsClientWithBaseURI(server.URL, "00000000-0000-0000-0000-000000000000")
+	client.RetryAttempts = 1
+	ctx := context.Background()
+	errors := make(map[string]error)
+
+	_, errors["Long Running Operation"] = client.CreateOrUpdate(ctx, "example-resources", "example-hub", network.VirtualHub{})
+	_, errors["Request"] = client.Get(ctx, "example-resources", "example-hub")
+
+	client.SkipResourceProviderRegistration = true
+	_, errors["Long Running Operation without Resource Provider registration"] = client.CreateOrUpdate(ctx, "example-resources", "example-hub", network.VirtualHub{})
+
+	for name, err := range errors {
+		if err == nil {
+			t.Fatalf("Expected an error for the %s but didn't get one", name)
+		}
+	}
+
+	return errors
+}
+
+func TestWasConflict(t *testing.T) {
+	testData := []struct {
+		Name       string
+		StatusCode int
+		Code       string
+		Expected   bool
+	}{
+		{
+			Name:       "Conflict",
+			StatusCode: http.StatusConflict,
+			Code:       "AnotherOperationInProgress",
+			Expected:   true,
+		},
+		{
+			Name:       "Bad Request",
+			StatusCode: http.StatusBadRequest,
+			Code:       "InvalidRequestFormat",
+			Expected:   false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Run(v.Name, func(t *testing.T) {
+			for name, err := range sdkErrorsForStatusCode(t, v.StatusCode, v.Code) {
+				if actual := wasConflict(err); actual != v.Expected {
+					t.Fatalf("Expected wasConflict to be %t for the %s but got %t: %+v", v.Expected, name, actual, err)
+				}
+			}
+		})
+	}
+
+	if wasConflict(fmt.Errorf("conflict")) {
+		t.Fatalf("Expected an error which didn't come from the SDK not to be a Conflict")
+	}
+}
+
+func TestStartOrAttach(t *testing.T) {
+	defer func(interval time.Duration) {
+		inProgressPollInterval = interval
+	}(inProgressPollInterval)
+	inProgressPollInterval = time.Millisecond
+
+	conflict := sdkErrorsForStatusCode(t, http.StatusConflict, "Conflict")["Long Running Operation without Resource Provider registration"]
+
+	testData := []struct {
+		Name          string
+		StartErrors   []error
+		States        []string
+		ExpectedCalls int
+		ExpectError   bool
+	}{
+		{
+			Name:          "Started",
+			StartErrors:   []error{nil},
+			ExpectedCalls: 1,
+		},
+		{
+			Name:          "Not a Conflict",
+			StartErrors:   []error{fmt.Errorf("bad request")},
+			ExpectedCalls: 1,
+			ExpectError:   true,
+		},
+		{
+			Name:          "Conflict without an in-flight operation",
+			StartErrors:   []error{conflict},
+			States:        []string{"Succeeded"},
+			ExpectedCalls: 1,
+			ExpectError:   true,
+		},
+		{
+			Name:          "Conflict with an in-flight operation",
+			StartErrors:   []error{conflict, nil},
+			States:        []string{"Updating", "Updating", "Succeeded"},
+			ExpectedCalls: 2,
+		},
+	}
+
+	for _, v := range testData {
+		t.Run(v.Name, func(t *testing.T) {
+			calls := 0
+			start := func() error {
+				err := v.StartErrors[calls]
+				calls++
+				return err
+			}
+
+			states := 0
+			provisioningState := func() (string, error) {
+				state := v.States[states]
+				states++
+				return state, nil
+			}
+
+			err := StartOrAttach(context.Background(), start, provisioningState)
+			if v.ExpectError && err == nil {
+				t.Fatalf("Expected an error but didn't get one")
+			}
+			if !v.ExpectError && err != nil {
+				t.Fatalf("Expected no error but got: %+v", err)
+			}
+
+			if calls != v.ExpectedCalls {
+				t.Fatalf("Expected %d calls to start but got %d", v.ExpectedCalls, calls)
+			}
+		})
+	}
+}
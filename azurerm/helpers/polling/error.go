@@ -0,0 +1,84 @@
+package polling
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+// OperationError is returned when a Long Running Operation fails, is cancelled or doesn't complete in time
+type OperationError struct {
+	// OperationID is the ID of the ARM Operation, parsed from the polling URL
+	OperationID string
+
+	// PollingURL is the `Azure-AsyncOperation` or `Location` URL used to check the status of the operation
+	PollingURL string
+
+	// Status is the last known status of the operation, such as `Failed`, `Canceled` or `InProgress`
+	Status string
+
+	// ServiceError contains the error details returned from Azure, where available
+	ServiceError *azure.ServiceError
+
+	// Err is the underlying error
+	Err error
+}
+
+func (e OperationError) Error() string {
+	message := fmt.Sprintf("Long Running Operation %q (Status %q)", e.OperationID, e.Status)
+
+	if e.ServiceError != nil {
+		return fmt.Sprintf("%s failed: %s", message, e.ServiceError.Error())
+	}
+
+	if e.Err != nil {
+		return fmt.Sprintf("%s failed: %+v", message, e.Err)
+	}
+
+	return fmt.Sprintf("%s failed", message)
+}
+
+func newOperationError(future *azure.Future, err error) OperationError {
+	pollingURL := future.PollingURL()
+	operationError := OperationError{
+		OperationID: parseOperationID(pollingURL),
+		PollingURL:  pollingURL,
+		Status:      future.Status(),
+		Err:         err,
+	}
+
+	switch v := err.(type) {
+	case *azure.ServiceError:
+		operationError.ServiceError = v
+	case azure.ServiceError:
+		operationError.ServiceError = &v
+	}
+
+	return operationError
+}
+
+// parseOperationID returns the ID of the ARM Operation from the `Azure-AsyncOperation` or `Location` URL, which are
+// in the format `/subscriptions/{id}/providers/{namespace}/locations/{location}/operations/{operationId}`
+// (or `operationResults` / `operationStatuses`) - falling back to the last segment of the path where this isn't found
+func parseOperationID(pollingURL string) string {
+	if pollingURL == "" {
+		return ""
+	}
+
+	path := pollingURL
+	if u, err := url.Parse(pollingURL); err == nil {
+		path = u.Path
+	}
+
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	for i := len(segments) - 2; i >= 0; i-- {
+		switch strings.ToLower(segments[i]) {
+		case "operations", "operationresults", "operationstatuses", "asyncoperations":
+			return segments[i+1]
+		}
+	}
+
+	return segments[len(segments)-1]
+}
@@ -0,0 +1,103 @@
+package polling
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+// progressLogInterval is how often the progress of a Long Running Operation is logged at the INFO level
+var progressLogInterval = time.Minute
+
+// WaitForCompletion waits for the Long Running Operation tracked by the Future to complete, logging its status
+// (from the `Azure-AsyncOperation` / `Location` URL) periodically.
+//
+// This honours the deadline on the context (e.g. the timeout for the resource), rather than only the client's
+// Polling Duration - and returns an OperationError (containing the ID of the ARM Operation and any error details
+// returned from Azure) when the operation fails, is cancelled or doesn't complete in time.
+func WaitForCompletion(ctx context.Context, future *azure.Future, client autorest.Client) error {
+	if client.PollingDuration > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, client.PollingDuration)
+		defer cancel()
+	}
+
+	operationID := parseOperationID(future.PollingURL())
+	started := time.Now()
+	lastLogged := started
+
+	attempts := 0
+	for {
+		done, err := future.Done(client)
+		if err != nil && (done || isFailedStatus(future.Status())) {
+			return newOperationError(future, err)
+		}
+
+		if err == nil && done {
+			log.Printf("[DEBUG] Long Running Operation %q completed with Status %q after %s", operationID, future.Status(), elapsedSince(started))
+			return nil
+		}
+
+		if operationID == "" {
+			// the polling URL isn't available until the tracker's been initialized for legacy futures
+			operationID = parseOperationID(future.PollingURL())
+		}
+
+		// we want delayAttempt to be zero in the non-error case so that the back-off isn't exponential
+		delayAttempt := 0
+		var delay time.Duration
+		if err == nil {
+			attempts = 0
+			delay = pollingDelay(future.Response(), client.PollingDelay)
+			log.Printf("[DEBUG] Long Running Operation %q has Status %q (polling %q)", operationID, future.Status(), future.PollingURL())
+		} else {
+			// there was an error polling for the status (e.g. a transient network error) - so back-off and retry
+			if attempts >= client.RetryAttempts {
+				return newOperationError(future, err)
+			}
+
+			log.Printf("[DEBUG] Error polling the status of Long Running Operation %q (attempt %d of %d): %+v", operationID, attempts+1, client.RetryAttempts, err)
+			delayAttempt = attempts
+			delay = client.RetryDuration
+			attempts++
+		}
+
+		if time.Since(lastLogged) >= progressLogInterval {
+			log.Printf("[INFO] Still waiting for Long Running Operation %q to complete (Status %q, elapsed %s)", operationID, future.Status(), elapsedSince(started))
+			lastLogged = time.Now()
+		}
+
+		if !autorest.DelayForBackoff(delay, delayAttempt, ctx.Done()) {
+			return newOperationError(future, ctx.Err())
+		}
+	}
+}
+
+// pollingDelay returns the delay specified in the `Retry-After` header of the response - falling back to the
+// default value where this isn't present or isn't a number of seconds (Future.GetPollingDelay panics in this case)
+func pollingDelay(resp *http.Response, defaultDelay time.Duration) time.Duration {
+	if resp == nil {
+		return defaultDelay
+	}
+
+	seconds, err := strconv.Atoi(strings.TrimSpace(resp.Header.Get(autorest.HeaderRetryAfter)))
+	if err != nil || seconds < 0 {
+		return defaultDelay
+	}
+
+	return time.Duration(seconds) * time.Second
+}
+
+func isFailedStatus(status string) bool {
+	return strings.EqualFold(status, "Failed") || strings.EqualFold(status, "Canceled")
+}
+
+func elapsedSince(t time.Time) time.Duration {
+	return time.Since(t).Round(time.Second)
+}
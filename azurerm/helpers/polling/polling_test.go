@@ -0,0 +1,192 @@
+package polling
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+)
+
+const testOperationPath = "/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.Compute/locations/westeurope/operations/11111111-2222-3333-4444-555555555555"
+
+// newTestFuture returns a Future for a PUT which polls the `Azure-AsyncOperation` URL on the test server, which
+// returns each of the responses in turn (repeating the last one)
+func newTestFuture(t *testing.T, responses ...string) (*azure.Future, autorest.Client, func()) {
+	polls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body := responses[len(responses)-1]
+		if polls < len(responses) {
+			body = responses[polls]
+		}
+		polls++
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Retry-After", "0")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, body)
+	}))
+
+	req, err := http.NewRequest(http.MethodPut, server.URL+"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Compute/virtualMachineScaleSets/vmss1", nil)
+	if err != nil {
+		t.Fatalf("Error building request: %+v", err)
+	}
+
+	resp := &http.Response{
+		Request:    req,
+		StatusCode: http.StatusCreated,
+		Header:     http.Header{},
+		Body:       http.NoBody,
+	}
+	resp.Header.Set("Azure-AsyncOperation", server.URL+testOperationPath+"?api-version=2018-06-01")
+
+	future, err := azure.NewFutureFromResponse(resp)
+	if err != nil {
+		t.Fatalf("Error building future: %+v", err)
+	}
+
+	client := autorest.Client{
+		PollingDelay:  time.Millisecond,
+		RetryAttempts: 1,
+	}
+
+	return &future, client, server.Close
+}
+
+func TestWaitForCompletion_Succeeded(t *testing.T) {
+	future, client, closer := newTestFuture(t, `{"status": "InProgress"}`, `{"status": "InProgress"}`, `{"status": "Succeeded"}`)
+	defer closer()
+
+	if err := WaitForCompletion(context.Background(), future, client); err != nil {
+		t.Fatalf("Expected no error but got: %+v", err)
+	}
+}
+
+func TestWaitForCompletion_Failed(t *testing.T) {
+	future, client, closer := newTestFuture(t, `{"status": "InProgress"}`, `{"status": "Failed", "error": {"code": "VMExtensionProvisioningError", "message": "The extension failed"}}`)
+	defer closer()
+
+	err := WaitForCompletion(context.Background(), future, client)
+	if err == nil {
+		t.Fatalf("Expected an error but didn't get one")
+	}
+
+	operationError, ok := err.(OperationError)
+	if !ok {
+		t.Fatalf("Expected an OperationError but got %T: %+v", err, err)
+	}
+
+	if operationError.OperationID != "11111111-2222-3333-4444-555555555555" {
+		t.Fatalf("Expected the Operation ID to be %q but got %q", "11111111-2222-3333-4444-555555555555", operationError.OperationID)
+	}
+
+	if operationError.Status != "Failed" {
+		t.Fatalf("Expected the Status to be %q but got %q", "Failed", operationError.Status)
+	}
+
+	if operationError.ServiceError == nil || operationError.ServiceError.Code != "VMExtensionProvisioningError" {
+		t.Fatalf("Expected the Service Error to have the code %q but got: %+v", "VMExtensionProvisioningError", operationError.ServiceError)
+	}
+}
+
+func TestWaitForCompletion_ContextExpired(t *testing.T) {
+	future, client, closer := newTestFuture(t, `{"status": "InProgress"}`)
+	defer closer()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	err := WaitForCompletion(ctx, future, client)
+	if err == nil {
+		t.Fatalf("Expected an error but didn't get one")
+	}
+
+	operationError, ok := err.(OperationError)
+	if !ok {
+		t.Fatalf("Expected an OperationError but got %T: %+v", err, err)
+	}
+
+	if operationError.Status != "InProgress" {
+		t.Fatalf("Expected the Status to be %q but got %q", "InProgress", operationError.Status)
+	}
+
+	if operationError.Err != context.DeadlineExceeded {
+		t.Fatalf("Expected the error to be %+v but got %+v", context.DeadlineExceeded, operationError.Err)
+	}
+}
+
+func TestPollingDelay(t *testing.T) {
+	testData := []struct {
+		Name       string
+		RetryAfter string
+		Expected   time.Duration
+	}{
+		{
+			Name:     "Not Specified",
+			Expected: time.Minute,
+		},
+		{
+			Name:       "Seconds",
+			RetryAfter: "15",
+			Expected:   15 * time.Second,
+		},
+		{
+			Name:       "HTTP Date",
+			RetryAfter: "Wed, 21 Oct 2015 07:28:00 GMT",
+			Expected:   time.Minute,
+		},
+	}
+
+	for _, v := range testData {
+		t.Run(v.Name, func(t *testing.T) {
+			resp := &http.Response{
+				Header: http.Header{},
+			}
+			if v.RetryAfter != "" {
+				resp.Header.Set("Retry-After", v.RetryAfter)
+			}
+
+			if actual := pollingDelay(resp, time.Minute); actual != v.Expected {
+				t.Fatalf("Expected %s but got %s", v.Expected, actual)
+			}
+		})
+	}
+}
+
+func TestParseOperationID(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected string
+	}{
+		{
+			Input:    "",
+			Expected: "",
+		},
+		{
+			Input:    "https://management.azure.com" + testOperationPath + "?api-version=2018-06-01",
+			Expected: "11111111-2222-3333-4444-555555555555",
+		},
+		{
+			Input:    "https://management.azure.com/subscriptions/00000000-0000-0000-0000-000000000000/providers/Microsoft.Network/locations/westeurope/operationResults/abc123?api-version=2018-04-01",
+			Expected: "abc123",
+		},
+		{
+			Input:    "https://management.azure.com/subscriptions/00000000-0000-0000-0000-000000000000/operationresults/def456",
+			Expected: "def456",
+		},
+		{
+			Input:    "https://example.com/status/ghi789",
+			Expected: "ghi789",
+		},
+	}
+
+	for _, v := range testData {
+		if actual := parseOperationID(v.Input); actual != v.Expected {
+			t.Fatalf("Expected %q for %q but got %q", v.Expected, v.Input, actual)
+		}
+	}
+}
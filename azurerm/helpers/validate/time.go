@@ -46,3 +46,25 @@ func RFC3339DateInFutureBy(d time.Duration) schema.SchemaValidateFunc {
 		return
 	}
 }
+
+// PositiveDuration validates the value is a duration which can be parsed by `time.ParseDuration` (e.g. `90s` or `5m`)
+// and is greater than zero
+func PositiveDuration(i interface{}, k string) (_ []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	d, err := time.ParseDuration(v)
+	if err != nil {
+		errors = append(errors, fmt.Errorf("%q has the invalid duration %q: %+v", k, v, err))
+		return
+	}
+
+	if d <= 0 {
+		errors = append(errors, fmt.Errorf("%q must be a positive duration, got %q", k, v))
+	}
+
+	return
+}
@@ -102,3 +102,49 @@ func TestRfc3339DateInFutureBy(t *testing.T) {
 		})
 	}
 }
+
+func TestPositiveDuration(t *testing.T) {
+	cases := []struct {
+		Duration string
+		Errors   int
+	}{
+		{
+			Duration: "",
+			Errors:   1,
+		},
+		{
+			Duration: "this is not a duration",
+			Errors:   1,
+		},
+		{
+			Duration: "60",
+			Errors:   1,
+		},
+		{
+			Duration: "-5m",
+			Errors:   1,
+		},
+		{
+			Duration: "0s",
+			Errors:   1,
+		},
+		{
+			Duration: "90s",
+			Errors:   0,
+		},
+		{
+			Duration: "1h30m",
+			Errors:   0,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Duration, func(t *testing.T) {
+			_, errors := PositiveDuration(tc.Duration, "test")
+
+			if len(errors) != tc.Errors {
+				t.Fatalf("Expected PositiveDuration to have %d not %d errors for %q", tc.Errors, len(errors), tc.Duration)
+			}
+		})
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-04-01/network"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 )
 
 func resourceGroupAndLBNameFromId(loadBalancerId string) (string, string, error) {
@@ -23,9 +24,8 @@ func resourceGroupAndLBNameFromId(loadBalancerId string) (string, string, error)
 	return resGroup, name, nil
 }
 
-func retrieveLoadBalancerById(loadBalancerId string, meta interface{}) (*network.LoadBalancer, bool, error) {
+func retrieveLoadBalancerById(ctx context.Context, loadBalancerId string, meta interface{}) (*network.LoadBalancer, bool, error) {
 	client := meta.(*ArmClient).loadBalancerClient
-	ctx := meta.(*ArmClient).StopContext
 
 	resGroup, name, err := resourceGroupAndLBNameFromId(loadBalancerId)
 	if err != nil {
@@ -147,22 +147,25 @@ func validateLoadBalancerPrivateIpAddressAllocation(v interface{}, k string) (ws
 }
 
 // sets the loadbalancer_id in the ResourceData from the sub resources full id
-func loadBalancerSubResourceStateImporter(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
-	r, err := regexp.Compile(`.+\/loadBalancers\/.+?\/`)
-	if err != nil {
-		return nil, err
-	}
+// loadBalancerSubResourceStateImporter returns a StateFunc which validates the ID being imported using the
+// specified StateFunc for the Sub Resource, and then sets the `loadbalancer_id` field from the ID
+func loadBalancerSubResourceStateImporter(importer schema.StateFunc) schema.StateFunc {
+	return func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+		if _, err := importer(d, meta); err != nil {
+			return nil, err
+		}
 
-	lbID := strings.TrimSuffix(r.FindString(d.Id()), "/")
-	parsed, err := parseAzureResourceID(lbID)
-	if err != nil {
-		return nil, fmt.Errorf("unable to parse loadbalancer id from %s", d.Id())
-	}
+		r, err := regexp.Compile(`(?i)^.+/loadBalancers/[^/]+`)
+		if err != nil {
+			return nil, err
+		}
 
-	if parsed.Path["loadBalancers"] == "" {
-		return nil, fmt.Errorf("parsed ID is invalid")
-	}
+		parsed, err := azure.ParseLoadBalancerID(r.FindString(d.Id()))
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse loadbalancer id from %s: %+v", d.Id(), err)
+		}
 
-	d.Set("loadbalancer_id", lbID)
-	return []*schema.ResourceData{d}, nil
+		d.Set("loadbalancer_id", parsed.String())
+		return []*schema.ResourceData{d}, nil
+	}
 }
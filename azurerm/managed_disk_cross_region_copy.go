@@ -0,0 +1,315 @@
+package azurerm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2018-06-01/compute"
+	mainStorage "github.com/Azure/azure-sdk-for-go/storage"
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/polling"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+// Azure only supports copying a Managed Disk or Snapshot within the same region - to copy one into another region
+// we instead grant temporary read access to the source, copy the underlying VHD into a Storage Account in the
+// target region and then import the Managed Disk or Snapshot from that VHD.
+
+const defaultDiskCopyStagingContainerName = "vhds"
+
+// diskCopyCleanupTimeout is how long revoking access to the source and removing the staging blob can take - these
+// run once the copy has finished or failed, by which point the context for the request may have been cancelled
+const diskCopyCleanupTimeout = 30 * time.Minute
+
+// azureRmDiskCopySource is the Managed Disk or Snapshot specified as the `source_resource_id`
+type azureRmDiskCopySource struct {
+	ResourceGroup string
+	Name          string
+	Location      string
+	IsSnapshot    bool
+}
+
+// getAzureRmDiskCopySource returns the Managed Disk or Snapshot which is being copied - or nil if the source isn't a
+// Managed Disk or Snapshot, in which case it's passed to Azure as-is
+func getAzureRmDiskCopySource(ctx context.Context, meta interface{}, sourceResourceId string) (*azureRmDiskCopySource, error) {
+	if id, err := azure.ParseManagedDiskID(sourceResourceId); err == nil {
+		client := meta.(*ArmClient).diskClient
+		disk, err := client.Get(ctx, id.ResourceGroup, id.Name)
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving source Managed Disk %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+		}
+
+		if disk.Location == nil {
+			return nil, fmt.Errorf("Error: `location` was nil for source Managed Disk %q (Resource Group %q)", id.Name, id.ResourceGroup)
+		}
+
+		return &azureRmDiskCopySource{
+			ResourceGroup: id.ResourceGroup,
+			Name:          id.Name,
+			Location:      azureRMNormalizeLocation(*disk.Location),
+		}, nil
+	}
+
+	if id, err := azure.ParseSnapshotID(sourceResourceId); err == nil {
+		client := meta.(*ArmClient).snapshotsClient
+		snapshot, err := client.Get(ctx, id.ResourceGroup, id.Name)
+		if err != nil {
+			return nil, fmt.Errorf("Error retrieving source Snapshot %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+		}
+
+		if snapshot.Location == nil {
+			return nil, fmt.Errorf("Error: `location` was nil for source Snapshot %q (Resource Group %q)", id.Name, id.ResourceGroup)
+		}
+
+		return &azureRmDiskCopySource{
+			ResourceGroup: id.ResourceGroup,
+			Name:          id.Name,
+			Location:      azureRMNormalizeLocation(*snapshot.Location),
+			IsSnapshot:    true,
+		}, nil
+	}
+
+	return nil, nil
+}
+
+// azureRmDiskCopyStagingBlobName returns a unique name for the staging blob, so that copies into the same Container
+// (for example of Managed Disks with the same name in different Resource Groups) don't overwrite one another
+func azureRmDiskCopyStagingBlobName(resourceGroup, name string) (string, error) {
+	suffix, err := uuid.GenerateUUID()
+	if err != nil {
+		return "", fmt.Errorf("Error generating the name of the staging Blob for %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	return fmt.Sprintf("%s-%s-%s.vhd", resourceGroup, name, suffix), nil
+}
+
+// expandAzureRmDiskCreationDataAcrossRegions copies the source VHD into the staging Storage Account, returning the
+// Creation Data which imports the copied VHD. `blobName` is the name of the staging blob, which is removed if the copy
+// fails - otherwise it should be removed once the Managed Disk or Snapshot has been created (or failed to be created)
+// using `cleanupAzureRmDiskCopyStagingBlob`.
+func expandAzureRmDiskCreationDataAcrossRegions(ctx context.Context, d *schema.ResourceData, meta interface{}, source azureRmDiskCopySource, location, blobName string) (*compute.CreationData, error) {
+	stagingStorageAccountId := d.Get("staging_storage_account_id").(string)
+	if stagingStorageAccountId == "" {
+		return nil, fmt.Errorf("Error: `staging_storage_account_id` must be specified when copying from %q into %q", source.Location, location)
+	}
+
+	storageAccountId, err := azure.ParseStorageAccountID(stagingStorageAccountId)
+	if err != nil {
+		return nil, err
+	}
+
+	account, err := meta.(*ArmClient).storageServiceClient.GetProperties(ctx, storageAccountId.ResourceGroup, storageAccountId.Name)
+	if err != nil {
+		return nil, fmt.Errorf("Error retrieving staging Storage Account %q (Resource Group %q): %+v", storageAccountId.Name, storageAccountId.ResourceGroup, err)
+	}
+	if account.Location == nil || azureRMNormalizeLocation(*account.Location) != location {
+		return nil, fmt.Errorf("Error: the staging Storage Account %q (Resource Group %q) must be in %q", storageAccountId.Name, storageAccountId.ResourceGroup, location)
+	}
+
+	blob, err := getAzureRmDiskCopyStagingBlob(ctx, meta, storageAccountId, d.Get("staging_storage_container_name").(string), blobName)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := blob.Container.CreateIfNotExists(&mainStorage.CreateContainerOptions{Access: mainStorage.ContainerAccessTypePrivate}); err != nil {
+		return nil, fmt.Errorf("Error creating staging Container %q (Storage Account %q): %+v", blob.Container.Name, storageAccountId.Name, err)
+	}
+
+	// the staging blob is never overwritten, since it may be in use by another copy
+	exists, err := blob.Exists()
+	if err != nil {
+		return nil, fmt.Errorf("Error checking for an existing staging Blob %q (Container %q / Storage Account %q): %+v", blob.Name, blob.Container.Name, storageAccountId.Name, err)
+	}
+	if exists {
+		return nil, fmt.Errorf("Error: the staging Blob %q already exists in Container %q (Storage Account %q)", blob.Name, blob.Container.Name, storageAccountId.Name)
+	}
+
+	timeout := d.Timeout(schema.TimeoutCreate)
+	sasUri, err := grantAzureRmDiskCopySourceAccess(ctx, meta, source, timeout)
+	if err != nil {
+		return nil, err
+	}
+
+	// the access is revoked regardless of whether the copy succeeds, since it's only required for the copy
+	defer func() {
+		revokeCtx, cancel := context.WithTimeout(context.Background(), diskCopyCleanupTimeout)
+		defer cancel()
+
+		if err := revokeAzureRmDiskCopySourceAccess(revokeCtx, meta, source); err != nil {
+			log.Printf("[WARN] %+v", err)
+		}
+	}()
+
+	log.Printf("[DEBUG] Copying %q into Blob %q (Container %q / Storage Account %q)..", source.Name, blob.Name, blob.Container.Name, storageAccountId.Name)
+	copyId, err := blob.StartCopy(sasUri, nil)
+	if err != nil {
+		return nil, fmt.Errorf("Error starting the copy of %q into Blob %q (Container %q / Storage Account %q): %+v", source.Name, blob.Name, blob.Container.Name, storageAccountId.Name, err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"pending"},
+		Target:     []string{"success"},
+		Refresh:    diskCopyStagingBlobStateRefreshFunc(blob),
+		Timeout:    timeout,
+		MinTimeout: 15 * time.Second,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		if abortErr := blob.AbortCopy(copyId, nil); abortErr != nil {
+			log.Printf("[DEBUG] Error aborting the copy of %q into Blob %q: %+v", source.Name, blob.Name, abortErr)
+		}
+		cleanupAzureRmDiskCopyStagingBlob(d, meta, blobName)
+
+		return nil, fmt.Errorf("Error waiting for the copy of %q into Blob %q (Container %q / Storage Account %q) to complete: %+v", source.Name, blob.Name, blob.Container.Name, storageAccountId.Name, err)
+	}
+
+	return &compute.CreationData{
+		CreateOption:     compute.Import,
+		SourceURI:        utils.String(blob.GetURL()),
+		StorageAccountID: utils.String(stagingStorageAccountId),
+	}, nil
+}
+
+// deleteAzureRmDiskCopyStagingBlob removes the VHD copied into the staging Storage Account, which is no longer
+// needed once the Managed Disk or Snapshot has been imported from it
+func deleteAzureRmDiskCopyStagingBlob(ctx context.Context, d *schema.ResourceData, meta interface{}, blobName string) error {
+	storageAccountId, err := azure.ParseStorageAccountID(d.Get("staging_storage_account_id").(string))
+	if err != nil {
+		return err
+	}
+
+	blob, err := getAzureRmDiskCopyStagingBlob(ctx, meta, storageAccountId, d.Get("staging_storage_container_name").(string), blobName)
+	if err != nil {
+		return err
+	}
+
+	if _, err := blob.DeleteIfExists(nil); err != nil {
+		return fmt.Errorf("Error deleting staging Blob %q (Container %q / Storage Account %q): %+v", blob.Name, blob.Container.Name, storageAccountId.Name, err)
+	}
+
+	return nil
+}
+
+// cleanupAzureRmDiskCopyStagingBlob removes the staging blob using a separate context, so that it's also removed when
+// the context for the request has been cancelled or has timed out
+func cleanupAzureRmDiskCopyStagingBlob(d *schema.ResourceData, meta interface{}, blobName string) {
+	ctx, cancel := context.WithTimeout(context.Background(), diskCopyCleanupTimeout)
+	defer cancel()
+
+	if err := deleteAzureRmDiskCopyStagingBlob(ctx, d, meta, blobName); err != nil {
+		log.Printf("[WARN] %+v", err)
+	}
+}
+
+// azureRmDiskWasCopiedAcrossRegions returns whether the Managed Disk or Snapshot was imported from a staging VHD,
+// in which case the Creation Data returned from Azure doesn't match the configuration
+func azureRmDiskWasCopiedAcrossRegions(d *schema.ResourceData, creationData *compute.CreationData) bool {
+	if creationData == nil || creationData.CreateOption != compute.Import {
+		return false
+	}
+
+	return strings.EqualFold(d.Get("create_option").(string), string(compute.Copy)) && d.Get("staging_storage_account_id").(string) != ""
+}
+
+func getAzureRmDiskCopyStagingBlob(ctx context.Context, meta interface{}, storageAccountId *azure.StorageAccountID, containerName, blobName string) (*mainStorage.Blob, error) {
+	blobClient, accountExists, err := meta.(*ArmClient).getBlobStorageClientForStorageAccount(ctx, storageAccountId.ResourceGroup, storageAccountId.Name)
+	if err != nil {
+		return nil, err
+	}
+	if !accountExists {
+		return nil, fmt.Errorf("Error: staging Storage Account %q (Resource Group %q) was not found", storageAccountId.Name, storageAccountId.ResourceGroup)
+	}
+
+	return blobClient.GetContainerReference(containerName).GetBlobReference(blobName), nil
+}
+
+func grantAzureRmDiskCopySourceAccess(ctx context.Context, meta interface{}, source azureRmDiskCopySource, timeout time.Duration) (string, error) {
+	input := compute.GrantAccessData{
+		Access:            compute.Read,
+		DurationInSeconds: utils.Int32(int32(timeout.Seconds())),
+	}
+
+	var accessUri compute.AccessURI
+	if source.IsSnapshot {
+		client := meta.(*ArmClient).snapshotsClient
+		future, err := client.GrantAccess(ctx, source.ResourceGroup, source.Name, input)
+		if err != nil {
+			return "", fmt.Errorf("Error granting access to Snapshot %q (Resource Group %q): %+v", source.Name, source.ResourceGroup, err)
+		}
+		if err := polling.WaitForCompletion(ctx, &future.Future, client.Client); err != nil {
+			return "", fmt.Errorf("Error waiting for access to be granted to Snapshot %q (Resource Group %q): %+v", source.Name, source.ResourceGroup, err)
+		}
+		if accessUri, err = future.Result(client); err != nil {
+			return "", fmt.Errorf("Error retrieving the Access URI for Snapshot %q (Resource Group %q): %+v", source.Name, source.ResourceGroup, err)
+		}
+	} else {
+		client := meta.(*ArmClient).diskClient
+		future, err := client.GrantAccess(ctx, source.ResourceGroup, source.Name, input)
+		if err != nil {
+			return "", fmt.Errorf("Error granting access to Managed Disk %q (Resource Group %q): %+v", source.Name, source.ResourceGroup, err)
+		}
+		if err := polling.WaitForCompletion(ctx, &future.Future, client.Client); err != nil {
+			return "", fmt.Errorf("Error waiting for access to be granted to Managed Disk %q (Resource Group %q): %+v", source.Name, source.ResourceGroup, err)
+		}
+		if accessUri, err = future.Result(client); err != nil {
+			return "", fmt.Errorf("Error retrieving the Access URI for Managed Disk %q (Resource Group %q): %+v", source.Name, source.ResourceGroup, err)
+		}
+	}
+
+	if accessUri.AccessSAS == nil {
+		return "", fmt.Errorf("Error: the Access URI for %q (Resource Group %q) was nil", source.Name, source.ResourceGroup)
+	}
+
+	return *accessUri.AccessSAS, nil
+}
+
+func revokeAzureRmDiskCopySourceAccess(ctx context.Context, meta interface{}, source azureRmDiskCopySource) error {
+	if source.IsSnapshot {
+		client := meta.(*ArmClient).snapshotsClient
+		future, err := client.RevokeAccess(ctx, source.ResourceGroup, source.Name)
+		if err != nil {
+			return fmt.Errorf("Error revoking access to Snapshot %q (Resource Group %q): %+v", source.Name, source.ResourceGroup, err)
+		}
+		if err := polling.WaitForCompletion(ctx, &future.Future, client.Client); err != nil {
+			return fmt.Errorf("Error waiting for access to be revoked for Snapshot %q (Resource Group %q): %+v", source.Name, source.ResourceGroup, err)
+		}
+
+		return nil
+	}
+
+	client := meta.(*ArmClient).diskClient
+	future, err := client.RevokeAccess(ctx, source.ResourceGroup, source.Name)
+	if err != nil {
+		return fmt.Errorf("Error revoking access to Managed Disk %q (Resource Group %q): %+v", source.Name, source.ResourceGroup, err)
+	}
+	if err := polling.WaitForCompletion(ctx, &future.Future, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for access to be revoked for Managed Disk %q (Resource Group %q): %+v", source.Name, source.ResourceGroup, err)
+	}
+
+	return nil
+}
+
+func diskCopyStagingBlobStateRefreshFunc(blob *mainStorage.Blob) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		if err := blob.GetProperties(nil); err != nil {
+			return nil, "", fmt.Errorf("Error retrieving the properties of Blob %q: %+v", blob.Name, err)
+		}
+
+		status := strings.ToLower(blob.Properties.CopyStatus)
+		switch status {
+		case "pending":
+			log.Printf("[DEBUG] Copying into Blob %q: %s bytes copied", blob.Name, blob.Properties.CopyProgress)
+		case "success":
+			log.Printf("[DEBUG] Copy into Blob %q completed", blob.Name)
+		default:
+			return blob, status, fmt.Errorf("the copy into Blob %q finished with the status %q: %s", blob.Name, status, blob.Properties.CopyStatusDescription)
+		}
+
+		return blob, status, nil
+	}
+}
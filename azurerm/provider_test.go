@@ -125,12 +125,18 @@ func TestAccAzureRMResourceProviderRegistration(t *testing.T) {
 			"error: %s", err)
 	}
 
-	err = registerAzureResourceProvidersWithSubscription(ctx, providerList.Values(), client)
+	err = registerAzureResourceProvidersWithSubscription(ctx, providerList.Values(), []string{}, client)
 	if err != nil {
 		t.Fatalf("Error registering Resource Providers: %+v", err)
 	}
 
-	needingRegistration := determineAzureResourceProvidersToRegister(providerList.Values())
+	// since we wait for each Resource Provider to become Registered, none should be outstanding
+	providerList, err = client.List(ctx, nil, "")
+	if err != nil {
+		t.Fatalf("Unable to list provider registration status: %+v", err)
+	}
+
+	needingRegistration := determineAzureResourceProvidersToRegister(providerList.Values(), []string{})
 	if len(needingRegistration) > 0 {
 		t.Fatalf("'%d' Resource Providers are still Pending Registration: %s", len(needingRegistration), spew.Sprint(needingRegistration))
 	}
@@ -2,14 +2,19 @@ package azurerm
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-04-01/network"
 	"github.com/hashicorp/terraform/helper/hashcode"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/polling"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -20,7 +25,16 @@ func resourceArmApplicationGateway() *schema.Resource {
 		Update: resourceArmApplicationGatewayCreateUpdate,
 		Delete: resourceArmApplicationGatewayDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: azure.ImportApplicationGatewayID,
+		},
+
+		CustomizeDiff: resourceArmApplicationGatewayCustomizeDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -43,6 +57,8 @@ func resourceArmApplicationGateway() *schema.Resource {
 				ForceNew: true,
 			},
 
+			"zones": zonesSchema(),
+
 			"sku": {
 				Type:     schema.TypeSet,
 				Required: true,
@@ -59,6 +75,8 @@ func resourceArmApplicationGateway() *schema.Resource {
 								string(network.StandardLarge),
 								string(network.WAFLarge),
 								string(network.WAFMedium),
+								string(network.StandardV2),
+								string(network.WAFV2),
 							}, true),
 						},
 
@@ -69,13 +87,36 @@ func resourceArmApplicationGateway() *schema.Resource {
 							ValidateFunc: validation.StringInSlice([]string{
 								string(network.ApplicationGatewayTierStandard),
 								string(network.ApplicationGatewayTierWAF),
+								string(network.ApplicationGatewayTierStandardV2),
+								string(network.ApplicationGatewayTierWAFV2),
 							}, true),
 						},
 
 						"capacity": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(1, 125),
+						},
+					},
+				},
+			},
+
+			"autoscale_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"min_capacity": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(0, 125),
+						},
+
+						"max_capacity": {
 							Type:         schema.TypeInt,
 							Required:     true,
-							ValidateFunc: validation.IntBetween(1, 10),
+							ValidateFunc: validation.IntBetween(2, 125),
 						},
 					},
 				},
@@ -148,8 +189,9 @@ func resourceArmApplicationGateway() *schema.Resource {
 						},
 
 						"subnet_id": {
-							Type:     schema.TypeString,
-							Required: true,
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: azure.ValidateSubnetID,
 						},
 					},
 				},
@@ -195,9 +237,10 @@ func resourceArmApplicationGateway() *schema.Resource {
 						},
 
 						"subnet_id": {
-							Type:     schema.TypeString,
-							Optional: true,
-							Computed: true,
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: azure.ValidateSubnetID,
 						},
 
 						"private_ip_address": {
@@ -207,9 +250,10 @@ func resourceArmApplicationGateway() *schema.Resource {
 						},
 
 						"public_ip_address_id": {
-							Type:     schema.TypeString,
-							Optional: true,
-							Computed: true,
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: azure.ValidatePublicIPAddressID,
 						},
 
 						"private_ip_address_allocation": {
@@ -554,6 +598,16 @@ func resourceArmApplicationGateway() *schema.Resource {
 							Type:     schema.TypeString,
 							Computed: true,
 						},
+
+						"redirect_configuration_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+
+						"redirect_configuration_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
 					},
 				},
 			},
@@ -575,7 +629,7 @@ func resourceArmApplicationGateway() *schema.Resource {
 
 						"default_backend_address_pool_name": {
 							Type:     schema.TypeString,
-							Required: true,
+							Optional: true,
 						},
 
 						"default_backend_address_pool_id": {
@@ -585,7 +639,7 @@ func resourceArmApplicationGateway() *schema.Resource {
 
 						"default_backend_http_settings_name": {
 							Type:     schema.TypeString,
-							Required: true,
+							Optional: true,
 						},
 
 						"default_backend_http_settings_id": {
@@ -593,6 +647,16 @@ func resourceArmApplicationGateway() *schema.Resource {
 							Computed: true,
 						},
 
+						"default_redirect_configuration_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+
+						"default_redirect_configuration_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
 						"path_rule": {
 							Type:     schema.TypeList,
 							Required: true,
@@ -618,7 +682,7 @@ func resourceArmApplicationGateway() *schema.Resource {
 
 									"backend_address_pool_name": {
 										Type:     schema.TypeString,
-										Required: true,
+										Optional: true,
 									},
 
 									"backend_address_pool_id": {
@@ -628,13 +692,23 @@ func resourceArmApplicationGateway() *schema.Resource {
 
 									"backend_http_settings_name": {
 										Type:     schema.TypeString,
-										Required: true,
+										Optional: true,
 									},
 
 									"backend_http_settings_id": {
 										Type:     schema.TypeString,
 										Computed: true,
 									},
+
+									"redirect_configuration_name": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+
+									"redirect_configuration_id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
 								},
 							},
 						},
@@ -642,6 +716,63 @@ func resourceArmApplicationGateway() *schema.Resource {
 				},
 			},
 
+			"redirect_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+
+						"redirect_type": {
+							Type:             schema.TypeString,
+							Required:         true,
+							DiffSuppressFunc: ignoreCaseDiffSuppressFunc,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(network.Permanent),
+								string(network.Temporary),
+								string(network.Found),
+								string(network.SeeOther),
+							}, true),
+						},
+
+						"target_listener_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+
+						"target_listener_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"target_url": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+
+						"include_path": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+
+						"include_query_string": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+					},
+				},
+			},
+
 			"authentication_certificate": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -709,7 +840,8 @@ func resourceArmApplicationGateway() *schema.Resource {
 func resourceArmApplicationGatewayCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	armClient := meta.(*ArmClient)
 	client := armClient.applicationGatewayClient
-	ctx := armClient.StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(armClient.StopContext, d)
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for AzureRM ApplicationGateway creation.")
 
@@ -735,6 +867,7 @@ func resourceArmApplicationGatewayCreateUpdate(d *schema.ResourceData, meta inte
 	properties.Probes = expandApplicationGatewayProbes(d)
 	properties.RequestRoutingRules = expandApplicationGatewayRequestRoutingRules(d, gatewayID)
 	properties.URLPathMaps = expandApplicationGatewayURLPathMaps(d, gatewayID)
+	properties.RedirectConfigurations = expandApplicationGatewayRedirectConfigurations(d, gatewayID)
 	properties.AuthenticationCertificates = expandApplicationGatewayAuthenticationCertificates(d)
 	properties.SslCertificates = expandApplicationGatewaySslCertificates(d)
 
@@ -742,10 +875,15 @@ func resourceArmApplicationGatewayCreateUpdate(d *schema.ResourceData, meta inte
 		properties.WebApplicationFirewallConfiguration = expandApplicationGatewayWafConfig(d)
 	}
 
+	if _, ok := d.GetOk("autoscale_configuration"); ok {
+		properties.AutoscaleConfiguration = expandApplicationGatewayAutoscaleConfiguration(d)
+	}
+
 	gateway := network.ApplicationGateway{
 		Name:     utils.String(name),
 		Location: utils.String(location),
-		Tags:     expandTags(tags),
+		Tags:     expandTags(tags, meta),
+		Zones:    expandZones(d.Get("zones").([]interface{})),
 		ApplicationGatewayPropertiesFormat: &properties,
 	}
 
@@ -754,7 +892,7 @@ func resourceArmApplicationGatewayCreateUpdate(d *schema.ResourceData, meta inte
 		return fmt.Errorf("Error Creating/Updating ApplicationGateway %q (Resource Group %q): %+v", name, resGroup, err)
 	}
 
-	err = future.WaitForCompletionRef(ctx, client.Client)
+	err = polling.WaitForCompletion(ctx, &future.Future, client.Client)
 	if err != nil {
 		return fmt.Errorf("Error Creating/Updating ApplicationGateway %q (Resource Group %q): %+v", name, resGroup, err)
 	}
@@ -773,12 +911,15 @@ func resourceArmApplicationGatewayCreateUpdate(d *schema.ResourceData, meta inte
 }
 
 func resourceArmApplicationGatewayRead(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
 		return fmt.Errorf("Error parsing ApplicationGateway ID: %+v", err)
 	}
 
-	applicationGateway, exists, err := retrieveApplicationGatewayById(d.Id(), meta)
+	applicationGateway, exists, err := retrieveApplicationGatewayById(ctx, d.Id(), meta)
 	if err != nil {
 		return fmt.Errorf("Error Getting ApplicationGateway By ID: %+v", err)
 	}
@@ -793,6 +934,7 @@ func resourceArmApplicationGatewayRead(d *schema.ResourceData, meta interface{})
 	if location := applicationGateway.Location; location != nil {
 		d.Set("location", azureRMNormalizeLocation(*location))
 	}
+	d.Set("zones", applicationGateway.Zones)
 
 	d.Set("sku", schema.NewSet(hashApplicationGatewaySku, flattenApplicationGatewaySku(applicationGateway.ApplicationGatewayPropertiesFormat.Sku)))
 	d.Set("disabled_ssl_protocols", flattenApplicationGatewaySslPolicy(applicationGateway.ApplicationGatewayPropertiesFormat.SslPolicy))
@@ -827,6 +969,16 @@ func resourceArmApplicationGatewayRead(d *schema.ResourceData, meta interface{})
 	}
 	d.Set("url_path_map", v4)
 
+	v5, err5 := flattenApplicationGatewayRedirectConfigurations(applicationGateway.ApplicationGatewayPropertiesFormat.RedirectConfigurations)
+	if err5 != nil {
+		return fmt.Errorf("error flattening RedirectConfigurations: %+v", err5)
+	}
+	d.Set("redirect_configuration", v5)
+
+	if err := d.Set("autoscale_configuration", flattenApplicationGatewayAutoscaleConfiguration(applicationGateway.ApplicationGatewayPropertiesFormat.AutoscaleConfiguration)); err != nil {
+		return fmt.Errorf("Error setting `autoscale_configuration`: %+v", err)
+	}
+
 	d.Set("authentication_certificate", schema.NewSet(hashApplicationGatewayAuthenticationCertificates, flattenApplicationGatewayAuthenticationCertificates(applicationGateway.ApplicationGatewayPropertiesFormat.AuthenticationCertificates)))
 	d.Set("ssl_certificate", schema.NewSet(hashApplicationGatewaySslCertificates, flattenApplicationGatewaySslCertificates(applicationGateway.ApplicationGatewayPropertiesFormat.SslCertificates)))
 
@@ -835,14 +987,15 @@ func resourceArmApplicationGatewayRead(d *schema.ResourceData, meta interface{})
 			flattenApplicationGatewayWafConfig(applicationGateway.ApplicationGatewayPropertiesFormat.WebApplicationFirewallConfiguration)))
 	}
 
-	flattenAndSetTags(d, applicationGateway.Tags)
+	flattenAndSetResourceTags(d, applicationGateway.Tags, meta)
 
 	return nil
 }
 
 func resourceArmApplicationGatewayDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).applicationGatewayClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
@@ -856,7 +1009,7 @@ func resourceArmApplicationGatewayDelete(d *schema.ResourceData, meta interface{
 		return fmt.Errorf("Error deleting for AppGateway %q (Resource Group %q): %+v", name, resGroup, err)
 	}
 
-	err = future.WaitForCompletionRef(ctx, client.Client)
+	err = polling.WaitForCompletion(ctx, &future.Future, client.Client)
 	if err != nil {
 		return fmt.Errorf("Error waiting for deletion of AppGateway %q (Resource Group %q): %+v", name, resGroup, err)
 	}
@@ -865,6 +1018,277 @@ func resourceArmApplicationGatewayDelete(d *schema.ResourceData, meta interface{
 	return nil
 }
 
+func resourceArmApplicationGatewayCustomizeDiff(diff *schema.ResourceDiff, v interface{}) error {
+	if err := validateApplicationGatewaySkuFeatures(diff); err != nil {
+		return err
+	}
+
+	return validateApplicationGatewayNameReferences(diff)
+}
+
+func validateApplicationGatewaySkuFeatures(diff *schema.ResourceDiff) error {
+	skus := diff.Get("sku").(*schema.Set).List()
+	if len(skus) == 0 || skus[0] == nil {
+		return nil
+	}
+
+	sku := skus[0].(map[string]interface{})
+	tier := sku["tier"].(string)
+	capacity := sku["capacity"].(int)
+
+	// the tier is empty when it's interpolated from another resource, in which case it's validated by the API
+	isV2 := strings.EqualFold(tier, string(network.ApplicationGatewayTierStandardV2)) || strings.EqualFold(tier, string(network.ApplicationGatewayTierWAFV2))
+	supportsV2Features := tier == "" || isV2
+
+	if zones := diff.Get("zones").([]interface{}); len(zones) > 0 && !supportsV2Features {
+		return fmt.Errorf("`zones` can only be specified for Application Gateways using the `Standard_v2` or `WAF_v2` tier")
+	}
+
+	// the v1 tiers support up to 32 instances, whereas the v2 tiers support up to 125
+	if capacity > 32 && !supportsV2Features {
+		return fmt.Errorf("`sku.capacity` can be at most 32 for Application Gateways using the `Standard` or `WAF` tier but got %d", capacity)
+	}
+
+	autoscaleConfigs := diff.Get("autoscale_configuration").([]interface{})
+	if len(autoscaleConfigs) == 0 || autoscaleConfigs[0] == nil {
+		return nil
+	}
+
+	if !supportsV2Features {
+		return fmt.Errorf("`autoscale_configuration` can only be specified for Application Gateways using the `Standard_v2` or `WAF_v2` tier")
+	}
+
+	if capacity > 0 {
+		return fmt.Errorf("`sku.capacity` cannot be specified when `autoscale_configuration` is specified")
+	}
+
+	autoscale := autoscaleConfigs[0].(map[string]interface{})
+	minCapacity := autoscale["min_capacity"].(int)
+	maxCapacity := autoscale["max_capacity"].(int)
+	if maxCapacity > 0 && minCapacity > maxCapacity {
+		return fmt.Errorf("`autoscale_configuration.0.min_capacity` (%d) cannot be greater than `autoscale_configuration.0.max_capacity` (%d)", minCapacity, maxCapacity)
+	}
+
+	return nil
+}
+
+type applicationGatewayNameReference struct {
+	field string
+	block string
+	name  string
+}
+
+// validateApplicationGatewayNameReferences ensures that the blocks within the Application Gateway which reference
+// each other by name refer to a block which exists, so that dangling references are caught at plan time rather than
+// part-way through an apply. Names which are interpolated from other resources aren't known at plan time (and are
+// returned as an empty string) - as such these are skipped and left for the API to validate.
+func validateApplicationGatewayNameReferences(diff *schema.ResourceDiff) error {
+	blocks := []string{
+		"authentication_certificate",
+		"backend_address_pool",
+		"backend_http_settings",
+		"frontend_ip_configuration",
+		"frontend_port",
+		"http_listener",
+		"probe",
+		"redirect_configuration",
+		"ssl_certificate",
+		"url_path_map",
+	}
+	names := make(map[string]map[string]bool, len(blocks))
+	for _, block := range blocks {
+		if blockNames, known := applicationGatewayBlockNames(diff, block); known {
+			names[block] = blockNames
+		}
+	}
+
+	references := make([]applicationGatewayNameReference, 0)
+
+	for i, raw := range diff.Get("backend_http_settings").([]interface{}) {
+		settings := raw.(map[string]interface{})
+		references = append(references, applicationGatewayNameReference{
+			field: fmt.Sprintf("backend_http_settings.%d.probe_name", i),
+			block: "probe",
+			name:  settings["probe_name"].(string),
+		})
+
+		for j, rawCert := range settings["authentication_certificate"].([]interface{}) {
+			cert := rawCert.(map[string]interface{})
+			references = append(references, applicationGatewayNameReference{
+				field: fmt.Sprintf("backend_http_settings.%d.authentication_certificate.%d.name", i, j),
+				block: "authentication_certificate",
+				name:  cert["name"].(string),
+			})
+		}
+	}
+
+	for i, raw := range diff.Get("http_listener").([]interface{}) {
+		listener := raw.(map[string]interface{})
+		references = append(references,
+			applicationGatewayNameReference{
+				field: fmt.Sprintf("http_listener.%d.frontend_ip_configuration_name", i),
+				block: "frontend_ip_configuration",
+				name:  listener["frontend_ip_configuration_name"].(string),
+			},
+			applicationGatewayNameReference{
+				field: fmt.Sprintf("http_listener.%d.frontend_port_name", i),
+				block: "frontend_port",
+				name:  listener["frontend_port_name"].(string),
+			},
+			applicationGatewayNameReference{
+				field: fmt.Sprintf("http_listener.%d.ssl_certificate_name", i),
+				block: "ssl_certificate",
+				name:  listener["ssl_certificate_name"].(string),
+			})
+	}
+
+	for i, raw := range diff.Get("request_routing_rule").([]interface{}) {
+		rule := raw.(map[string]interface{})
+		backendAddressPoolName := rule["backend_address_pool_name"].(string)
+		backendHTTPSettingsName := rule["backend_http_settings_name"].(string)
+		redirectConfigName := rule["redirect_configuration_name"].(string)
+
+		if redirectConfigName != "" && (backendAddressPoolName != "" || backendHTTPSettingsName != "") {
+			return fmt.Errorf("`request_routing_rule.%d` cannot specify both a `redirect_configuration_name` and a `backend_address_pool_name` / `backend_http_settings_name`", i)
+		}
+
+		references = append(references,
+			applicationGatewayNameReference{
+				field: fmt.Sprintf("request_routing_rule.%d.http_listener_name", i),
+				block: "http_listener",
+				name:  rule["http_listener_name"].(string),
+			},
+			applicationGatewayNameReference{
+				field: fmt.Sprintf("request_routing_rule.%d.backend_address_pool_name", i),
+				block: "backend_address_pool",
+				name:  backendAddressPoolName,
+			},
+			applicationGatewayNameReference{
+				field: fmt.Sprintf("request_routing_rule.%d.backend_http_settings_name", i),
+				block: "backend_http_settings",
+				name:  backendHTTPSettingsName,
+			},
+			applicationGatewayNameReference{
+				field: fmt.Sprintf("request_routing_rule.%d.url_path_map_name", i),
+				block: "url_path_map",
+				name:  rule["url_path_map_name"].(string),
+			},
+			applicationGatewayNameReference{
+				field: fmt.Sprintf("request_routing_rule.%d.redirect_configuration_name", i),
+				block: "redirect_configuration",
+				name:  redirectConfigName,
+			})
+	}
+
+	for i, raw := range diff.Get("url_path_map").([]interface{}) {
+		pathMap := raw.(map[string]interface{})
+		defaultBackendAddressPoolName := pathMap["default_backend_address_pool_name"].(string)
+		defaultBackendHTTPSettingsName := pathMap["default_backend_http_settings_name"].(string)
+		defaultRedirectConfigName := pathMap["default_redirect_configuration_name"].(string)
+
+		if defaultRedirectConfigName != "" && (defaultBackendAddressPoolName != "" || defaultBackendHTTPSettingsName != "") {
+			return fmt.Errorf("`url_path_map.%d` cannot specify both a `default_redirect_configuration_name` and a `default_backend_address_pool_name` / `default_backend_http_settings_name`", i)
+		}
+
+		references = append(references,
+			applicationGatewayNameReference{
+				field: fmt.Sprintf("url_path_map.%d.default_backend_address_pool_name", i),
+				block: "backend_address_pool",
+				name:  defaultBackendAddressPoolName,
+			},
+			applicationGatewayNameReference{
+				field: fmt.Sprintf("url_path_map.%d.default_backend_http_settings_name", i),
+				block: "backend_http_settings",
+				name:  defaultBackendHTTPSettingsName,
+			},
+			applicationGatewayNameReference{
+				field: fmt.Sprintf("url_path_map.%d.default_redirect_configuration_name", i),
+				block: "redirect_configuration",
+				name:  defaultRedirectConfigName,
+			})
+
+		for j, rawRule := range pathMap["path_rule"].([]interface{}) {
+			rule := rawRule.(map[string]interface{})
+			backendAddressPoolName := rule["backend_address_pool_name"].(string)
+			backendHTTPSettingsName := rule["backend_http_settings_name"].(string)
+			redirectConfigName := rule["redirect_configuration_name"].(string)
+
+			if redirectConfigName != "" && (backendAddressPoolName != "" || backendHTTPSettingsName != "") {
+				return fmt.Errorf("`url_path_map.%d.path_rule.%d` cannot specify both a `redirect_configuration_name` and a `backend_address_pool_name` / `backend_http_settings_name`", i, j)
+			}
+
+			references = append(references,
+				applicationGatewayNameReference{
+					field: fmt.Sprintf("url_path_map.%d.path_rule.%d.backend_address_pool_name", i, j),
+					block: "backend_address_pool",
+					name:  backendAddressPoolName,
+				},
+				applicationGatewayNameReference{
+					field: fmt.Sprintf("url_path_map.%d.path_rule.%d.backend_http_settings_name", i, j),
+					block: "backend_http_settings",
+					name:  backendHTTPSettingsName,
+				},
+				applicationGatewayNameReference{
+					field: fmt.Sprintf("url_path_map.%d.path_rule.%d.redirect_configuration_name", i, j),
+					block: "redirect_configuration",
+					name:  redirectConfigName,
+				})
+		}
+	}
+
+	for i, raw := range diff.Get("redirect_configuration").([]interface{}) {
+		redirect := raw.(map[string]interface{})
+		targetListenerName := redirect["target_listener_name"].(string)
+
+		if targetListenerName != "" && redirect["target_url"].(string) != "" {
+			return fmt.Errorf("`redirect_configuration.%d` cannot specify both a `target_listener_name` and a `target_url`", i)
+		}
+
+		references = append(references, applicationGatewayNameReference{
+			field: fmt.Sprintf("redirect_configuration.%d.target_listener_name", i),
+			block: "http_listener",
+			name:  targetListenerName,
+		})
+	}
+
+	for _, reference := range references {
+		if reference.name == "" {
+			continue
+		}
+
+		blockNames, known := names[reference.block]
+		if !known {
+			continue
+		}
+
+		if !blockNames[reference.name] {
+			return fmt.Errorf("`%s` references the `%s` %q which isn't defined within this Application Gateway", reference.field, reference.block, reference.name)
+		}
+	}
+
+	return nil
+}
+
+// applicationGatewayBlockNames returns the names of each of the specified blocks, and whether all of these are known
+func applicationGatewayBlockNames(diff *schema.ResourceDiff, block string) (map[string]bool, bool) {
+	names := make(map[string]bool)
+
+	for _, raw := range diff.Get(block).([]interface{}) {
+		if raw == nil {
+			return nil, false
+		}
+
+		name := raw.(map[string]interface{})["name"].(string)
+		if name == "" {
+			return nil, false
+		}
+
+		names[name] = true
+	}
+
+	return names, true
+}
+
 func ApplicationGatewayResGroupAndNameFromID(ApplicationGatewayID string) (string, string, error) {
 	id, err := parseAzureResourceID(ApplicationGatewayID)
 	if err != nil {
@@ -876,9 +1300,8 @@ func ApplicationGatewayResGroupAndNameFromID(ApplicationGatewayID string) (strin
 	return resGroup, name, nil
 }
 
-func retrieveApplicationGatewayById(applicationGatewayID string, meta interface{}) (*network.ApplicationGateway, bool, error) {
+func retrieveApplicationGatewayById(ctx context.Context, applicationGatewayID string, meta interface{}) (*network.ApplicationGateway, bool, error) {
 	client := meta.(*ArmClient).applicationGatewayClient
-	ctx := meta.(*ArmClient).StopContext
 
 	resGroup, name, err := ApplicationGatewayResGroupAndNameFromID(applicationGatewayID)
 	if err != nil {
@@ -902,12 +1325,32 @@ func expandApplicationGatewaySku(d *schema.ResourceData) *network.ApplicationGat
 
 	name := sku["name"].(string)
 	tier := sku["tier"].(string)
-	capacity := int32(sku["capacity"].(int))
 
-	return &network.ApplicationGatewaySku{
-		Name:     network.ApplicationGatewaySkuName(name),
-		Tier:     network.ApplicationGatewayTier(tier),
-		Capacity: &capacity,
+	output := network.ApplicationGatewaySku{
+		Name: network.ApplicationGatewaySkuName(name),
+		Tier: network.ApplicationGatewayTier(tier),
+	}
+
+	// the capacity is omitted when the Application Gateway is autoscaled
+	if capacity := int32(sku["capacity"].(int)); capacity > 0 {
+		output.Capacity = &capacity
+	}
+
+	return &output
+}
+
+func expandApplicationGatewayAutoscaleConfiguration(d *schema.ResourceData) *network.ApplicationGatewayAutoscaleConfiguration {
+	configs := d.Get("autoscale_configuration").([]interface{})
+	config := configs[0].(map[string]interface{})
+
+	minCapacity := int32(config["min_capacity"].(int))
+	maxCapacity := int32(config["max_capacity"].(int))
+
+	return &network.ApplicationGatewayAutoscaleConfiguration{
+		Bounds: &network.ApplicationGatewayAutoscaleBounds{
+			Min: &minCapacity,
+			Max: &maxCapacity,
+		},
 	}
 }
 
@@ -1257,6 +1700,13 @@ func expandApplicationGatewayRequestRoutingRules(d *schema.ResourceData, gateway
 			}
 		}
 
+		if redirectConfigName := data["redirect_configuration_name"].(string); redirectConfigName != "" {
+			redirectConfigID := fmt.Sprintf("%s/redirectConfigurations/%s", gatewayID, redirectConfigName)
+			rule.ApplicationGatewayRequestRoutingRulePropertiesFormat.RedirectConfiguration = &network.SubResource{
+				ID: &redirectConfigID,
+			}
+		}
+
 		rules = append(rules, rule)
 	}
 
@@ -1271,10 +1721,6 @@ func expandApplicationGatewayURLPathMaps(d *schema.ResourceData, gatewayID strin
 		data := configRaw.(map[string]interface{})
 
 		name := data["name"].(string)
-		defaultBackendAddressPoolName := data["default_backend_address_pool_name"].(string)
-		defaultBackendAddressPoolID := fmt.Sprintf("%s/backendAddressPools/%s", gatewayID, defaultBackendAddressPoolName)
-		defaultBackendHTTPSettingsName := data["default_backend_http_settings_name"].(string)
-		defaultBackendHTTPSettingsID := fmt.Sprintf("%s/backendHttpSettingsCollection/%s", gatewayID, defaultBackendHTTPSettingsName)
 
 		pathRules := []network.ApplicationGatewayPathRule{}
 		for _, ruleConfig := range data["path_rule"].([]interface{}) {
@@ -1308,28 +1754,88 @@ func expandApplicationGatewayURLPathMaps(d *schema.ResourceData, gatewayID strin
 				}
 			}
 
+			if redirectConfigName := ruleConfigMap["redirect_configuration_name"].(string); redirectConfigName != "" {
+				redirectConfigID := fmt.Sprintf("%s/redirectConfigurations/%s", gatewayID, redirectConfigName)
+				rule.ApplicationGatewayPathRulePropertiesFormat.RedirectConfiguration = &network.SubResource{
+					ID: &redirectConfigID,
+				}
+			}
+
 			pathRules = append(pathRules, rule)
 		}
 
 		pathMap := network.ApplicationGatewayURLPathMap{
 			Name: &name,
 			ApplicationGatewayURLPathMapPropertiesFormat: &network.ApplicationGatewayURLPathMapPropertiesFormat{
-				DefaultBackendAddressPool: &network.SubResource{
-					ID: &defaultBackendAddressPoolID,
-				},
-				DefaultBackendHTTPSettings: &network.SubResource{
-					ID: &defaultBackendHTTPSettingsID,
-				},
 				PathRules: &pathRules,
 			},
 		}
 
+		if defaultBackendAddressPoolName := data["default_backend_address_pool_name"].(string); defaultBackendAddressPoolName != "" {
+			defaultBackendAddressPoolID := fmt.Sprintf("%s/backendAddressPools/%s", gatewayID, defaultBackendAddressPoolName)
+			pathMap.ApplicationGatewayURLPathMapPropertiesFormat.DefaultBackendAddressPool = &network.SubResource{
+				ID: &defaultBackendAddressPoolID,
+			}
+		}
+
+		if defaultBackendHTTPSettingsName := data["default_backend_http_settings_name"].(string); defaultBackendHTTPSettingsName != "" {
+			defaultBackendHTTPSettingsID := fmt.Sprintf("%s/backendHttpSettingsCollection/%s", gatewayID, defaultBackendHTTPSettingsName)
+			pathMap.ApplicationGatewayURLPathMapPropertiesFormat.DefaultBackendHTTPSettings = &network.SubResource{
+				ID: &defaultBackendHTTPSettingsID,
+			}
+		}
+
+		if defaultRedirectConfigName := data["default_redirect_configuration_name"].(string); defaultRedirectConfigName != "" {
+			defaultRedirectConfigID := fmt.Sprintf("%s/redirectConfigurations/%s", gatewayID, defaultRedirectConfigName)
+			pathMap.ApplicationGatewayURLPathMapPropertiesFormat.DefaultRedirectConfiguration = &network.SubResource{
+				ID: &defaultRedirectConfigID,
+			}
+		}
+
 		pathMaps = append(pathMaps, pathMap)
 	}
 
 	return &pathMaps
 }
 
+func expandApplicationGatewayRedirectConfigurations(d *schema.ResourceData, gatewayID string) *[]network.ApplicationGatewayRedirectConfiguration {
+	configs := d.Get("redirect_configuration").([]interface{})
+	redirectConfigs := make([]network.ApplicationGatewayRedirectConfiguration, 0, len(configs))
+
+	for _, configRaw := range configs {
+		data := configRaw.(map[string]interface{})
+
+		name := data["name"].(string)
+		redirectType := data["redirect_type"].(string)
+		includePath := data["include_path"].(bool)
+		includeQueryString := data["include_query_string"].(bool)
+
+		redirectConfig := network.ApplicationGatewayRedirectConfiguration{
+			Name: &name,
+			ApplicationGatewayRedirectConfigurationPropertiesFormat: &network.ApplicationGatewayRedirectConfigurationPropertiesFormat{
+				RedirectType:       network.ApplicationGatewayRedirectType(redirectType),
+				IncludePath:        &includePath,
+				IncludeQueryString: &includeQueryString,
+			},
+		}
+
+		if targetListenerName := data["target_listener_name"].(string); targetListenerName != "" {
+			targetListenerID := fmt.Sprintf("%s/httpListeners/%s", gatewayID, targetListenerName)
+			redirectConfig.ApplicationGatewayRedirectConfigurationPropertiesFormat.TargetListener = &network.SubResource{
+				ID: &targetListenerID,
+			}
+		}
+
+		if targetURL := data["target_url"].(string); targetURL != "" {
+			redirectConfig.ApplicationGatewayRedirectConfigurationPropertiesFormat.TargetURL = &targetURL
+		}
+
+		redirectConfigs = append(redirectConfigs, redirectConfig)
+	}
+
+	return &redirectConfigs
+}
+
 func expandApplicationGatewayAuthenticationCertificates(d *schema.ResourceData) *[]network.ApplicationGatewayAuthenticationCertificate {
 	configs := d.Get("authentication_certificate").([]interface{})
 	authCerts := make([]network.ApplicationGatewayAuthenticationCertificate, 0, len(configs))
@@ -1389,7 +1895,30 @@ func flattenApplicationGatewaySku(sku *network.ApplicationGatewaySku) []interfac
 
 	result["name"] = string(sku.Name)
 	result["tier"] = string(sku.Tier)
-	result["capacity"] = int(*sku.Capacity)
+
+	capacity := 0
+	if sku.Capacity != nil {
+		capacity = int(*sku.Capacity)
+	}
+	result["capacity"] = capacity
+
+	return []interface{}{result}
+}
+
+func flattenApplicationGatewayAutoscaleConfiguration(input *network.ApplicationGatewayAutoscaleConfiguration) []interface{} {
+	if input == nil || input.Bounds == nil {
+		return []interface{}{}
+	}
+
+	result := make(map[string]interface{})
+
+	if min := input.Bounds.Min; min != nil {
+		result["min_capacity"] = int(*min)
+	}
+
+	if max := input.Bounds.Max; max != nil {
+		result["max_capacity"] = int(*max)
+	}
 
 	return []interface{}{result}
 }
@@ -1717,6 +2246,12 @@ func flattenApplicationGatewayRequestRoutingRules(input *[]network.ApplicationGa
 					listener["url_path_map_id"] = *pathMap.ID
 				}
 
+				if redirect := props.RedirectConfiguration; redirect != nil {
+					redirectConfigName := strings.Split(*redirect.ID, "/")[len(strings.Split(*redirect.ID, "/"))-1]
+					listener["redirect_configuration_name"] = redirectConfigName
+					listener["redirect_configuration_id"] = *redirect.ID
+				}
+
 				result = append(result, listener)
 			}
 		}
@@ -1748,6 +2283,12 @@ func flattenApplicationGatewayURLPathMaps(input *[]network.ApplicationGatewayURL
 					pathMap["default_backend_http_settings_id"] = *settings.ID
 				}
 
+				if redirect := props.DefaultRedirectConfiguration; redirect != nil {
+					redirectConfigName := strings.Split(*redirect.ID, "/")[len(strings.Split(*redirect.ID, "/"))-1]
+					pathMap["default_redirect_configuration_name"] = redirectConfigName
+					pathMap["default_redirect_configuration_id"] = *redirect.ID
+				}
+
 				pathRules := make([]interface{}, 0)
 				if rules := props.PathRules; rules != nil {
 					for _, pathRuleConfig := range *rules {
@@ -1769,6 +2310,12 @@ func flattenApplicationGatewayURLPathMaps(input *[]network.ApplicationGatewayURL
 								rule["backend_http_settings_id"] = *backend.ID
 							}
 
+							if redirect := ruleProps.RedirectConfiguration; redirect != nil {
+								redirectConfigName := strings.Split(*redirect.ID, "/")[len(strings.Split(*redirect.ID, "/"))-1]
+								rule["redirect_configuration_name"] = redirectConfigName
+								rule["redirect_configuration_id"] = *redirect.ID
+							}
+
 							pathOutputs := make([]interface{}, 0)
 							if paths := ruleProps.Paths; paths != nil {
 								for _, rulePath := range *paths {
@@ -1791,6 +2338,45 @@ func flattenApplicationGatewayURLPathMaps(input *[]network.ApplicationGatewayURL
 	return result, nil
 }
 
+func flattenApplicationGatewayRedirectConfigurations(input *[]network.ApplicationGatewayRedirectConfiguration) ([]interface{}, error) {
+	result := make([]interface{}, 0)
+
+	if configs := input; configs != nil {
+		for _, config := range *configs {
+			redirectConfig := map[string]interface{}{
+				"id":   *config.ID,
+				"name": *config.Name,
+			}
+
+			if props := config.ApplicationGatewayRedirectConfigurationPropertiesFormat; props != nil {
+				redirectConfig["redirect_type"] = string(props.RedirectType)
+
+				if listener := props.TargetListener; listener != nil {
+					targetListenerName := strings.Split(*listener.ID, "/")[len(strings.Split(*listener.ID, "/"))-1]
+					redirectConfig["target_listener_name"] = targetListenerName
+					redirectConfig["target_listener_id"] = *listener.ID
+				}
+
+				if url := props.TargetURL; url != nil {
+					redirectConfig["target_url"] = *url
+				}
+
+				if includePath := props.IncludePath; includePath != nil {
+					redirectConfig["include_path"] = *includePath
+				}
+
+				if includeQueryString := props.IncludeQueryString; includeQueryString != nil {
+					redirectConfig["include_query_string"] = *includeQueryString
+				}
+			}
+
+			result = append(result, redirectConfig)
+		}
+	}
+
+	return result, nil
+}
+
 func flattenApplicationGatewayAuthenticationCertificates(input *[]network.ApplicationGatewayAuthenticationCertificate) []interface{} {
 	result := make([]interface{}, 0)
 
@@ -3,6 +3,7 @@ package azurerm
 import (
 	"fmt"
 	"os"
+	"regexp"
 	"testing"
 
 	"log"
@@ -10,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/polling"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
@@ -60,7 +62,7 @@ func testSweepApplicationGateways(region string) error {
 			return err
 		}
 
-		err = future.WaitForCompletionRef(ctx, client.Client)
+		err = polling.WaitForCompletion(ctx, &future.Future, client.Client)
 		if err != nil {
 			if response.WasNotFound(future.Response()) {
 				continue
@@ -224,6 +226,100 @@ func TestAccAzureRMApplicationGateway_probeResponseMatch(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMApplicationGateway_redirectConfiguration(t *testing.T) {
+	resourceName := "azurerm_application_gateway.test"
+	ri := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMApplicationGatewayDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMApplicationGateway_redirectConfiguration(ri, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMApplicationGatewayExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "redirect_configuration.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "redirect_configuration.0.name", "redirect-https"),
+					resource.TestCheckResourceAttr(resourceName, "redirect_configuration.0.redirect_type", "Permanent"),
+					resource.TestCheckResourceAttr(resourceName, "redirect_configuration.0.target_listener_name", "listener-https"),
+					resource.TestCheckResourceAttr(resourceName, "redirect_configuration.0.include_path", "true"),
+					resource.TestCheckResourceAttr(resourceName, "redirect_configuration.0.include_query_string", "true"),
+					resource.TestCheckResourceAttr(resourceName, "redirect_configuration.1.target_url", "https://www.terraform.io"),
+					resource.TestCheckResourceAttr(resourceName, "request_routing_rule.0.redirect_configuration_name", "redirect-https"),
+					resource.TestCheckResourceAttrSet(resourceName, "request_routing_rule.0.redirect_configuration_id"),
+					resource.TestCheckResourceAttr(resourceName, "url_path_map.0.path_rule.0.redirect_configuration_name", "redirect-url"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMApplicationGateway_autoscaleConfigurationAndZones(t *testing.T) {
+	resourceName := "azurerm_application_gateway.test"
+	ri := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMApplicationGatewayDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMApplicationGateway_autoscaleConfiguration(ri, testLocation(), 2, 4),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMApplicationGatewayExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "zones.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "autoscale_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "autoscale_configuration.0.min_capacity", "2"),
+					resource.TestCheckResourceAttr(resourceName, "autoscale_configuration.0.max_capacity", "4"),
+				),
+			},
+			{
+				Config: testAccAzureRMApplicationGateway_autoscaleConfiguration(ri, testLocation(), 3, 6),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMApplicationGatewayExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "autoscale_configuration.0.min_capacity", "3"),
+					resource.TestCheckResourceAttr(resourceName, "autoscale_configuration.0.max_capacity", "6"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMApplicationGateway_danglingReference(t *testing.T) {
+	ri := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMApplicationGatewayDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAzureRMApplicationGateway_danglingReference(ri, testLocation()),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("`request_routing_rule.0.redirect_configuration_name` references the `redirect_configuration` \"redirect-missing\""),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMApplicationGateway_autoscaleConfigurationRequiresV2(t *testing.T) {
+	ri := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMApplicationGatewayDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAzureRMApplicationGateway_autoscaleConfigurationRequiresV2(ri, testLocation()),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("`autoscale_configuration` can only be specified for Application Gateways using the `Standard_v2` or `WAF_v2` tier"),
+			},
+		},
+	})
+}
+
 func testCheckAzureRMApplicationGatewayExists(name string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[name]
@@ -1565,3 +1661,369 @@ resource "azurerm_application_gateway" "test" {
 }
 `, rInt, location, rInt, rInt, rInt, rInt)
 }
+
+func testAccAzureRMApplicationGateway_template(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctest-vnet-%[1]d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  address_space       = ["10.254.0.0/16"]
+  location            = "${azurerm_resource_group.test.location}"
+}
+
+resource "azurerm_subnet" "test" {
+  name                 = "subnet-%[1]d"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+  virtual_network_name = "${azurerm_virtual_network.test.name}"
+  address_prefix       = "10.254.0.0/24"
+}
+`, rInt, location)
+}
+
+func testAccAzureRMApplicationGateway_redirectConfiguration(rInt int, location string) string {
+	template := testAccAzureRMApplicationGateway_template(rInt, location)
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_public_ip" "test" {
+  name                         = "acctest-pubip-%[2]d"
+  location                     = "${azurerm_resource_group.test.location}"
+  resource_group_name          = "${azurerm_resource_group.test.name}"
+  public_ip_address_allocation = "dynamic"
+}
+
+resource "azurerm_application_gateway" "test" {
+  name                = "acctestgw-%[2]d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  sku {
+    name     = "Standard_Small"
+    tier     = "Standard"
+    capacity = 1
+  }
+
+  gateway_ip_configuration {
+    name      = "gw-ip-config1"
+    subnet_id = "${azurerm_subnet.test.id}"
+  }
+
+  frontend_ip_configuration {
+    name                 = "ip-config-public"
+    public_ip_address_id = "${azurerm_public_ip.test.id}"
+  }
+
+  frontend_port {
+    name = "port-80"
+    port = 80
+  }
+
+  frontend_port {
+    name = "port-443"
+    port = 443
+  }
+
+  backend_address_pool {
+    name = "pool-1"
+
+    fqdn_list = [
+      "terraform.io",
+    ]
+  }
+
+  backend_http_settings {
+    name                  = "backend-http-1"
+    port                  = 80
+    protocol              = "Http"
+    cookie_based_affinity = "Disabled"
+    request_timeout       = 30
+  }
+
+  http_listener {
+    name                           = "listener-http"
+    frontend_ip_configuration_name = "ip-config-public"
+    frontend_port_name             = "port-80"
+    protocol                       = "Http"
+  }
+
+  http_listener {
+    name                           = "listener-https"
+    frontend_ip_configuration_name = "ip-config-public"
+    frontend_port_name             = "port-443"
+    protocol                       = "Https"
+    ssl_certificate_name           = "ssl-1"
+  }
+
+  redirect_configuration {
+    name                 = "redirect-https"
+    redirect_type        = "Permanent"
+    target_listener_name = "listener-https"
+    include_path         = true
+    include_query_string = true
+  }
+
+  redirect_configuration {
+    name          = "redirect-url"
+    redirect_type = "Temporary"
+    target_url    = "https://www.terraform.io"
+  }
+
+  url_path_map {
+    name                               = "path-map-1"
+    default_backend_address_pool_name  = "pool-1"
+    default_backend_http_settings_name = "backend-http-1"
+
+    path_rule {
+      name                        = "path-rule-1"
+      redirect_configuration_name = "redirect-url"
+
+      paths = [
+        "/docs/*",
+      ]
+    }
+  }
+
+  request_routing_rule {
+    name                        = "rule-redirect-1"
+    rule_type                   = "Basic"
+    http_listener_name          = "listener-http"
+    redirect_configuration_name = "redirect-https"
+  }
+
+  request_routing_rule {
+    name               = "rule-path-1"
+    rule_type          = "PathBasedRouting"
+    http_listener_name = "listener-https"
+    url_path_map_name  = "path-map-1"
+  }
+
+  ssl_certificate {
+    name     = "ssl-1"
+    data     = "${file("testdata/application_gateway_test.pfx")}"
+    password = "terraform"
+  }
+}
+`, template, rInt)
+}
+
+func testAccAzureRMApplicationGateway_autoscaleConfiguration(rInt int, location string, minCapacity int, maxCapacity int) string {
+	template := testAccAzureRMApplicationGateway_template(rInt, location)
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_public_ip" "test" {
+  name                         = "acctest-pubip-%[2]d"
+  location                     = "${azurerm_resource_group.test.location}"
+  resource_group_name          = "${azurerm_resource_group.test.name}"
+  public_ip_address_allocation = "static"
+  sku                          = "Standard"
+}
+
+resource "azurerm_application_gateway" "test" {
+  name                = "acctestgw-%[2]d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  zones               = ["1", "2"]
+
+  sku {
+    name = "Standard_v2"
+    tier = "Standard_v2"
+  }
+
+  autoscale_configuration {
+    min_capacity = %[3]d
+    max_capacity = %[4]d
+  }
+
+  gateway_ip_configuration {
+    name      = "gw-ip-config1"
+    subnet_id = "${azurerm_subnet.test.id}"
+  }
+
+  frontend_ip_configuration {
+    name                 = "ip-config-public"
+    public_ip_address_id = "${azurerm_public_ip.test.id}"
+  }
+
+  frontend_port {
+    name = "port-80"
+    port = 80
+  }
+
+  backend_address_pool {
+    name = "pool-1"
+
+    fqdn_list = [
+      "terraform.io",
+    ]
+  }
+
+  backend_http_settings {
+    name                  = "backend-http-1"
+    port                  = 80
+    protocol              = "Http"
+    cookie_based_affinity = "Disabled"
+    request_timeout       = 30
+  }
+
+  http_listener {
+    name                           = "listener-1"
+    frontend_ip_configuration_name = "ip-config-public"
+    frontend_port_name             = "port-80"
+    protocol                       = "Http"
+  }
+
+  request_routing_rule {
+    name                       = "rule-basic-1"
+    rule_type                  = "Basic"
+    http_listener_name         = "listener-1"
+    backend_address_pool_name  = "pool-1"
+    backend_http_settings_name = "backend-http-1"
+  }
+}
+`, template, rInt, minCapacity, maxCapacity)
+}
+
+func testAccAzureRMApplicationGateway_danglingReference(rInt int, location string) string {
+	template := testAccAzureRMApplicationGateway_template(rInt, location)
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_public_ip" "test" {
+  name                         = "acctest-pubip-%[2]d"
+  location                     = "${azurerm_resource_group.test.location}"
+  resource_group_name          = "${azurerm_resource_group.test.name}"
+  public_ip_address_allocation = "dynamic"
+}
+
+resource "azurerm_application_gateway" "test" {
+  name                = "acctestgw-%[2]d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  sku {
+    name     = "Standard_Small"
+    tier     = "Standard"
+    capacity = 1
+  }
+
+  gateway_ip_configuration {
+    name      = "gw-ip-config1"
+    subnet_id = "${azurerm_subnet.test.id}"
+  }
+
+  frontend_ip_configuration {
+    name                 = "ip-config-public"
+    public_ip_address_id = "${azurerm_public_ip.test.id}"
+  }
+
+  frontend_port {
+    name = "port-80"
+    port = 80
+  }
+
+  backend_address_pool {
+    name = "pool-1"
+  }
+
+  backend_http_settings {
+    name                  = "backend-http-1"
+    port                  = 80
+    protocol              = "Http"
+    cookie_based_affinity = "Disabled"
+    request_timeout       = 30
+  }
+
+  http_listener {
+    name                           = "listener-1"
+    frontend_ip_configuration_name = "ip-config-public"
+    frontend_port_name             = "port-80"
+    protocol                       = "Http"
+  }
+
+  request_routing_rule {
+    name                        = "rule-redirect-1"
+    rule_type                   = "Basic"
+    http_listener_name          = "listener-1"
+    redirect_configuration_name = "redirect-missing"
+  }
+}
+`, template, rInt)
+}
+
+func testAccAzureRMApplicationGateway_autoscaleConfigurationRequiresV2(rInt int, location string) string {
+	template := testAccAzureRMApplicationGateway_template(rInt, location)
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_public_ip" "test" {
+  name                         = "acctest-pubip-%[2]d"
+  location                     = "${azurerm_resource_group.test.location}"
+  resource_group_name          = "${azurerm_resource_group.test.name}"
+  public_ip_address_allocation = "dynamic"
+}
+
+resource "azurerm_application_gateway" "test" {
+  name                = "acctestgw-%[2]d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  sku {
+    name = "Standard_Small"
+    tier = "Standard"
+  }
+
+  autoscale_configuration {
+    min_capacity = 2
+    max_capacity = 4
+  }
+
+  gateway_ip_configuration {
+    name      = "gw-ip-config1"
+    subnet_id = "${azurerm_subnet.test.id}"
+  }
+
+  frontend_ip_configuration {
+    name                 = "ip-config-public"
+    public_ip_address_id = "${azurerm_public_ip.test.id}"
+  }
+
+  frontend_port {
+    name = "port-80"
+    port = 80
+  }
+
+  backend_address_pool {
+    name = "pool-1"
+  }
+
+  backend_http_settings {
+    name                  = "backend-http-1"
+    port                  = 80
+    protocol              = "Http"
+    cookie_based_affinity = "Disabled"
+    request_timeout       = 30
+  }
+
+  http_listener {
+    name                           = "listener-1"
+    frontend_ip_configuration_name = "ip-config-public"
+    frontend_port_name             = "port-80"
+    protocol                       = "Http"
+  }
+
+  request_routing_rule {
+    name                       = "rule-basic-1"
+    rule_type                  = "Basic"
+    http_listener_name         = "listener-1"
+    backend_address_pool_name  = "pool-1"
+    backend_http_settings_name = "backend-http-1"
+  }
+}
+`, template, rInt)
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/polling"
 )
 
 func TestAccAzureRMCdnEndpoint_basic(t *testing.T) {
@@ -275,7 +276,7 @@ func testCheckAzureRMCdnEndpointDisappears(name string) resource.TestCheckFunc {
 			return fmt.Errorf("Bad: Delete on cdnEndpointsClient: %+v", err)
 		}
 
-		err = future.WaitForCompletionRef(ctx, conn.Client)
+		err = polling.WaitForCompletion(ctx, &future.Future, conn.Client)
 		if err != nil {
 			return fmt.Errorf("Bad: Delete on cdnEndpointsClient: %+v", err)
 		}
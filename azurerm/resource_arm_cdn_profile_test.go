@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/polling"
 )
 
 func init() {
@@ -52,7 +53,7 @@ func testSweepCDNProfiles(region string) error {
 			return err
 		}
 
-		err = future.WaitForCompletionRef(ctx, client.Client)
+		err = polling.WaitForCompletion(ctx, &future.Future, client.Client)
 		if err != nil {
 			return err
 		}
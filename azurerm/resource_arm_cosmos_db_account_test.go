@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/polling"
 )
 
 func init() {
@@ -53,7 +54,7 @@ func testSweepCosmosDBAccount(region string) error {
 		if err != nil {
 			return err
 		}
-		err = future.WaitForCompletionRef(ctx, client.Client)
+		err = polling.WaitForCompletion(ctx, &future.Future, client.Client)
 		if err != nil {
 			return err
 		}
@@ -0,0 +1,220 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-04-01/network"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/polling"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+var ddosProtectionPlanResourceName = "azurerm_ddos_protection_plan"
+
+func resourceArmDdosProtectionPlan() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmDdosProtectionPlanCreateUpdate,
+		Read:   resourceArmDdosProtectionPlanRead,
+		Update: resourceArmDdosProtectionPlanCreateUpdate,
+		Delete: resourceArmDdosProtectionPlanDelete,
+		Importer: &schema.ResourceImporter{
+			State: azure.ImportDdosProtectionPlanID,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"location": locationSchema(),
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"virtual_network_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceArmDdosProtectionPlanCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).ddosProtectionPlanClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	location := azureRMNormalizeLocation(d.Get("location").(string))
+	tags := d.Get("tags").(map[string]interface{})
+
+	if d.IsNewResource() {
+		existing, err := client.Get(ctx, resourceGroup, name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("Error checking for the presence of an existing DDoS Protection Plan %q (Resource Group %q): %+v", name, resourceGroup, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return fmt.Errorf("A DDoS Protection Plan named %q already exists in Resource Group %q - to be managed via Terraform this resource needs to be imported into the State", name, resourceGroup)
+		}
+	}
+
+	azureRMLockByName(name, ddosProtectionPlanResourceName)
+	defer azureRMUnlockByName(name, ddosProtectionPlanResourceName)
+
+	plan := network.DdosProtectionPlan{
+		Location: utils.String(location),
+		Tags:     expandTags(tags, meta),
+	}
+	future, err := client.CreateOrUpdate(ctx, resourceGroup, name, plan)
+	if err != nil {
+		return fmt.Errorf("Error creating/updating DDoS Protection Plan %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	err = polling.WaitForCompletion(ctx, &future.Future, client.Client)
+	if err != nil {
+		return fmt.Errorf("Error waiting for the creation/update of DDoS Protection Plan %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	read, err := client.Get(ctx, resourceGroup, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving DDoS Protection Plan %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read DDoS Protection Plan %q (Resource Group %q) ID", name, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmDdosProtectionPlanRead(d, meta)
+}
+
+func resourceArmDdosProtectionPlanRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).ddosProtectionPlanClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := azure.ParseDdosProtectionPlanID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] DDoS Protection Plan %q was not found in Resource Group %q - removing from state!", id.Name, id.ResourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error making Read request on DDoS Protection Plan %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", id.ResourceGroup)
+	if location := resp.Location; location != nil {
+		d.Set("location", azureRMNormalizeLocation(*location))
+	}
+
+	virtualNetworkIds := make([]string, 0)
+	if props := resp.DdosProtectionPlanPropertiesFormat; props != nil {
+		virtualNetworkIds = flattenArmDdosProtectionPlanVirtualNetworkIds(props.VirtualNetworks)
+	}
+	if err := d.Set("virtual_network_ids", virtualNetworkIds); err != nil {
+		return fmt.Errorf("Error setting `virtual_network_ids`: %+v", err)
+	}
+
+	flattenAndSetResourceTags(d, resp.Tags, meta)
+
+	return nil
+}
+
+func resourceArmDdosProtectionPlanDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).ddosProtectionPlanClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := azure.ParseDdosProtectionPlanID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	azureRMLockByName(id.Name, ddosProtectionPlanResourceName)
+	defer azureRMUnlockByName(id.Name, ddosProtectionPlanResourceName)
+
+	// a DDoS Protection Plan can't be deleted whilst it's associated with a Virtual Network - rather than surfacing
+	// the API error we check for this first, so that we can tell the user which Virtual Networks need to be updated
+	existing, err := client.Get(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(existing.Response) {
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving DDoS Protection Plan %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+	}
+
+	if props := existing.DdosProtectionPlanPropertiesFormat; props != nil {
+		if virtualNetworkIds := flattenArmDdosProtectionPlanVirtualNetworkIds(props.VirtualNetworks); len(virtualNetworkIds) > 0 {
+			return fmt.Errorf("Error deleting DDoS Protection Plan %q (Resource Group %q): the plan is still associated with the Virtual Networks [%s] - the `ddos_protection_plan` block must be removed from these Virtual Networks (or they must be deleted) before the plan can be deleted", id.Name, id.ResourceGroup, strings.Join(virtualNetworkIds, ", "))
+		}
+	}
+
+	future, err := client.Delete(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		if response.WasNotFound(future.Response()) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting DDoS Protection Plan %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+	}
+
+	err = polling.WaitForCompletion(ctx, &future.Future, client.Client)
+	if err != nil {
+		if response.WasNotFound(future.Response()) {
+			return nil
+		}
+
+		return fmt.Errorf("Error waiting for the deletion of DDoS Protection Plan %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+	}
+
+	return nil
+}
+
+func flattenArmDdosProtectionPlanVirtualNetworkIds(input *[]network.SubResource) []string {
+	results := make([]string, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, virtualNetwork := range *input {
+		if id := virtualNetwork.ID; id != nil {
+			results = append(results, *id)
+		}
+	}
+
+	return results
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/polling"
 )
 
 func TestAccAzureRMFirewallNetworkRuleCollection_basic(t *testing.T) {
@@ -344,7 +345,7 @@ func testCheckAzureRMFirewallNetworkRuleCollectionDisappears(resourceName string
 			return fmt.Errorf("Error removing Network Rule Collection from Firewall: %+v", err)
 		}
 
-		err = future.WaitForCompletionRef(ctx, client.Client)
+		err = polling.WaitForCompletion(ctx, &future.Future, client.Client)
 		if err != nil {
 			return fmt.Errorf("Error waiting for the removal of Network Rule Collection from Firewall: %+v", err)
 		}
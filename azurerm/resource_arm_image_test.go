@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/polling"
 	"golang.org/x/crypto/ssh"
 )
 
@@ -174,7 +175,7 @@ func testGeneralizeVMImage(resourceGroup string, vmName string, userName string,
 			return fmt.Errorf("Bad: Deallocating error %+v", err)
 		}
 
-		err = future.WaitForCompletionRef(ctx, vmClient.Client)
+		err = polling.WaitForCompletion(ctx, &future.Future, vmClient.Client)
 		if err != nil {
 			return fmt.Errorf("Bad: Deallocating error %+v", err)
 		}
@@ -4,11 +4,15 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2018-06-01/compute"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/polling"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -16,10 +20,32 @@ func resourceArmManagedDisk() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceArmManagedDiskCreate,
 		Read:   resourceArmManagedDiskRead,
-		Update: resourceArmManagedDiskCreate,
+		Update: resourceArmManagedDiskUpdate,
 		Delete: resourceArmManagedDiskDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: azure.ImportManagedDiskID,
+		},
+
+		CustomizeDiff: func(diff *schema.ResourceDiff, v interface{}) error {
+			// Azure only supports growing a Managed Disk - rather than recreating the disk (and losing the data)
+			// we instead raise an error when the size is reduced
+			if diff.Id() == "" {
+				return nil
+			}
+
+			oldSize, newSize := diff.GetChange("disk_size_gb")
+			if newSize.(int) != 0 && newSize.(int) < oldSize.(int) {
+				return fmt.Errorf("`disk_size_gb` can only be increased - Managed Disks cannot be shrunk from %dGB to %dGB", oldSize.(int), newSize.(int))
+			}
+
+			return nil
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -71,6 +97,21 @@ func resourceArmManagedDisk() *schema.Resource {
 				ForceNew: true,
 			},
 
+			"staging_storage_account_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateStorageAccountID,
+			},
+
+			"staging_storage_container_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      defaultDiskCopyStagingContainerName,
+				ValidateFunc: validateArmStorageContainerName,
+			},
+
 			"image_reference_id": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -111,7 +152,8 @@ func validateDiskSizeGB(v interface{}, k string) (ws []string, errors []error) {
 
 func resourceArmManagedDiskCreate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).diskClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for Azure ARM Managed Disk creation.")
 
@@ -121,17 +163,10 @@ func resourceArmManagedDiskCreate(d *schema.ResourceData, meta interface{}) erro
 	storageAccountType := d.Get("storage_account_type").(string)
 	osType := d.Get("os_type").(string)
 	tags := d.Get("tags").(map[string]interface{})
-	expandedTags := expandTags(tags)
+	expandedTags := expandTags(tags, meta)
 	zones := expandZones(d.Get("zones").([]interface{}))
 
-	var skuName compute.DiskStorageAccountTypes
-	if strings.EqualFold(storageAccountType, string(compute.PremiumLRS)) {
-		skuName = compute.PremiumLRS
-	} else if strings.EqualFold(storageAccountType, string(compute.StandardLRS)) {
-		skuName = compute.StandardLRS
-	} else if strings.EqualFold(storageAccountType, string(compute.StandardSSDLRS)) {
-		skuName = compute.StandardSSDLRS
-	}
+	skuName := expandAzureRmManagedDiskStorageAccountType(storageAccountType)
 
 	createDisk := compute.Disk{
 		Name:     &name,
@@ -165,6 +200,27 @@ func resourceArmManagedDiskCreate(d *schema.ResourceData, meta interface{}) erro
 	} else if strings.EqualFold(createOption, string(compute.Copy)) {
 		if sourceResourceId := d.Get("source_resource_id").(string); sourceResourceId != "" {
 			createDisk.CreationData.SourceResourceID = &sourceResourceId
+
+			source, err := getAzureRmDiskCopySource(ctx, meta, sourceResourceId)
+			if err != nil {
+				return err
+			}
+
+			if source != nil && source.Location != location {
+				stagingBlobName, err := azureRmDiskCopyStagingBlobName(resGroup, name)
+				if err != nil {
+					return err
+				}
+
+				creationData, err := expandAzureRmDiskCreationDataAcrossRegions(ctx, d, meta, *source, location, stagingBlobName)
+				if err != nil {
+					return fmt.Errorf("Error copying %q into %q for Managed Disk %q (Resource Group %q): %+v", source.Name, location, name, resGroup, err)
+				}
+
+				createDisk.CreationData = creationData
+				// the staging blob is only needed until the import completes, regardless of whether it succeeds
+				defer cleanupAzureRmDiskCopyStagingBlob(d, meta, stagingBlobName)
+			}
 		} else {
 			return fmt.Errorf("[ERROR] source_resource_id must be specified when create_option is `%s`", compute.Copy)
 		}
@@ -189,7 +245,7 @@ func resourceArmManagedDiskCreate(d *schema.ResourceData, meta interface{}) erro
 		return err
 	}
 
-	err = future.WaitForCompletionRef(ctx, client.Client)
+	err = polling.WaitForCompletion(ctx, &future.Future, client.Client)
 	if err != nil {
 		return err
 	}
@@ -207,9 +263,116 @@ func resourceArmManagedDiskCreate(d *schema.ResourceData, meta interface{}) erro
 	return resourceArmManagedDiskRead(d, meta)
 }
 
+func resourceArmManagedDiskUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).diskClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := azure.ParseManagedDiskID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] preparing arguments for Azure ARM Managed Disk update.")
+
+	tags := d.Get("tags").(map[string]interface{})
+	diskUpdate := compute.DiskUpdate{
+		DiskUpdateProperties: &compute.DiskUpdateProperties{},
+		Tags:                 expandTags(tags, meta),
+	}
+
+	// resizing the disk or changing its SKU requires the disk to be detached from a running Virtual Machine
+	shutDownRequired := false
+
+	if d.HasChange("disk_size_gb") {
+		diskUpdate.DiskUpdateProperties.DiskSizeGB = utils.Int32(int32(d.Get("disk_size_gb").(int)))
+		shutDownRequired = true
+	}
+
+	if d.HasChange("storage_account_type") {
+		diskUpdate.Sku = &compute.DiskSku{
+			Name: expandAzureRmManagedDiskStorageAccountType(d.Get("storage_account_type").(string)),
+		}
+		shutDownRequired = true
+	}
+
+	if d.HasChange("os_type") {
+		diskUpdate.DiskUpdateProperties.OsType = compute.OperatingSystemTypes(d.Get("os_type").(string))
+	}
+
+	if d.HasChange("encryption_settings") {
+		if v, ok := d.GetOk("encryption_settings"); ok {
+			encryptionSettings := v.([]interface{})
+			settings := encryptionSettings[0].(map[string]interface{})
+			diskUpdate.DiskUpdateProperties.EncryptionSettings = expandManagedDiskEncryptionSettings(settings)
+		} else {
+			// omitting the Encryption Settings leaves the existing settings as-is, so these are explicitly disabled
+			diskUpdate.DiskUpdateProperties.EncryptionSettings = &compute.EncryptionSettings{
+				Enabled: utils.Bool(false),
+			}
+		}
+	}
+
+	disk, err := client.Get(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Managed Disk %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+	}
+
+	// if the disk is attached to a Virtual Machine which isn't deallocated (including one which is stopped, which
+	// remains allocated), it needs to be deallocated whilst the disk is updated
+	var virtualMachineId *azure.VirtualMachineID
+	if shutDownRequired && disk.ManagedBy != nil && *disk.ManagedBy != "" {
+		attachedTo, err := azure.ParseVirtualMachineID(*disk.ManagedBy)
+		if err != nil {
+			return fmt.Errorf("Error parsing the ID of the Virtual Machine which Managed Disk %q (Resource Group %q) is attached to: %+v", id.Name, id.ResourceGroup, err)
+		}
+
+		azureRMLockByName(attachedTo.Name, virtualMachineResourceName)
+		defer azureRMUnlockByName(attachedTo.Name, virtualMachineResourceName)
+
+		powerState, err := azureRmVirtualMachinePowerState(ctx, meta, attachedTo.ResourceGroup, attachedTo.Name)
+		if err != nil {
+			return err
+		}
+
+		if powerState != "deallocated" {
+			if err := deallocateAzureRmVirtualMachine(ctx, meta, attachedTo.ResourceGroup, attachedTo.Name); err != nil {
+				return err
+			}
+
+			// only a Virtual Machine which was running is started afterwards
+			if powerState == "running" || powerState == "starting" {
+				virtualMachineId = attachedTo
+			}
+		}
+	}
+
+	future, err := client.Update(ctx, id.ResourceGroup, id.Name, diskUpdate)
+	if err == nil {
+		err = polling.WaitForCompletion(ctx, &future.Future, client.Client)
+	}
+
+	// the Virtual Machine is started regardless of whether the update was successful, to avoid leaving it offline
+	if virtualMachineId != nil {
+		if startErr := startAzureRmVirtualMachine(ctx, meta, virtualMachineId.ResourceGroup, virtualMachineId.Name); startErr != nil {
+			if err != nil {
+				log.Printf("[DEBUG] Error updating Managed Disk %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+			}
+			return startErr
+		}
+	}
+
+	if err != nil {
+		return fmt.Errorf("Error updating Managed Disk %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+	}
+
+	return resourceArmManagedDiskRead(d, meta)
+}
+
 func resourceArmManagedDiskRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).diskClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
@@ -248,25 +411,31 @@ func resourceArmManagedDiskRead(d *schema.ResourceData, meta interface{}) error
 		}
 	}
 
-	if resp.CreationData != nil {
+	// where the Managed Disk was copied from another region it's imported from a staging VHD, which doesn't match the configuration
+	if resp.CreationData != nil && !azureRmDiskWasCopiedAcrossRegions(d, resp.CreationData) {
 		flattenAzureRmManagedDiskCreationData(d, resp.CreationData)
 	}
 
 	if settings := resp.EncryptionSettings; settings != nil {
-		flattened := flattenManagedDiskEncryptionSettings(settings)
-		if err := d.Set("encryption_settings", flattened); err != nil {
-			return fmt.Errorf("Error flattening encryption settings: %+v", err)
+		// once the `encryption_settings` block has been removed the disabled settings remain, which are equivalent to none
+		_, configured := d.GetOk("encryption_settings")
+		if configured || !managedDiskEncryptionSettingsAreDisabled(settings) {
+			flattened := flattenManagedDiskEncryptionSettings(settings)
+			if err := d.Set("encryption_settings", flattened); err != nil {
+				return fmt.Errorf("Error flattening encryption settings: %+v", err)
+			}
 		}
 	}
 
-	flattenAndSetTags(d, resp.Tags)
+	flattenAndSetResourceTags(d, resp.Tags, meta)
 
 	return nil
 }
 
 func resourceArmManagedDiskDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).diskClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
@@ -282,7 +451,7 @@ func resourceArmManagedDiskDelete(d *schema.ResourceData, meta interface{}) erro
 		}
 	}
 
-	err = future.WaitForCompletionRef(ctx, client.Client)
+	err = polling.WaitForCompletion(ctx, &future.Future, client.Client)
 	if err != nil {
 		if !response.WasNotFound(future.Response()) {
 			return err
@@ -292,6 +461,19 @@ func resourceArmManagedDiskDelete(d *schema.ResourceData, meta interface{}) erro
 	return nil
 }
 
+func expandAzureRmManagedDiskStorageAccountType(input string) compute.DiskStorageAccountTypes {
+	var skuName compute.DiskStorageAccountTypes
+	if strings.EqualFold(input, string(compute.PremiumLRS)) {
+		skuName = compute.PremiumLRS
+	} else if strings.EqualFold(input, string(compute.StandardLRS)) {
+		skuName = compute.StandardLRS
+	} else if strings.EqualFold(input, string(compute.StandardSSDLRS)) {
+		skuName = compute.StandardSSDLRS
+	}
+
+	return skuName
+}
+
 func flattenAzureRmManagedDiskCreationData(d *schema.ResourceData, creationData *compute.CreationData) {
 	d.Set("create_option", string(creationData.CreateOption))
 	if ref := creationData.ImageReference; ref != nil {
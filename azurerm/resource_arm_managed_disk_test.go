@@ -3,14 +3,37 @@ package azurerm
 import (
 	"fmt"
 	"net/http"
+	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2018-06-01/compute"
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/polling"
 )
 
+func TestAzureRMManagedDisk_copyStagingBlobName(t *testing.T) {
+	first, err := azureRmDiskCopyStagingBlobName("acctestRG", "acctestd")
+	if err != nil {
+		t.Fatalf("Error generating the staging Blob name: %+v", err)
+	}
+
+	second, err := azureRmDiskCopyStagingBlobName("acctestRG", "acctestd")
+	if err != nil {
+		t.Fatalf("Error generating the staging Blob name: %+v", err)
+	}
+
+	if first == second {
+		t.Fatalf("Expected the staging Blob names to be unique but both were %q", first)
+	}
+
+	if !strings.HasPrefix(first, "acctestRG-acctestd-") || !strings.HasSuffix(first, ".vhd") {
+		t.Fatalf("Expected the staging Blob name to contain the Resource Group and Name but got %q", first)
+	}
+}
+
 func TestAccAzureRMManagedDisk_empty(t *testing.T) {
 	var d compute.Disk
 	ri := acctest.RandInt()
@@ -100,6 +123,33 @@ func TestAccAzureRMManagedDisk_copy(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMManagedDisk_copyFromAnotherRegion(t *testing.T) {
+	resourceName := "azurerm_managed_disk.test"
+	var d compute.Disk
+	ri := acctest.RandInt()
+	rs := acctest.RandString(4)
+	config := testAccAzureRMManagedDisk_copyFromAnotherRegion(ri, rs, testLocation(), testAltLocation())
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMManagedDiskDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMManagedDiskExists(resourceName, &d, true),
+					resource.TestCheckResourceAttr(resourceName, "create_option", "Copy"),
+					resource.TestCheckResourceAttr(resourceName, "disk_size_gb", "1"),
+				),
+			},
+			{
+				Config:   config,
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
 func TestAccAzureRMManagedDisk_fromPlatformImage(t *testing.T) {
 	var d compute.Disk
 	ri := acctest.RandInt()
@@ -156,6 +206,63 @@ func TestAccAzureRMManagedDisk_update(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMManagedDisk_attachedDiskUpdate(t *testing.T) {
+	var d compute.Disk
+
+	resourceName := "azurerm_managed_disk.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMManagedDiskDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMManagedDisk_attached(ri, location, 10, "Standard_LRS"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMManagedDiskExists(resourceName, &d, true),
+					resource.TestCheckResourceAttr(resourceName, "disk_size_gb", "10"),
+					resource.TestCheckResourceAttr(resourceName, "storage_account_type", string(compute.StorageAccountTypesStandardLRS)),
+				),
+			},
+			{
+				Config: testAccAzureRMManagedDisk_attached(ri, location, 20, "Premium_LRS"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMManagedDiskExists(resourceName, &d, true),
+					resource.TestCheckResourceAttr(resourceName, "disk_size_gb", "20"),
+					resource.TestCheckResourceAttr(resourceName, "storage_account_type", string(compute.StorageAccountTypesPremiumLRS)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMManagedDisk_shrinkNotAllowed(t *testing.T) {
+	var d compute.Disk
+
+	resourceName := "azurerm_managed_disk.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMManagedDiskDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMManagedDisk_empty_updated(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMManagedDiskExists(resourceName, &d, true),
+					resource.TestCheckResourceAttr(resourceName, "disk_size_gb", "2"),
+				),
+			},
+			{
+				Config:      testAccAzureRMManagedDisk_empty(ri, location),
+				ExpectError: regexp.MustCompile("`disk_size_gb` can only be increased"),
+			},
+		},
+	})
+}
+
 func TestAccAzureRMManagedDisk_encryption(t *testing.T) {
 	var d compute.Disk
 
@@ -291,7 +398,7 @@ func testDeleteAzureRMVirtualMachine(name string) resource.TestCheckFunc {
 			return fmt.Errorf("Bad: Delete on vmClient: %+v", err)
 		}
 
-		err = future.WaitForCompletionRef(ctx, client.Client)
+		err = polling.WaitForCompletion(ctx, &future.Future, client.Client)
 		if err != nil {
 			return fmt.Errorf("Bad: Delete on vmClient: %+v", err)
 		}
@@ -427,6 +534,48 @@ resource "azurerm_managed_disk" "test" {
 `, rInt, location, rInt, rInt)
 }
 
+func testAccAzureRMManagedDisk_copyFromAnotherRegion(rInt int, rString string, location string, altLocation string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[3]s"
+}
+
+resource "azurerm_resource_group" "alt" {
+  name     = "acctestRG-alt-%[1]d"
+  location = "%[4]s"
+}
+
+resource "azurerm_managed_disk" "source" {
+  name                 = "acctestd1-%[1]d"
+  location             = "${azurerm_resource_group.test.location}"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+  storage_account_type = "Standard_LRS"
+  create_option        = "Empty"
+  disk_size_gb         = "1"
+}
+
+resource "azurerm_storage_account" "staging" {
+  name                     = "acctestsa%[2]s"
+  location                 = "${azurerm_resource_group.alt.location}"
+  resource_group_name      = "${azurerm_resource_group.alt.name}"
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_managed_disk" "test" {
+  name                       = "acctestd2-%[1]d"
+  location                   = "${azurerm_resource_group.alt.location}"
+  resource_group_name        = "${azurerm_resource_group.alt.name}"
+  storage_account_type       = "Standard_LRS"
+  create_option              = "Copy"
+  source_resource_id         = "${azurerm_managed_disk.source.id}"
+  staging_storage_account_id = "${azurerm_storage_account.staging.id}"
+  disk_size_gb               = "1"
+}
+`, rInt, rString, location, altLocation)
+}
+
 func testAccAzureRMManagedDisk_empty_updated(rInt int, location string) string {
 	return fmt.Sprintf(`
 resource "azurerm_resource_group" "test" {
@@ -608,3 +757,86 @@ resource "azurerm_managed_disk" "test" {
 }
 `, rInt, location, rString, rString, rString, rInt)
 }
+
+func testAccAzureRMManagedDisk_attached(rInt int, location string, diskSizeGB int, storageAccountType string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctvn-%[1]d"
+  address_space       = ["10.0.0.0/16"]
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+
+resource "azurerm_subnet" "test" {
+  name                 = "acctsub-%[1]d"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+  virtual_network_name = "${azurerm_virtual_network.test.name}"
+  address_prefix       = "10.0.2.0/24"
+}
+
+resource "azurerm_network_interface" "test" {
+  name                = "acctni-%[1]d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  ip_configuration {
+    name                          = "testconfiguration1"
+    subnet_id                     = "${azurerm_subnet.test.id}"
+    private_ip_address_allocation = "dynamic"
+  }
+}
+
+resource "azurerm_virtual_machine" "test" {
+  name                  = "acctvm-%[1]d"
+  location              = "${azurerm_resource_group.test.location}"
+  resource_group_name   = "${azurerm_resource_group.test.name}"
+  network_interface_ids = ["${azurerm_network_interface.test.id}"]
+  vm_size               = "Standard_F2s"
+
+  storage_image_reference {
+    publisher = "Canonical"
+    offer     = "UbuntuServer"
+    sku       = "16.04-LTS"
+    version   = "latest"
+  }
+
+  storage_os_disk {
+    name              = "myosdisk1"
+    caching           = "ReadWrite"
+    create_option     = "FromImage"
+    managed_disk_type = "Standard_LRS"
+  }
+
+  os_profile {
+    computer_name  = "hn%[1]d"
+    admin_username = "testadmin"
+    admin_password = "Password1234!"
+  }
+
+  os_profile_linux_config {
+    disable_password_authentication = false
+  }
+}
+
+resource "azurerm_managed_disk" "test" {
+  name                 = "acctestd-%[1]d"
+  location             = "${azurerm_resource_group.test.location}"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+  storage_account_type = "%[4]s"
+  create_option        = "Empty"
+  disk_size_gb         = %[3]d
+}
+
+resource "azurerm_virtual_machine_data_disk_attachment" "test" {
+  managed_disk_id    = "${azurerm_managed_disk.test.id}"
+  virtual_machine_id = "${azurerm_virtual_machine.test.id}"
+  lun                = "0"
+  caching            = "None"
+}
+`, rInt, location, diskSizeGB, storageAccountType)
+}
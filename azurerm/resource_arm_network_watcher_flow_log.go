@@ -0,0 +1,364 @@
+package azurerm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-04-01/network"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/polling"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmNetworkWatcherFlowLog() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmNetworkWatcherFlowLogCreateUpdate,
+		Read:   resourceArmNetworkWatcherFlowLogRead,
+		Update: resourceArmNetworkWatcherFlowLogCreateUpdate,
+		Delete: resourceArmNetworkWatcherFlowLogDelete,
+		Importer: &schema.ResourceImporter{
+			State: azure.ImportNetworkWatcherFlowLogID,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"network_watcher_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"network_security_group_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: ignoreCaseDiffSuppressFunc,
+				ValidateFunc:     azure.ValidateNetworkSecurityGroupID,
+			},
+
+			"storage_account_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				DiffSuppressFunc: ignoreCaseDiffSuppressFunc,
+				ValidateFunc:     azure.ValidateStorageAccountID,
+			},
+
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"retention_policy": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+
+						"days": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(0, 365),
+						},
+					},
+				},
+			},
+
+			"traffic_analytics": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+
+						"workspace_id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateUUID,
+						},
+
+						"workspace_region": {
+							Type:             schema.TypeString,
+							Required:         true,
+							StateFunc:        azureRMNormalizeLocation,
+							DiffSuppressFunc: azureRMSuppressLocationDiff,
+						},
+
+						"workspace_resource_id": {
+							Type:             schema.TypeString,
+							Required:         true,
+							DiffSuppressFunc: ignoreCaseDiffSuppressFunc,
+							ValidateFunc:     azure.ValidateLogAnalyticsWorkspaceID,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceArmNetworkWatcherFlowLogCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).watcherClient
+	subscriptionId := meta.(*ArmClient).subscriptionId
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	watcherName := d.Get("network_watcher_name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+
+	networkSecurityGroupId, err := azure.ParseNetworkSecurityGroupID(d.Get("network_security_group_id").(string))
+	if err != nil {
+		return err
+	}
+
+	// only a single operation can be performed against a Network Watcher at once
+	azureRMLockByName(watcherName, networkWatcherResourceName)
+	defer azureRMUnlockByName(watcherName, networkWatcherResourceName)
+
+	parameters := network.FlowLogInformation{
+		TargetResourceID: utils.String(networkSecurityGroupId.String()),
+		FlowLogProperties: &network.FlowLogProperties{
+			StorageID:       utils.String(d.Get("storage_account_id").(string)),
+			Enabled:         utils.Bool(d.Get("enabled").(bool)),
+			RetentionPolicy: expandAzureRmNetworkWatcherFlowLogRetentionPolicy(d.Get("retention_policy").([]interface{})),
+		},
+		FlowAnalyticsConfiguration: expandAzureRmNetworkWatcherFlowLogTrafficAnalytics(d.Get("traffic_analytics").([]interface{})),
+	}
+
+	log.Printf("[DEBUG] Configuring the Flow Log for Network Security Group %q (Network Watcher %q / Resource Group %q)..", networkSecurityGroupId.Name, watcherName, resourceGroup)
+	if err := setAzureRmNetworkWatcherFlowLog(ctx, client, resourceGroup, watcherName, parameters); err != nil {
+		return fmt.Errorf("Error configuring the Flow Log for Network Security Group %q (Network Watcher %q / Resource Group %q): %+v", networkSecurityGroupId.Name, watcherName, resourceGroup, err)
+	}
+
+	watcherId := azure.NewNetworkWatcherID(subscriptionId, resourceGroup, watcherName)
+	d.SetId(azure.NewNetworkWatcherFlowLogID(watcherId, *networkSecurityGroupId).String())
+
+	return resourceArmNetworkWatcherFlowLogRead(d, meta)
+}
+
+func resourceArmNetworkWatcherFlowLogRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).watcherClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := azure.ParseNetworkWatcherFlowLogID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroup := id.NetworkWatcher.ResourceGroup
+	watcherName := id.NetworkWatcher.Name
+	networkSecurityGroupName := id.NetworkSecurityGroup.Name
+
+	parameters := network.FlowLogStatusParameters{
+		TargetResourceID: utils.String(id.NetworkSecurityGroup.String()),
+	}
+	future, err := client.GetFlowLogStatus(ctx, resourceGroup, watcherName, parameters)
+	if err != nil {
+		if response.WasNotFound(future.Response()) {
+			log.Printf("[DEBUG] Network Watcher %q or Network Security Group %q was not found - removing Flow Log from state", watcherName, networkSecurityGroupName)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving the Flow Log for Network Security Group %q (Network Watcher %q / Resource Group %q): %+v", networkSecurityGroupName, watcherName, resourceGroup, err)
+	}
+
+	if err := polling.WaitForCompletion(ctx, &future.Future, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for the Flow Log for Network Security Group %q (Network Watcher %q / Resource Group %q) to be retrieved: %+v", networkSecurityGroupName, watcherName, resourceGroup, err)
+	}
+
+	flowLog, err := future.Result(client)
+	if err != nil {
+		return fmt.Errorf("Error retrieving the result of the Flow Log for Network Security Group %q (Network Watcher %q / Resource Group %q): %+v", networkSecurityGroupName, watcherName, resourceGroup, err)
+	}
+
+	d.Set("network_watcher_name", watcherName)
+	d.Set("resource_group_name", resourceGroup)
+	d.Set("network_security_group_id", id.NetworkSecurityGroup.String())
+
+	// Flow Logs can be disabled outside of Terraform (e.g. in the Portal) whilst retaining the rest of their
+	// configuration - so `enabled` is always set, which surfaces this as a diff
+	enabled := false
+	if props := flowLog.FlowLogProperties; props != nil {
+		if props.Enabled != nil {
+			enabled = *props.Enabled
+		}
+
+		d.Set("storage_account_id", props.StorageID)
+
+		if err := d.Set("retention_policy", flattenAzureRmNetworkWatcherFlowLogRetentionPolicy(props.RetentionPolicy)); err != nil {
+			return fmt.Errorf("Error setting `retention_policy`: %+v", err)
+		}
+	}
+	d.Set("enabled", enabled)
+
+	if err := d.Set("traffic_analytics", flattenAzureRmNetworkWatcherFlowLogTrafficAnalytics(flowLog.FlowAnalyticsConfiguration)); err != nil {
+		return fmt.Errorf("Error setting `traffic_analytics`: %+v", err)
+	}
+
+	return nil
+}
+
+func resourceArmNetworkWatcherFlowLogDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).watcherClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := azure.ParseNetworkWatcherFlowLogID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroup := id.NetworkWatcher.ResourceGroup
+	watcherName := id.NetworkWatcher.Name
+	networkSecurityGroupName := id.NetworkSecurityGroup.Name
+
+	azureRMLockByName(watcherName, networkWatcherResourceName)
+	defer azureRMUnlockByName(watcherName, networkWatcherResourceName)
+
+	// Flow Logs can't be deleted, instead they're disabled - which requires the existing Storage Account
+	parameters := network.FlowLogInformation{
+		TargetResourceID: utils.String(id.NetworkSecurityGroup.String()),
+		FlowLogProperties: &network.FlowLogProperties{
+			StorageID: utils.String(d.Get("storage_account_id").(string)),
+			Enabled:   utils.Bool(false),
+		},
+	}
+
+	if analytics := expandAzureRmNetworkWatcherFlowLogTrafficAnalytics(d.Get("traffic_analytics").([]interface{})); analytics != nil {
+		analytics.NetworkWatcherFlowAnalyticsConfiguration.Enabled = utils.Bool(false)
+		parameters.FlowAnalyticsConfiguration = analytics
+	}
+
+	log.Printf("[DEBUG] Disabling the Flow Log for Network Security Group %q (Network Watcher %q / Resource Group %q)..", networkSecurityGroupName, watcherName, resourceGroup)
+	if err := setAzureRmNetworkWatcherFlowLog(ctx, client, resourceGroup, watcherName, parameters); err != nil {
+		return fmt.Errorf("Error disabling the Flow Log for Network Security Group %q (Network Watcher %q / Resource Group %q): %+v", networkSecurityGroupName, watcherName, resourceGroup, err)
+	}
+
+	return nil
+}
+
+func setAzureRmNetworkWatcherFlowLog(ctx context.Context, client network.WatchersClient, resourceGroup, watcherName string, parameters network.FlowLogInformation) error {
+	future, err := client.SetFlowLogConfiguration(ctx, resourceGroup, watcherName, parameters)
+	if err != nil {
+		return err
+	}
+
+	return polling.WaitForCompletion(ctx, &future.Future, client.Client)
+}
+
+func expandAzureRmNetworkWatcherFlowLogRetentionPolicy(input []interface{}) *network.RetentionPolicyParameters {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+
+	return &network.RetentionPolicyParameters{
+		Enabled: utils.Bool(v["enabled"].(bool)),
+		Days:    utils.Int32(int32(v["days"].(int))),
+	}
+}
+
+func flattenAzureRmNetworkWatcherFlowLogRetentionPolicy(input *network.RetentionPolicyParameters) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	enabled := false
+	if input.Enabled != nil {
+		enabled = *input.Enabled
+	}
+
+	days := 0
+	if input.Days != nil {
+		days = int(*input.Days)
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"enabled": enabled,
+			"days":    days,
+		},
+	}
+}
+
+func expandAzureRmNetworkWatcherFlowLogTrafficAnalytics(input []interface{}) *network.TrafficAnalyticsProperties {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+
+	return &network.TrafficAnalyticsProperties{
+		NetworkWatcherFlowAnalyticsConfiguration: &network.TrafficAnalyticsConfigurationProperties{
+			Enabled:             utils.Bool(v["enabled"].(bool)),
+			WorkspaceID:         utils.String(v["workspace_id"].(string)),
+			WorkspaceRegion:     utils.String(azureRMNormalizeLocation(v["workspace_region"].(string))),
+			WorkspaceResourceID: utils.String(v["workspace_resource_id"].(string)),
+		},
+	}
+}
+
+func flattenAzureRmNetworkWatcherFlowLogTrafficAnalytics(input *network.TrafficAnalyticsProperties) []interface{} {
+	if input == nil || input.NetworkWatcherFlowAnalyticsConfiguration == nil {
+		return []interface{}{}
+	}
+
+	config := input.NetworkWatcherFlowAnalyticsConfiguration
+
+	// when Traffic Analytics has never been configured the API returns an empty (disabled) configuration
+	if config.WorkspaceResourceID == nil || *config.WorkspaceResourceID == "" {
+		return []interface{}{}
+	}
+
+	enabled := false
+	if config.Enabled != nil {
+		enabled = *config.Enabled
+	}
+
+	workspaceId := ""
+	if config.WorkspaceID != nil {
+		workspaceId = *config.WorkspaceID
+	}
+
+	workspaceRegion := ""
+	if config.WorkspaceRegion != nil {
+		workspaceRegion = azureRMNormalizeLocation(*config.WorkspaceRegion)
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"enabled":               enabled,
+			"workspace_id":          workspaceId,
+			"workspace_region":      workspaceRegion,
+			"workspace_resource_id": *config.WorkspaceResourceID,
+		},
+	}
+}
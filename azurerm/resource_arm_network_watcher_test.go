@@ -7,6 +7,7 @@ import (
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/polling"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
@@ -24,6 +25,13 @@ func TestAccAzureRMNetworkWatcher(t *testing.T) {
 			"importBasic":    testAccAzureRMNetworkWatcher_importBasic,
 			"importComplete": testAccAzureRMNetworkWatcher_importComplete,
 		},
+		"FlowLog": {
+			"basic":                testAccAzureRMNetworkWatcherFlowLog_basic,
+			"disabled":             testAccAzureRMNetworkWatcherFlowLog_disabled,
+			"disabledOutOfBand":    testAccAzureRMNetworkWatcherFlowLog_disabledOutOfBand,
+			"trafficAnalytics":     testAccAzureRMNetworkWatcherFlowLog_trafficAnalytics,
+			"updateStorageAccount": testAccAzureRMNetworkWatcherFlowLog_updateStorageAccount,
+		},
 		"PacketCapture": {
 			"import":                     testAccAzureRMPacketCapture_importBasic,
 			"localDisk":                  testAccAzureRMPacketCapture_localDisk,
@@ -179,7 +187,7 @@ func testCheckAzureRMNetworkWatcherDisappears(name string) resource.TestCheckFun
 			}
 		}
 
-		err = future.WaitForCompletionRef(ctx, client.Client)
+		err = polling.WaitForCompletion(ctx, &future.Future, client.Client)
 		if err != nil {
 			return fmt.Errorf("Bad: Delete on watcherClient: %+v", err)
 		}
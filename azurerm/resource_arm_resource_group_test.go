@@ -4,11 +4,13 @@ import (
 	"fmt"
 	"log"
 	"net/http"
+	"os"
 	"testing"
 
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/polling"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -46,7 +48,7 @@ func testSweepResourceGroups(region string) error {
 			return err
 		}
 
-		err = deleteFuture.WaitForCompletionRef(ctx, client.Client)
+		err = polling.WaitForCompletion(ctx, &deleteFuture.Future, client.Client)
 		if err != nil {
 			return err
 		}
@@ -129,6 +131,37 @@ func TestAccAzureRMResourceGroup_withTags(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMResourceGroup_alternateSubscription(t *testing.T) {
+	subscriptionId := os.Getenv("ARM_SUBSCRIPTION_ID_ALT")
+	if subscriptionId == "" {
+		t.Skip("Skipping since `ARM_SUBSCRIPTION_ID_ALT` isn't specified")
+	}
+
+	resourceName := "azurerm_resource_group.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMResourceGroup_alternateSubscription(ri, testLocation(), subscriptionId)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMResourceGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMResourceGroupExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "subscription_id", subscriptionId),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testCheckAzureRMResourceGroupExists(name string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		// Ensure we have enough information in state to look up in API
@@ -138,9 +171,10 @@ func testCheckAzureRMResourceGroupExists(name string) resource.TestCheckFunc {
 		}
 
 		resourceGroup := rs.Primary.Attributes["name"]
+		subscriptionId := rs.Primary.Attributes["subscription_id"]
 
 		// Ensure resource group exists in API
-		client := testAccProvider.Meta().(*ArmClient).resourceGroupsClient
+		client := testAccProvider.Meta().(*ArmClient).forSubscription(subscriptionId).resourceGroupsClient
 		ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 		resp, err := client.Get(ctx, resourceGroup)
@@ -175,7 +209,7 @@ func testCheckAzureRMResourceGroupDisappears(name string) resource.TestCheckFunc
 			return fmt.Errorf("Failed deleting Resource Group %q: %+v", resourceGroup, err)
 		}
 
-		err = deleteFuture.WaitForCompletionRef(ctx, client.Client)
+		err = polling.WaitForCompletion(ctx, &deleteFuture.Future, client.Client)
 		if err != nil {
 			return fmt.Errorf("Failed long polling for the deletion of Resource Group %q: %+v", resourceGroup, err)
 		}
@@ -185,7 +219,6 @@ func testCheckAzureRMResourceGroupDisappears(name string) resource.TestCheckFunc
 }
 
 func testCheckAzureRMResourceGroupDestroy(s *terraform.State) error {
-	client := testAccProvider.Meta().(*ArmClient).resourceGroupsClient
 	ctx := testAccProvider.Meta().(*ArmClient).StopContext
 
 	for _, rs := range s.RootModule().Resources {
@@ -193,6 +226,7 @@ func testCheckAzureRMResourceGroupDestroy(s *terraform.State) error {
 			continue
 		}
 
+		client := testAccProvider.Meta().(*ArmClient).forSubscription(rs.Primary.Attributes["subscription_id"]).resourceGroupsClient
 		resourceGroup := rs.Primary.ID
 
 		resp, err := client.Get(ctx, resourceGroup)
@@ -243,3 +277,13 @@ resource "azurerm_resource_group" "test" {
 }
 `, rInt, location)
 }
+
+func testAccAzureRMResourceGroup_alternateSubscription(rInt int, location string, subscriptionId string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name            = "acctestRG-%d"
+  location        = "%s"
+  subscription_id = "%s"
+}
+`, rInt, location, subscriptionId)
+}
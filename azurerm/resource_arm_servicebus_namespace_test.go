@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/polling"
 )
 
 func init() {
@@ -53,7 +54,7 @@ func testSweepServiceBusNamespace(region string) error {
 			return err
 		}
 
-		err = deleteFuture.WaitForCompletionRef(ctx, client.Client)
+		err = polling.WaitForCompletion(ctx, &deleteFuture.Future, client.Client)
 		if err != nil {
 			return err
 		}
@@ -0,0 +1,280 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2018-06-01/compute"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/polling"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmSharedImage() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmSharedImageCreateUpdate,
+		Read:   resourceArmSharedImageRead,
+		Update: resourceArmSharedImageCreateUpdate,
+		Delete: resourceArmSharedImageDelete,
+		Importer: &schema.ResourceImporter{
+			State: azure.ImportSharedImageID,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateSharedImageName(),
+			},
+
+			"gallery_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateSharedImageGalleryName(),
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"location": locationSchema(),
+
+			"os_type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(compute.Linux),
+					string(compute.Windows),
+				}, false),
+			},
+
+			"identifier": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"publisher": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+
+						"offer": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+
+						"sku": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+					},
+				},
+			},
+
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"eula": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"privacy_statement_uri": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.URLIsHTTPOrHTTPS,
+			},
+
+			"release_note_uri": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.URLIsHTTPOrHTTPS,
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceArmSharedImageCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).galleryImagesClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	log.Printf("[INFO] preparing arguments for Shared Image creation.")
+
+	name := d.Get("name").(string)
+	galleryName := d.Get("gallery_name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	location := azureRMNormalizeLocation(d.Get("location").(string))
+	tags := d.Get("tags").(map[string]interface{})
+
+	image := compute.GalleryImage{
+		Location: utils.String(location),
+		GalleryImageProperties: &compute.GalleryImageProperties{
+			Description:         utils.String(d.Get("description").(string)),
+			Eula:                utils.String(d.Get("eula").(string)),
+			Identifier:          expandAzureRmSharedImageIdentifier(d),
+			PrivacyStatementURI: utils.String(d.Get("privacy_statement_uri").(string)),
+			ReleaseNoteURI:      utils.String(d.Get("release_note_uri").(string)),
+			OsType:              compute.OperatingSystemTypes(d.Get("os_type").(string)),
+			// only Generalized images can be shared at this time
+			OsState: compute.Generalized,
+		},
+		Tags: expandTags(tags, meta),
+	}
+
+	future, err := client.CreateOrUpdate(ctx, resourceGroup, galleryName, name, image)
+	if err != nil {
+		return fmt.Errorf("Error creating/updating Shared Image %q (Gallery %q / Resource Group %q): %+v", name, galleryName, resourceGroup, err)
+	}
+
+	if err = polling.WaitForCompletion(ctx, &future.Future, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for creation/update of Shared Image %q (Gallery %q / Resource Group %q): %+v", name, galleryName, resourceGroup, err)
+	}
+
+	read, err := client.Get(ctx, resourceGroup, galleryName, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Shared Image %q (Gallery %q / Resource Group %q): %+v", name, galleryName, resourceGroup, err)
+	}
+
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read Shared Image %q (Gallery %q / Resource Group %q) ID", name, galleryName, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmSharedImageRead(d, meta)
+}
+
+func resourceArmSharedImageRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).galleryImagesClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := azure.ParseSharedImageID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.Gallery, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Shared Image %q (Gallery %q / Resource Group %q) was not found - removing from state", id.Name, id.Gallery, id.ResourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error making Read request on Shared Image %q (Gallery %q / Resource Group %q): %+v", id.Name, id.Gallery, id.ResourceGroup, err)
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("gallery_name", id.Gallery)
+	d.Set("resource_group_name", id.ResourceGroup)
+	if location := resp.Location; location != nil {
+		d.Set("location", azureRMNormalizeLocation(*location))
+	}
+
+	if props := resp.GalleryImageProperties; props != nil {
+		d.Set("description", props.Description)
+		d.Set("eula", props.Eula)
+		d.Set("os_type", string(props.OsType))
+		d.Set("privacy_statement_uri", props.PrivacyStatementURI)
+		d.Set("release_note_uri", props.ReleaseNoteURI)
+
+		if err := d.Set("identifier", flattenAzureRmSharedImageIdentifier(props.Identifier)); err != nil {
+			return fmt.Errorf("Error setting `identifier`: %+v", err)
+		}
+	}
+
+	flattenAndSetResourceTags(d, resp.Tags, meta)
+
+	return nil
+}
+
+func resourceArmSharedImageDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).galleryImagesClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := azure.ParseSharedImageID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	future, err := client.Delete(ctx, id.ResourceGroup, id.Gallery, id.Name)
+	if err != nil {
+		// deleted outside of Terraform
+		if response.WasNotFound(future.Response()) {
+			return nil
+		}
+		return fmt.Errorf("Error deleting Shared Image %q (Gallery %q / Resource Group %q): %+v", id.Name, id.Gallery, id.ResourceGroup, err)
+	}
+
+	if err = polling.WaitForCompletion(ctx, &future.Future, client.Client); err != nil {
+		if !response.WasNotFound(future.Response()) {
+			return fmt.Errorf("Error waiting for the deletion of Shared Image %q (Gallery %q / Resource Group %q): %+v", id.Name, id.Gallery, id.ResourceGroup, err)
+		}
+	}
+
+	return nil
+}
+
+func expandAzureRmSharedImageIdentifier(d *schema.ResourceData) *compute.GalleryImageIdentifier {
+	identifiers := d.Get("identifier").([]interface{})
+	identifier := identifiers[0].(map[string]interface{})
+
+	return &compute.GalleryImageIdentifier{
+		Publisher: utils.String(identifier["publisher"].(string)),
+		Offer:     utils.String(identifier["offer"].(string)),
+		Sku:       utils.String(identifier["sku"].(string)),
+	}
+}
+
+func flattenAzureRmSharedImageIdentifier(input *compute.GalleryImageIdentifier) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	result := make(map[string]interface{})
+
+	if input.Offer != nil {
+		result["offer"] = *input.Offer
+	}
+
+	if input.Publisher != nil {
+		result["publisher"] = *input.Publisher
+	}
+
+	if input.Sku != nil {
+		result["sku"] = *input.Sku
+	}
+
+	return []interface{}{result}
+}
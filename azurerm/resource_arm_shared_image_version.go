@@ -0,0 +1,285 @@
+package azurerm
+
+import (
+	"bytes"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2018-06-01/compute"
+	"github.com/hashicorp/terraform/helper/hashcode"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/polling"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmSharedImageVersion() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmSharedImageVersionCreateUpdate,
+		Read:   resourceArmSharedImageVersionRead,
+		Update: resourceArmSharedImageVersionCreateUpdate,
+		Delete: resourceArmSharedImageVersionDelete,
+		Importer: &schema.ResourceImporter{
+			State: azure.ImportSharedImageVersionID,
+		},
+
+		// replicating the Image Version to each of the Target Regions can take some time
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateSharedImageVersionName(),
+			},
+
+			"gallery_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateSharedImageGalleryName(),
+			},
+
+			"image_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateSharedImageName(),
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"location": locationSchema(),
+
+			"managed_image_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateImageID,
+			},
+
+			"target_region": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Set:      resourceArmSharedImageVersionTargetRegionHash,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:             schema.TypeString,
+							Required:         true,
+							StateFunc:        azureRMNormalizeLocation,
+							DiffSuppressFunc: azureRMSuppressLocationDiff,
+						},
+
+						"regional_replica_count": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+					},
+				},
+			},
+
+			"exclude_from_latest": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceArmSharedImageVersionCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).galleryImageVersionsClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	log.Printf("[INFO] preparing arguments for Shared Image Version creation.")
+
+	name := d.Get("name").(string)
+	imageName := d.Get("image_name").(string)
+	galleryName := d.Get("gallery_name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	location := azureRMNormalizeLocation(d.Get("location").(string))
+	managedImageId := d.Get("managed_image_id").(string)
+	excludeFromLatest := d.Get("exclude_from_latest").(bool)
+	tags := d.Get("tags").(map[string]interface{})
+
+	version := compute.GalleryImageVersion{
+		Location: utils.String(location),
+		GalleryImageVersionProperties: &compute.GalleryImageVersionProperties{
+			PublishingProfile: &compute.GalleryImageVersionPublishingProfile{
+				ExcludeFromLatest: utils.Bool(excludeFromLatest),
+				TargetRegions:     expandAzureRmSharedImageVersionTargetRegions(d),
+				Source: &compute.GalleryArtifactSource{
+					ManagedImage: &compute.ManagedArtifact{
+						ID: utils.String(managedImageId),
+					},
+				},
+			},
+		},
+		Tags: expandTags(tags, meta),
+	}
+
+	future, err := client.CreateOrUpdate(ctx, resourceGroup, galleryName, imageName, name, version)
+	if err != nil {
+		return fmt.Errorf("Error creating/updating Shared Image Version %q (Image %q / Gallery %q / Resource Group %q): %+v", name, imageName, galleryName, resourceGroup, err)
+	}
+
+	if err = polling.WaitForCompletion(ctx, &future.Future, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for creation/update of Shared Image Version %q (Image %q / Gallery %q / Resource Group %q): %+v", name, imageName, galleryName, resourceGroup, err)
+	}
+
+	read, err := client.Get(ctx, resourceGroup, galleryName, imageName, name, "")
+	if err != nil {
+		return fmt.Errorf("Error retrieving Shared Image Version %q (Image %q / Gallery %q / Resource Group %q): %+v", name, imageName, galleryName, resourceGroup, err)
+	}
+
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read Shared Image Version %q (Image %q / Gallery %q / Resource Group %q) ID", name, imageName, galleryName, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmSharedImageVersionRead(d, meta)
+}
+
+func resourceArmSharedImageVersionRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).galleryImageVersionsClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := azure.ParseSharedImageVersionID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.Gallery, id.Image, id.Name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Shared Image Version %q (Image %q / Gallery %q / Resource Group %q) was not found - removing from state", id.Name, id.Image, id.Gallery, id.ResourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error making Read request on Shared Image Version %q (Image %q / Gallery %q / Resource Group %q): %+v", id.Name, id.Image, id.Gallery, id.ResourceGroup, err)
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("image_name", id.Image)
+	d.Set("gallery_name", id.Gallery)
+	d.Set("resource_group_name", id.ResourceGroup)
+	if location := resp.Location; location != nil {
+		d.Set("location", azureRMNormalizeLocation(*location))
+	}
+
+	if props := resp.GalleryImageVersionProperties; props != nil {
+		if profile := props.PublishingProfile; profile != nil {
+			d.Set("exclude_from_latest", profile.ExcludeFromLatest)
+
+			if source := profile.Source; source != nil && source.ManagedImage != nil {
+				d.Set("managed_image_id", source.ManagedImage.ID)
+			}
+
+			if err := d.Set("target_region", flattenAzureRmSharedImageVersionTargetRegions(profile.TargetRegions)); err != nil {
+				return fmt.Errorf("Error setting `target_region`: %+v", err)
+			}
+		}
+	}
+
+	flattenAndSetResourceTags(d, resp.Tags, meta)
+
+	return nil
+}
+
+func resourceArmSharedImageVersionDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).galleryImageVersionsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := azure.ParseSharedImageVersionID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	future, err := client.Delete(ctx, id.ResourceGroup, id.Gallery, id.Image, id.Name)
+	if err != nil {
+		// deleted outside of Terraform
+		if response.WasNotFound(future.Response()) {
+			return nil
+		}
+		return fmt.Errorf("Error deleting Shared Image Version %q (Image %q / Gallery %q / Resource Group %q): %+v", id.Name, id.Image, id.Gallery, id.ResourceGroup, err)
+	}
+
+	if err = polling.WaitForCompletion(ctx, &future.Future, client.Client); err != nil {
+		if !response.WasNotFound(future.Response()) {
+			return fmt.Errorf("Error waiting for the deletion of Shared Image Version %q (Image %q / Gallery %q / Resource Group %q): %+v", id.Name, id.Image, id.Gallery, id.ResourceGroup, err)
+		}
+	}
+
+	return nil
+}
+
+func expandAzureRmSharedImageVersionTargetRegions(d *schema.ResourceData) *[]compute.TargetRegion {
+	vs := d.Get("target_region").(*schema.Set).List()
+	results := make([]compute.TargetRegion, 0)
+
+	for _, v := range vs {
+		input := v.(map[string]interface{})
+
+		name := input["name"].(string)
+		regionalReplicaCount := input["regional_replica_count"].(int)
+
+		results = append(results, compute.TargetRegion{
+			Name:                 utils.String(name),
+			RegionalReplicaCount: utils.Int32(int32(regionalReplicaCount)),
+		})
+	}
+
+	return &results
+}
+
+func flattenAzureRmSharedImageVersionTargetRegions(input *[]compute.TargetRegion) []interface{} {
+	results := make([]interface{}, 0)
+
+	if input != nil {
+		for _, v := range *input {
+			output := make(map[string]interface{})
+
+			if v.Name != nil {
+				output["name"] = azureRMNormalizeLocation(*v.Name)
+			}
+
+			if v.RegionalReplicaCount != nil {
+				output["regional_replica_count"] = int(*v.RegionalReplicaCount)
+			}
+
+			results = append(results, output)
+		}
+	}
+
+	return results
+}
+
+func resourceArmSharedImageVersionTargetRegionHash(v interface{}) int {
+	var buf bytes.Buffer
+
+	if m, ok := v.(map[string]interface{}); ok {
+		buf.WriteString(fmt.Sprintf("%s-", azureRMNormalizeLocation(m["name"].(string))))
+		buf.WriteString(fmt.Sprintf("%d-", m["regional_replica_count"].(int)))
+	}
+
+	return hashcode.String(buf.String())
+}
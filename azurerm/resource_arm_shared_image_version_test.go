@@ -0,0 +1,196 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMSharedImageVersion_basic(t *testing.T) {
+	resourceName := "azurerm_shared_image_version.test"
+	ri := acctest.RandInt()
+	resourceGroup := fmt.Sprintf("acctestRG-%d", ri)
+	userName := "testadmin"
+	password := "Password1234!"
+	hostName := fmt.Sprintf("tftestcustomimagesrc%d", ri)
+	sshPort := "22"
+	location := testLocation()
+	altLocation := testAltLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMSharedImageVersionDestroy,
+		Steps: []resource.TestStep{
+			{
+				// need to create a vm and then generalize it before creating the image
+				Config:  testAccAzureRMImage_standaloneImage_setup(ri, userName, password, hostName, location),
+				Destroy: false,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureVMExists("azurerm_virtual_machine.testsource", true),
+					testGeneralizeVMImage(resourceGroup, "testsource", userName, password, hostName, sshPort, location),
+				),
+			},
+			{
+				Config: testAccAzureRMSharedImageVersion_basic(ri, userName, password, hostName, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMSharedImageVersionExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "managed_image_id"),
+					resource.TestCheckResourceAttr(resourceName, "target_region.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "exclude_from_latest", "false"),
+				),
+			},
+			{
+				Config: testAccAzureRMSharedImageVersion_replicated(ri, userName, password, hostName, location, altLocation),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMSharedImageVersionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "target_region.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "exclude_from_latest", "true"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckAzureRMSharedImageVersionDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).galleryImageVersionsClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_shared_image_version" {
+			continue
+		}
+
+		name := rs.Primary.Attributes["name"]
+		imageName := rs.Primary.Attributes["image_name"]
+		galleryName := rs.Primary.Attributes["gallery_name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		resp, err := client.Get(ctx, resourceGroup, galleryName, imageName, name, "")
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil
+			}
+			return err
+		}
+
+		return fmt.Errorf("Shared Image Version still exists:\n%+v", resp)
+	}
+
+	return nil
+}
+
+func testCheckAzureRMSharedImageVersionExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		versionName := rs.Primary.Attributes["name"]
+		imageName := rs.Primary.Attributes["image_name"]
+		galleryName := rs.Primary.Attributes["gallery_name"]
+		resourceGroup, hasResourceGroup := rs.Primary.Attributes["resource_group_name"]
+		if !hasResourceGroup {
+			return fmt.Errorf("Bad: no resource group found in state for Shared Image Version: %s", versionName)
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).galleryImageVersionsClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+		resp, err := client.Get(ctx, resourceGroup, galleryName, imageName, versionName, "")
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Shared Image Version %q (Image %q / Gallery %q / Resource Group %q) does not exist", versionName, imageName, galleryName, resourceGroup)
+			}
+
+			return fmt.Errorf("Bad: Get on galleryImageVersionsClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testAccAzureRMSharedImageVersion_basic(rInt int, userName string, password string, hostName string, location string) string {
+	template := testAccAzureRMSharedImageVersion_template(rInt, userName, password, hostName, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_shared_image_version" "test" {
+  name                = "0.0.1"
+  gallery_name        = "${azurerm_shared_image.test.gallery_name}"
+  image_name          = "${azurerm_shared_image.test.name}"
+  resource_group_name = "${azurerm_shared_image.test.resource_group_name}"
+  location            = "${azurerm_shared_image.test.location}"
+  managed_image_id    = "${azurerm_image.test.id}"
+
+  target_region {
+    name                   = "${azurerm_shared_image.test.location}"
+    regional_replica_count = 1
+  }
+}
+`, template)
+}
+
+func testAccAzureRMSharedImageVersion_replicated(rInt int, userName string, password string, hostName string, location string, altLocation string) string {
+	template := testAccAzureRMSharedImageVersion_template(rInt, userName, password, hostName, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_shared_image_version" "test" {
+  name                = "0.0.1"
+  gallery_name        = "${azurerm_shared_image.test.gallery_name}"
+  image_name          = "${azurerm_shared_image.test.name}"
+  resource_group_name = "${azurerm_shared_image.test.resource_group_name}"
+  location            = "${azurerm_shared_image.test.location}"
+  managed_image_id    = "${azurerm_image.test.id}"
+  exclude_from_latest = true
+
+  target_region {
+    name                   = "${azurerm_shared_image.test.location}"
+    regional_replica_count = 1
+  }
+
+  target_region {
+    name                   = "%s"
+    regional_replica_count = 2
+  }
+}
+`, template, altLocation)
+}
+
+func testAccAzureRMSharedImageVersion_template(rInt int, userName string, password string, hostName string, location string) string {
+	template := testAccAzureRMImage_standaloneImage_provision(rInt, userName, password, hostName, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_shared_image_gallery" "test" {
+  name                = "acctestsig%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+}
+
+resource "azurerm_shared_image" "test" {
+  name                = "acctestimg%d"
+  gallery_name        = "${azurerm_shared_image_gallery.test.name}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  location            = "${azurerm_resource_group.test.location}"
+  os_type             = "Linux"
+
+  identifier {
+    publisher = "AccTesPublisher%d"
+    offer     = "AccTesOffer%d"
+    sku       = "AccTesSku%d"
+  }
+}
+`, template, rInt, rInt, rInt, rInt, rInt)
+}
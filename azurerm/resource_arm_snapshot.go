@@ -4,10 +4,15 @@ import (
 	"fmt"
 	"log"
 	"regexp"
+	"strings"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2018-06-01/compute"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/polling"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -18,7 +23,14 @@ func resourceArmSnapshot() *schema.Resource {
 		Update: resourceArmSnapshotCreateUpdate,
 		Delete: resourceArmSnapshotDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: azure.ImportSnapshotID,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -56,9 +68,25 @@ func resourceArmSnapshot() *schema.Resource {
 			},
 
 			"storage_account_id": {
-				Type:     schema.TypeString,
-				Optional: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateStorageAccountID,
+			},
+
+			"staging_storage_account_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateStorageAccountID,
+			},
+
+			"staging_storage_container_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      defaultDiskCopyStagingContainerName,
+				ValidateFunc: validateArmStorageContainerName,
 			},
 
 			"disk_size_gb": {
@@ -76,7 +104,8 @@ func resourceArmSnapshot() *schema.Resource {
 
 func resourceArmSnapshotCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).snapshotsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	name := d.Get("name").(string)
 	resourceGroup := d.Get("resource_group_name").(string)
@@ -91,7 +120,7 @@ func resourceArmSnapshotCreateUpdate(d *schema.ResourceData, meta interface{}) e
 				CreateOption: compute.DiskCreateOption(createOption),
 			},
 		},
-		Tags: expandTags(tags),
+		Tags: expandTags(tags, meta),
 	}
 
 	if v, ok := d.GetOk("source_uri"); ok {
@@ -102,6 +131,41 @@ func resourceArmSnapshotCreateUpdate(d *schema.ResourceData, meta interface{}) e
 		properties.SnapshotProperties.CreationData.SourceResourceID = utils.String(v.(string))
 	}
 
+	if strings.EqualFold(createOption, string(compute.Copy)) {
+		if d.IsNewResource() {
+			source, err := getAzureRmDiskCopySource(ctx, meta, d.Get("source_resource_id").(string))
+			if err != nil {
+				return err
+			}
+
+			if source != nil && source.Location != location {
+				stagingBlobName, err := azureRmDiskCopyStagingBlobName(resourceGroup, name)
+				if err != nil {
+					return err
+				}
+
+				creationData, err := expandAzureRmDiskCreationDataAcrossRegions(ctx, d, meta, *source, location, stagingBlobName)
+				if err != nil {
+					return fmt.Errorf("Error copying %q into %q for Snapshot %q (Resource Group %q): %+v", source.Name, location, name, resourceGroup, err)
+				}
+
+				properties.SnapshotProperties.CreationData = creationData
+				// the staging blob is only needed until the import completes, regardless of whether it succeeds
+				defer cleanupAzureRmDiskCopyStagingBlob(d, meta, stagingBlobName)
+			}
+		} else if d.Get("staging_storage_account_id").(string) != "" {
+			// a Snapshot copied from another region was imported from the staging VHD, which needs to be retained
+			existing, err := client.Get(ctx, resourceGroup, name)
+			if err != nil {
+				return fmt.Errorf("Error retrieving Snapshot %q (Resource Group %q): %+v", name, resourceGroup, err)
+			}
+
+			if props := existing.SnapshotProperties; props != nil && azureRmDiskWasCopiedAcrossRegions(d, props.CreationData) {
+				properties.SnapshotProperties.CreationData = props.CreationData
+			}
+		}
+	}
+
 	if v, ok := d.GetOk("storage_account_id"); ok {
 		properties.SnapshotProperties.CreationData.StorageAccountID = utils.String(v.(string))
 	}
@@ -122,7 +186,7 @@ func resourceArmSnapshotCreateUpdate(d *schema.ResourceData, meta interface{}) e
 		return err
 	}
 
-	err = future.WaitForCompletionRef(ctx, client.Client)
+	err = polling.WaitForCompletion(ctx, &future.Future, client.Client)
 	if err != nil {
 		return err
 	}
@@ -139,7 +203,8 @@ func resourceArmSnapshotCreateUpdate(d *schema.ResourceData, meta interface{}) e
 
 func resourceArmSnapshotRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).snapshotsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
@@ -168,7 +233,7 @@ func resourceArmSnapshotRead(d *schema.ResourceData, meta interface{}) error {
 
 	if props := resp.SnapshotProperties; props != nil {
 
-		if data := props.CreationData; data != nil {
+		if data := props.CreationData; data != nil && !azureRmDiskWasCopiedAcrossRegions(d, data) {
 			d.Set("create_option", string(data.CreateOption))
 
 			if accountId := data.StorageAccountID; accountId != nil {
@@ -185,14 +250,15 @@ func resourceArmSnapshotRead(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
-	flattenAndSetTags(d, resp.Tags)
+	flattenAndSetResourceTags(d, resp.Tags, meta)
 
 	return nil
 }
 
 func resourceArmSnapshotDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).snapshotsClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
@@ -207,7 +273,7 @@ func resourceArmSnapshotDelete(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("Error deleting Snapshot: %+v", err)
 	}
 
-	err = future.WaitForCompletionRef(ctx, client.Client)
+	err = polling.WaitForCompletion(ctx, &future.Future, client.Client)
 	if err != nil {
 		return fmt.Errorf("Error deleting Snapshot: %+v", err)
 	}
@@ -167,6 +167,33 @@ func TestAccAzureRMSnapshot_fromExistingSnapshot(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMSnapshot_fromSnapshotInAnotherRegion(t *testing.T) {
+	resourceName := "azurerm_snapshot.second"
+	ri := acctest.RandInt()
+	rs := acctest.RandString(4)
+	config := testAccAzureRMSnapshot_fromSnapshotInAnotherRegion(ri, rs, testLocation(), testAltLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMSnapshotDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMSnapshotExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "create_option", "Copy"),
+					resource.TestCheckResourceAttr(resourceName, "location", azureRMNormalizeLocation(testAltLocation())),
+				),
+			},
+			{
+				Config:   config,
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
 func TestAccAzureRMSnapshot_fromUnmanagedDisk(t *testing.T) {
 	resourceName := "azurerm_snapshot.test"
 	ri := acctest.RandInt()
@@ -455,6 +482,54 @@ resource "azurerm_snapshot" "second" {
 `, rInt, location, rInt, rInt, rInt)
 }
 
+func testAccAzureRMSnapshot_fromSnapshotInAnotherRegion(rInt int, rString string, location string, altLocation string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[3]s"
+}
+
+resource "azurerm_resource_group" "alt" {
+  name     = "acctestRG-alt-%[1]d"
+  location = "%[4]s"
+}
+
+resource "azurerm_managed_disk" "test" {
+  name                 = "acctestmd-%[1]d"
+  location             = "${azurerm_resource_group.test.location}"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+  storage_account_type = "Standard_LRS"
+  create_option        = "Empty"
+  disk_size_gb         = "10"
+}
+
+resource "azurerm_snapshot" "first" {
+  name                = "acctestss1_%[1]d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  create_option       = "Copy"
+  source_uri          = "${azurerm_managed_disk.test.id}"
+}
+
+resource "azurerm_storage_account" "staging" {
+  name                     = "acctestsa%[2]s"
+  location                 = "${azurerm_resource_group.alt.location}"
+  resource_group_name      = "${azurerm_resource_group.alt.name}"
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_snapshot" "second" {
+  name                       = "acctestss2_%[1]d"
+  location                   = "${azurerm_resource_group.alt.location}"
+  resource_group_name        = "${azurerm_resource_group.alt.name}"
+  create_option              = "Copy"
+  source_resource_id         = "${azurerm_snapshot.first.id}"
+  staging_storage_account_id = "${azurerm_storage_account.staging.id}"
+}
+`, rInt, rString, location, altLocation)
+}
+
 func testAccAzureRMSnapshot_fromUnmanagedDisk(rInt int, rString string, location string) string {
 	return fmt.Sprintf(`
 resource "azurerm_resource_group" "test" {
@@ -0,0 +1,241 @@
+package azurerm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-04-01/network"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/polling"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+var virtualHubResourceName = "azurerm_virtual_hub"
+
+func resourceArmVirtualHub() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmVirtualHubCreateUpdate,
+		Read:   resourceArmVirtualHubRead,
+		Update: resourceArmVirtualHubCreateUpdate,
+		Delete: resourceArmVirtualHubDelete,
+		Importer: &schema.ResourceImporter{
+			State: azure.ImportVirtualHubID,
+		},
+
+		// provisioning a Virtual Hub routinely takes longer than 30 minutes
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"location": locationSchema(),
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"virtual_wan_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateVirtualWanID,
+			},
+
+			"address_prefix": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.CIDRNetwork(0, 24),
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceArmVirtualHubCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).virtualHubClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	location := azureRMNormalizeLocation(d.Get("location").(string))
+	tags := d.Get("tags").(map[string]interface{})
+
+	azureRMLockByName(name, virtualHubResourceName)
+	defer azureRMUnlockByName(name, virtualHubResourceName)
+
+	existing, err := client.Get(ctx, resourceGroup, name)
+	if err != nil {
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return fmt.Errorf("Error checking for the presence of an existing Virtual Hub %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+	}
+
+	if d.IsNewResource() && existing.ID != nil && *existing.ID != "" {
+		return fmt.Errorf("A Virtual Hub named %q already exists in Resource Group %q - to be managed via Terraform this resource needs to be imported into the State", name, resourceGroup)
+	}
+
+	hub := network.VirtualHub{
+		Location: utils.String(location),
+		VirtualHubProperties: &network.VirtualHubProperties{
+			VirtualWan: &network.SubResource{
+				ID: utils.String(d.Get("virtual_wan_id").(string)),
+			},
+			AddressPrefix: utils.String(d.Get("address_prefix").(string)),
+		},
+		Tags: expandTags(tags, meta),
+	}
+
+	var future network.VirtualHubsCreateOrUpdateFuture
+	start := func() error {
+		// the connections to Virtual Networks are managed via the `azurerm_virtual_hub_connection` resource - so these
+		// are retrieved each time the update is started, to ensure a retry (after waiting for an in-flight operation)
+		// includes any connections made in the meantime
+		existing, err := client.Get(ctx, resourceGroup, name)
+		if err != nil && !utils.ResponseWasNotFound(existing.Response) {
+			return fmt.Errorf("Error retrieving Virtual Hub %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+
+		hub.VirtualHubProperties.HubVirtualNetworkConnections = nil
+		if props := existing.VirtualHubProperties; props != nil {
+			hub.VirtualHubProperties.HubVirtualNetworkConnections = props.HubVirtualNetworkConnections
+		}
+
+		future, err = client.CreateOrUpdate(ctx, resourceGroup, name, hub)
+		return err
+	}
+	if err := polling.StartOrAttach(ctx, start, virtualHubProvisioningState(ctx, client, resourceGroup, name)); err != nil {
+		return fmt.Errorf("Error creating/updating Virtual Hub %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	err = polling.WaitForCompletion(ctx, &future.Future, client.Client)
+	if err != nil {
+		return fmt.Errorf("Error waiting for the creation/update of Virtual Hub %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	read, err := client.Get(ctx, resourceGroup, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Virtual Hub %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read Virtual Hub %q (Resource Group %q) ID", name, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmVirtualHubRead(d, meta)
+}
+
+func resourceArmVirtualHubRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).virtualHubClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := azure.ParseVirtualHubID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Virtual Hub %q was not found in Resource Group %q - removing from state!", id.Name, id.ResourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error making Read request on Virtual Hub %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", id.ResourceGroup)
+	if location := resp.Location; location != nil {
+		d.Set("location", azureRMNormalizeLocation(*location))
+	}
+
+	if props := resp.VirtualHubProperties; props != nil {
+		d.Set("address_prefix", props.AddressPrefix)
+
+		virtualWanId := ""
+		if wan := props.VirtualWan; wan != nil && wan.ID != nil {
+			virtualWanId = *wan.ID
+		}
+		d.Set("virtual_wan_id", virtualWanId)
+	}
+
+	flattenAndSetResourceTags(d, resp.Tags, meta)
+
+	return nil
+}
+
+func resourceArmVirtualHubDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).virtualHubClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := azure.ParseVirtualHubID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	azureRMLockByName(id.Name, virtualHubResourceName)
+	defer azureRMUnlockByName(id.Name, virtualHubResourceName)
+
+	var future network.VirtualHubsDeleteFuture
+	start := func() error {
+		future, err = client.Delete(ctx, id.ResourceGroup, id.Name)
+		return err
+	}
+	if err := polling.StartOrAttach(ctx, start, virtualHubProvisioningState(ctx, client, id.ResourceGroup, id.Name)); err != nil {
+		if response.WasNotFound(future.Response()) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting Virtual Hub %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+	}
+
+	err = polling.WaitForCompletion(ctx, &future.Future, client.Client)
+	if err != nil {
+		if response.WasNotFound(future.Response()) {
+			return nil
+		}
+
+		return fmt.Errorf("Error waiting for the deletion of Virtual Hub %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+	}
+
+	return nil
+}
+
+// virtualHubProvisioningState returns a function which retrieves the Provisioning State of the Virtual Hub, for use
+// with `polling.StartOrAttach` - since the Virtual Hub and the resources within it (such as the VPN Gateway and the
+// connections to Virtual Networks) can't be modified whilst the hub is being provisioned, which takes some time.
+func virtualHubProvisioningState(ctx context.Context, client network.VirtualHubsClient, resourceGroup, name string) func() (string, error) {
+	return func() (string, error) {
+		hub, err := client.Get(ctx, resourceGroup, name)
+		if err != nil {
+			return "", err
+		}
+
+		if props := hub.VirtualHubProperties; props != nil {
+			return string(props.ProvisioningState), nil
+		}
+
+		return "", nil
+	}
+}
@@ -0,0 +1,333 @@
+package azurerm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-04-01/network"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/polling"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmVirtualHubConnection() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmVirtualHubConnectionCreateUpdate,
+		Read:   resourceArmVirtualHubConnectionRead,
+		Update: resourceArmVirtualHubConnectionCreateUpdate,
+		Delete: resourceArmVirtualHubConnectionDelete,
+		Importer: &schema.ResourceImporter{
+			State: azure.ImportVirtualHubConnectionID,
+		},
+
+		// the connections are updated through the Virtual Hub, which routinely takes longer than 30 minutes
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"virtual_hub_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateVirtualHubID,
+			},
+
+			"remote_virtual_network_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateVirtualNetworkID,
+			},
+
+			"allow_hub_to_remote_virtual_network_transit": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"allow_remote_virtual_network_to_use_hub_gateways": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+		},
+	}
+}
+
+func resourceArmVirtualHubConnectionCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).virtualHubClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	name := d.Get("name").(string)
+	hubId, err := azure.ParseVirtualHubID(d.Get("virtual_hub_id").(string))
+	if err != nil {
+		return err
+	}
+	resourceGroup := hubId.ResourceGroup
+	hubName := hubId.Name
+
+	azureRMLockByName(hubName, virtualHubResourceName)
+	defer azureRMUnlockByName(hubName, virtualHubResourceName)
+
+	// the Connections are updated through the Virtual Hub, so the hub is retrieved each time the update is started -
+	// to ensure a retry (after waiting for an in-flight operation) includes any Connections made in the meantime
+	var future network.VirtualHubsCreateOrUpdateFuture
+	start := func() error {
+		hub, err := expandArmVirtualHubWithConnection(ctx, d, client, resourceGroup, hubName)
+		if err != nil {
+			return err
+		}
+
+		future, err = client.CreateOrUpdate(ctx, resourceGroup, hubName, *hub)
+		return err
+	}
+	if err := polling.StartOrAttach(ctx, start, virtualHubProvisioningState(ctx, client, resourceGroup, hubName)); err != nil {
+		return fmt.Errorf("Error creating/updating Connection %q in Virtual Hub %q (Resource Group %q): %+v", name, hubName, resourceGroup, err)
+	}
+
+	err = polling.WaitForCompletion(ctx, &future.Future, client.Client)
+	if err != nil {
+		return fmt.Errorf("Error waiting for creation/update of Connection %q in Virtual Hub %q (Resource Group %q): %+v", name, hubName, resourceGroup, err)
+	}
+
+	read, err := client.Get(ctx, resourceGroup, hubName)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Virtual Hub %q (Resource Group %q): %+v", hubName, resourceGroup, err)
+	}
+
+	var connectionId string
+	if props := read.VirtualHubProperties; props != nil && props.HubVirtualNetworkConnections != nil {
+		if i := findVirtualHubConnectionIndex(*props.HubVirtualNetworkConnections, name); i != -1 {
+			if id := (*props.HubVirtualNetworkConnections)[i].ID; id != nil {
+				connectionId = *id
+			}
+		}
+	}
+
+	if connectionId == "" {
+		return fmt.Errorf("Cannot find ID for Connection %q (Virtual Hub %q / Resource Group %q)", name, hubName, resourceGroup)
+	}
+	d.SetId(connectionId)
+
+	return resourceArmVirtualHubConnectionRead(d, meta)
+}
+
+func resourceArmVirtualHubConnectionRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).virtualHubClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := azure.ParseVirtualHubConnectionID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroup := id.ResourceGroup
+	hubName := id.VirtualHub
+	name := id.Name
+
+	hub, err := client.Get(ctx, resourceGroup, hubName)
+	if err != nil {
+		if utils.ResponseWasNotFound(hub.Response) {
+			log.Printf("[DEBUG] Virtual Hub %q (Resource Group %q) was not found - removing from state!", hubName, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Virtual Hub %q (Resource Group %q): %+v", hubName, resourceGroup, err)
+	}
+
+	var connection *network.HubVirtualNetworkConnection
+	if props := hub.VirtualHubProperties; props != nil && props.HubVirtualNetworkConnections != nil {
+		if i := findVirtualHubConnectionIndex(*props.HubVirtualNetworkConnections, name); i != -1 {
+			connection = &(*props.HubVirtualNetworkConnections)[i]
+		}
+	}
+
+	if connection == nil {
+		log.Printf("[DEBUG] Connection %q was not found in Virtual Hub %q (Resource Group %q) - removing from state!", name, hubName, resourceGroup)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("name", name)
+	d.Set("virtual_hub_id", azure.NewVirtualHubID(id.Subscription, resourceGroup, hubName).String())
+
+	if props := connection.HubVirtualNetworkConnectionProperties; props != nil {
+		remoteVirtualNetworkId := ""
+		if vnet := props.RemoteVirtualNetwork; vnet != nil && vnet.ID != nil {
+			remoteVirtualNetworkId = *vnet.ID
+		}
+		d.Set("remote_virtual_network_id", remoteVirtualNetworkId)
+
+		d.Set("allow_hub_to_remote_virtual_network_transit", props.AllowHubToRemoteVnetTransit)
+		d.Set("allow_remote_virtual_network_to_use_hub_gateways", props.AllowRemoteVnetToUseHubVnetGateways)
+	}
+
+	return nil
+}
+
+func resourceArmVirtualHubConnectionDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).virtualHubClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := azure.ParseVirtualHubConnectionID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resourceGroup := id.ResourceGroup
+	hubName := id.VirtualHub
+	name := id.Name
+
+	azureRMLockByName(hubName, virtualHubResourceName)
+	defer azureRMUnlockByName(hubName, virtualHubResourceName)
+
+	// as with Create/Update the hub is retrieved each time the update is started, so that a retry doesn't remove any
+	// Connections made whilst waiting for an in-flight operation
+	deleted := false
+	var future network.VirtualHubsCreateOrUpdateFuture
+	start := func() error {
+		hub, err := expandArmVirtualHubWithoutConnection(ctx, client, resourceGroup, hubName, name)
+		if err != nil {
+			return err
+		}
+
+		if hub == nil {
+			deleted = true
+			return nil
+		}
+
+		future, err = client.CreateOrUpdate(ctx, resourceGroup, hubName, *hub)
+		return err
+	}
+	if err := polling.StartOrAttach(ctx, start, virtualHubProvisioningState(ctx, client, resourceGroup, hubName)); err != nil {
+		return fmt.Errorf("Error deleting Connection %q from Virtual Hub %q (Resource Group %q): %+v", name, hubName, resourceGroup, err)
+	}
+
+	if deleted {
+		return nil
+	}
+
+	err = polling.WaitForCompletion(ctx, &future.Future, client.Client)
+	if err != nil {
+		return fmt.Errorf("Error waiting for deletion of Connection %q from Virtual Hub %q (Resource Group %q): %+v", name, hubName, resourceGroup, err)
+	}
+
+	return nil
+}
+
+// expandArmVirtualHubWithConnection retrieves the Virtual Hub and returns it with this Connection added or updated
+func expandArmVirtualHubWithConnection(ctx context.Context, d *schema.ResourceData, client network.VirtualHubsClient, resourceGroup, hubName string) (*network.VirtualHub, error) {
+	name := d.Get("name").(string)
+
+	hub, err := client.Get(ctx, resourceGroup, hubName)
+	if err != nil {
+		return nil, fmt.Errorf("Error retrieving Virtual Hub %q (Resource Group %q): %+v", hubName, resourceGroup, err)
+	}
+
+	if hub.VirtualHubProperties == nil {
+		return nil, fmt.Errorf("Error retrieving Virtual Hub %q (Resource Group %q): `properties` was nil", hubName, resourceGroup)
+	}
+
+	connections := make([]network.HubVirtualNetworkConnection, 0)
+	if existing := hub.VirtualHubProperties.HubVirtualNetworkConnections; existing != nil {
+		connections = *existing
+	}
+
+	newConnection := network.HubVirtualNetworkConnection{
+		Name: utils.String(name),
+		HubVirtualNetworkConnectionProperties: &network.HubVirtualNetworkConnectionProperties{
+			RemoteVirtualNetwork: &network.SubResource{
+				ID: utils.String(d.Get("remote_virtual_network_id").(string)),
+			},
+			AllowHubToRemoteVnetTransit:         utils.Bool(d.Get("allow_hub_to_remote_virtual_network_transit").(bool)),
+			AllowRemoteVnetToUseHubVnetGateways: utils.Bool(d.Get("allow_remote_virtual_network_to_use_hub_gateways").(bool)),
+		},
+	}
+
+	index := findVirtualHubConnectionIndex(connections, name)
+	if d.IsNewResource() {
+		if index != -1 {
+			return nil, fmt.Errorf("A Connection named %q already exists in Virtual Hub %q (Resource Group %q) - to be managed via Terraform this resource needs to be imported into the State", name, hubName, resourceGroup)
+		}
+
+		connections = append(connections, newConnection)
+	} else {
+		if index == -1 {
+			return nil, fmt.Errorf("Error locating Connection %q (Virtual Hub %q / Resource Group %q)", name, hubName, resourceGroup)
+		}
+
+		connections[index] = newConnection
+	}
+
+	hub.VirtualHubProperties.HubVirtualNetworkConnections = &connections
+	return &hub, nil
+}
+
+// expandArmVirtualHubWithoutConnection retrieves the Virtual Hub and returns it with the named Connection removed -
+// or nil if either the Virtual Hub or the Connection no longer exists, in which case there's nothing to delete
+func expandArmVirtualHubWithoutConnection(ctx context.Context, client network.VirtualHubsClient, resourceGroup, hubName, name string) (*network.VirtualHub, error) {
+	hub, err := client.Get(ctx, resourceGroup, hubName)
+	if err != nil {
+		if utils.ResponseWasNotFound(hub.Response) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("Error retrieving Virtual Hub %q (Resource Group %q): %+v", hubName, resourceGroup, err)
+	}
+
+	props := hub.VirtualHubProperties
+	if props == nil || props.HubVirtualNetworkConnections == nil {
+		return nil, nil
+	}
+
+	connections := make([]network.HubVirtualNetworkConnection, 0)
+	for _, connection := range *props.HubVirtualNetworkConnections {
+		if connection.Name != nil && *connection.Name == name {
+			continue
+		}
+
+		connections = append(connections, connection)
+	}
+
+	if len(connections) == len(*props.HubVirtualNetworkConnections) {
+		// the connection's already gone
+		return nil, nil
+	}
+
+	props.HubVirtualNetworkConnections = &connections
+	return &hub, nil
+}
+
+func findVirtualHubConnectionIndex(connections []network.HubVirtualNetworkConnection, name string) int {
+	for i, v := range connections {
+		if v.Name == nil {
+			continue
+		}
+
+		if *v.Name == name {
+			return i
+		}
+	}
+
+	return -1
+}
@@ -0,0 +1,347 @@
+package azurerm
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-04-01/network"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMVirtualHubConnection_basic(t *testing.T) {
+	resourceName := "azurerm_virtual_hub_connection.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMVirtualHubConnection_basic(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMVirtualHubConnectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualHubConnectionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "allow_hub_to_remote_virtual_network_transit", "false"),
+					resource.TestCheckResourceAttr(resourceName, "allow_remote_virtual_network_to_use_hub_gateways", "false"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMVirtualHubConnection_update(t *testing.T) {
+	resourceName := "azurerm_virtual_hub_connection.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMVirtualHubConnectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMVirtualHubConnection_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualHubConnectionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "allow_hub_to_remote_virtual_network_transit", "false"),
+				),
+			},
+			{
+				Config: testAccAzureRMVirtualHubConnection_complete(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualHubConnectionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "allow_hub_to_remote_virtual_network_transit", "true"),
+					resource.TestCheckResourceAttr(resourceName, "allow_remote_virtual_network_to_use_hub_gateways", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMVirtualHubConnection_hubUpdate(t *testing.T) {
+	resourceName := "azurerm_virtual_hub_connection.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMVirtualHubConnectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMVirtualHubConnection_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualHubConnectionExists(resourceName),
+				),
+			},
+			{
+				// updating the Virtual Hub mustn't remove the connection
+				Config: testAccAzureRMVirtualHubConnection_hubTags(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualHubConnectionExists(resourceName),
+					resource.TestCheckResourceAttr("azurerm_virtual_hub.test", "tags.%", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestExpandArmVirtualHubConnections(t *testing.T) {
+	// the Connections within the Virtual Hub, which are changed by other operations between the requests
+	existing := []string{"first"}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		connections := make([]network.HubVirtualNetworkConnection, 0)
+		for _, name := range existing {
+			connections = append(connections, network.HubVirtualNetworkConnection{Name: utils.String(name)})
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		hub := network.VirtualHub{
+			Name: utils.String("hub1"),
+			VirtualHubProperties: &network.VirtualHubProperties{
+				HubVirtualNetworkConnections: &connections,
+			},
+		}
+		if err := json.NewEncoder(w).Encode(hub); err != nil {
+			t.Errorf("Error encoding the Virtual Hub: %+v", err)
+		}
+	}))
+	defer server.Close()
+
+	client := network.NewVirtualHubsClientWithBaseURI(server.URL, "00000000-0000-0000-0000-000000000000")
+	ctx := context.Background()
+
+	d := schema.TestResourceDataRaw(t, resourceArmVirtualHubConnection().Schema, map[string]interface{}{
+		"name":                      "example",
+		"virtual_hub_id":            "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/virtualHubs/hub1",
+		"remote_virtual_network_id": "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1",
+	})
+	d.MarkNewResource()
+
+	hub, err := expandArmVirtualHubWithConnection(ctx, d, client, "group1", "hub1")
+	if err != nil {
+		t.Fatalf("Expected no error but got: %+v", err)
+	}
+	if actual, expected := testVirtualHubConnectionNames(hub), []string{"first", "example"}; !reflect.DeepEqual(expected, actual) {
+		t.Fatalf("Expected the Connections %+v but got %+v", expected, actual)
+	}
+
+	// a Connection made whilst waiting for an in-flight operation is included when the hub is expanded again
+	existing = []string{"first", "second"}
+	hub, err = expandArmVirtualHubWithConnection(ctx, d, client, "group1", "hub1")
+	if err != nil {
+		t.Fatalf("Expected no error but got: %+v", err)
+	}
+	if actual, expected := testVirtualHubConnectionNames(hub), []string{"first", "second", "example"}; !reflect.DeepEqual(expected, actual) {
+		t.Fatalf("Expected the Connections %+v but got %+v", expected, actual)
+	}
+
+	existing = []string{"first", "example", "third"}
+	hub, err = expandArmVirtualHubWithoutConnection(ctx, client, "group1", "hub1", "example")
+	if err != nil {
+		t.Fatalf("Expected no error but got: %+v", err)
+	}
+	if actual, expected := testVirtualHubConnectionNames(hub), []string{"first", "third"}; !reflect.DeepEqual(expected, actual) {
+		t.Fatalf("Expected the Connections %+v but got %+v", expected, actual)
+	}
+
+	// once the Connection's been removed there's nothing to update
+	existing = []string{"first", "third"}
+	hub, err = expandArmVirtualHubWithoutConnection(ctx, client, "group1", "hub1", "example")
+	if err != nil {
+		t.Fatalf("Expected no error but got: %+v", err)
+	}
+	if hub != nil {
+		t.Fatalf("Expected no Virtual Hub to be returned but got the Connections %+v", testVirtualHubConnectionNames(hub))
+	}
+}
+
+func testVirtualHubConnectionNames(hub *network.VirtualHub) []string {
+	names := make([]string, 0)
+	if hub == nil || hub.VirtualHubProperties == nil || hub.VirtualHubProperties.HubVirtualNetworkConnections == nil {
+		return names
+	}
+
+	for _, connection := range *hub.VirtualHubProperties.HubVirtualNetworkConnections {
+		if connection.Name != nil {
+			names = append(names, *connection.Name)
+		}
+	}
+
+	return names
+}
+
+func testCheckAzureRMVirtualHubConnectionExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		id, err := azure.ParseVirtualHubConnectionID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).virtualHubClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+		resp, err := client.Get(ctx, id.ResourceGroup, id.VirtualHub)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Virtual Hub %q (Resource Group %q) does not exist", id.VirtualHub, id.ResourceGroup)
+			}
+
+			return fmt.Errorf("Bad: Get on virtualHubClient: %+v", err)
+		}
+
+		if props := resp.VirtualHubProperties; props != nil && props.HubVirtualNetworkConnections != nil {
+			if findVirtualHubConnectionIndex(*props.HubVirtualNetworkConnections, id.Name) != -1 {
+				return nil
+			}
+		}
+
+		return fmt.Errorf("Bad: Connection %q does not exist in Virtual Hub %q (Resource Group %q)", id.Name, id.VirtualHub, id.ResourceGroup)
+	}
+}
+
+func testCheckAzureRMVirtualHubConnectionDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).virtualHubClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_virtual_hub_connection" {
+			continue
+		}
+
+		id, err := azure.ParseVirtualHubConnectionID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		resp, err := client.Get(ctx, id.ResourceGroup, id.VirtualHub)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil
+			}
+
+			return err
+		}
+
+		if props := resp.VirtualHubProperties; props != nil && props.HubVirtualNetworkConnections != nil {
+			if findVirtualHubConnectionIndex(*props.HubVirtualNetworkConnections, id.Name) != -1 {
+				return fmt.Errorf("Connection %q still exists in Virtual Hub %q (Resource Group %q)", id.Name, id.VirtualHub, id.ResourceGroup)
+			}
+		}
+	}
+
+	return nil
+}
+
+func testAccAzureRMVirtualHubConnection_basic(rInt int, location string) string {
+	template := testAccAzureRMVirtualHubConnection_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_hub" "test" {
+  name                = "acctestvhub-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  virtual_wan_id      = "${azurerm_virtual_wan.test.id}"
+  address_prefix      = "10.0.1.0/24"
+}
+
+resource "azurerm_virtual_hub_connection" "test" {
+  name                      = "acctestvhubconn-%d"
+  virtual_hub_id            = "${azurerm_virtual_hub.test.id}"
+  remote_virtual_network_id = "${azurerm_virtual_network.test.id}"
+}
+`, template, rInt, rInt)
+}
+
+func testAccAzureRMVirtualHubConnection_complete(rInt int, location string) string {
+	template := testAccAzureRMVirtualHubConnection_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_hub" "test" {
+  name                = "acctestvhub-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  virtual_wan_id      = "${azurerm_virtual_wan.test.id}"
+  address_prefix      = "10.0.1.0/24"
+}
+
+resource "azurerm_virtual_hub_connection" "test" {
+  name                                             = "acctestvhubconn-%d"
+  virtual_hub_id                                   = "${azurerm_virtual_hub.test.id}"
+  remote_virtual_network_id                        = "${azurerm_virtual_network.test.id}"
+  allow_hub_to_remote_virtual_network_transit      = true
+  allow_remote_virtual_network_to_use_hub_gateways = true
+}
+`, template, rInt, rInt)
+}
+
+func testAccAzureRMVirtualHubConnection_hubTags(rInt int, location string) string {
+	template := testAccAzureRMVirtualHubConnection_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_hub" "test" {
+  name                = "acctestvhub-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  virtual_wan_id      = "${azurerm_virtual_wan.test.id}"
+  address_prefix      = "10.0.1.0/24"
+
+  tags {
+    environment = "Production"
+  }
+}
+
+resource "azurerm_virtual_hub_connection" "test" {
+  name                      = "acctestvhubconn-%d"
+  virtual_hub_id            = "${azurerm_virtual_hub.test.id}"
+  remote_virtual_network_id = "${azurerm_virtual_network.test.id}"
+}
+`, template, rInt, rInt)
+}
+
+func testAccAzureRMVirtualHubConnection_template(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctestvirtnet%d"
+  address_space       = ["172.16.0.0/16"]
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+
+resource "azurerm_virtual_wan" "test" {
+  name                = "acctestvwan-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+`, rInt, location, rInt, rInt)
+}
@@ -6,6 +6,7 @@ import (
 	"log"
 	"net/url"
 	"strings"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2018-06-01/compute"
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-04-01/network"
@@ -13,6 +14,9 @@ import (
 	"github.com/hashicorp/terraform/helper/hashcode"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/polling"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 	"golang.org/x/net/context"
 )
@@ -26,7 +30,14 @@ func resourceArmVirtualMachine() *schema.Resource {
 		Update: resourceArmVirtualMachineCreate,
 		Delete: resourceArmVirtualMachineDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: azure.ImportVirtualMachineID,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -133,9 +144,10 @@ func resourceArmVirtualMachine() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"id": {
-							Type:     schema.TypeString,
-							Optional: true,
-							ForceNew: true,
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ValidateFunc: azure.ValidateImageReferenceID,
 						},
 
 						"publisher": {
@@ -331,6 +343,13 @@ func resourceArmVirtualMachine() *schema.Resource {
 				Default:  false,
 			},
 
+			"manage_data_disks_externally": {
+				Type:          schema.TypeBool,
+				Optional:      true,
+				Default:       false,
+				ConflictsWith: []string{"storage_data_disk"},
+			},
+
 			"boot_diagnostics": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -509,8 +528,9 @@ func resourceArmVirtualMachine() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"source_vault_id": {
-							Type:     schema.TypeString,
-							Required: true,
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: azure.ValidateKeyVaultID,
 						},
 
 						"vault_certificates": {
@@ -542,8 +562,9 @@ func resourceArmVirtualMachine() *schema.Resource {
 			},
 
 			"primary_network_interface_id": {
-				Type:     schema.TypeString,
-				Optional: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: azure.ValidateNetworkInterfaceID,
 			},
 
 			"tags": tagsSchema(),
@@ -553,7 +574,8 @@ func resourceArmVirtualMachine() *schema.Resource {
 
 func resourceArmVirtualMachineCreate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).vmClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for Azure ARM Virtual Machine creation.")
 
@@ -561,7 +583,7 @@ func resourceArmVirtualMachineCreate(d *schema.ResourceData, meta interface{}) e
 	location := azureRMNormalizeLocation(d.Get("location").(string))
 	resGroup := d.Get("resource_group_name").(string)
 	tags := d.Get("tags").(map[string]interface{})
-	expandedTags := expandTags(tags)
+	expandedTags := expandTags(tags, meta)
 	zones := expandZones(d.Get("zones").([]interface{}))
 
 	osDisk, err := expandAzureRmVirtualMachineOsDisk(d)
@@ -580,7 +602,8 @@ func resourceArmVirtualMachineCreate(d *schema.ResourceData, meta interface{}) e
 		storageProfile.ImageReference = imageRef
 	}
 
-	if _, ok := d.GetOk("storage_data_disk"); ok {
+	manageDataDisksExternally := d.Get("manage_data_disks_externally").(bool)
+	if _, ok := d.GetOk("storage_data_disk"); ok && !manageDataDisksExternally {
 		dataDisks, err := expandAzureRmVirtualMachineDataDisk(d)
 		if err != nil {
 			return err
@@ -652,12 +675,69 @@ func resourceArmVirtualMachineCreate(d *schema.ResourceData, meta interface{}) e
 	azureRMLockByName(name, virtualMachineResourceName)
 	defer azureRMUnlockByName(name, virtualMachineResourceName)
 
+	// whether the Virtual Machine was deallocated to update the OS Disk, and needs to be started again afterwards
+	restartRequired := false
+
+	if !d.IsNewResource() {
+		if manageDataDisksExternally {
+			existing, err := client.Get(ctx, resGroup, name, "")
+			if err != nil {
+				return fmt.Errorf("Error retrieving Virtual Machine %q (Resource Group %q): %+v", name, resGroup, err)
+			}
+
+			// the Data Disks are attached via the `azurerm_virtual_machine_data_disk_attachment` resource (or outside of
+			// Terraform) - as such we retain the Data Disks currently attached, rather than detaching them
+			if props := existing.VirtualMachineProperties; props != nil && props.StorageProfile != nil {
+				storageProfile.DataDisks = props.StorageProfile.DataDisks
+			}
+		}
+
+		// resizing the OS Disk or changing its type requires the Virtual Machine to be deallocated
+		if d.HasChange("storage_os_disk.0.disk_size_gb") || d.HasChange("storage_os_disk.0.managed_disk_type") {
+			powerState, err := azureRmVirtualMachinePowerState(ctx, meta, resGroup, name)
+			if err != nil {
+				return err
+			}
+
+			// a stopped Virtual Machine remains allocated, but is only started afterwards if it was running
+			if powerState != "deallocated" {
+				if err := deallocateAzureRmVirtualMachine(ctx, meta, resGroup, name); err != nil {
+					return err
+				}
+				restartRequired = powerState == "running" || powerState == "starting"
+			}
+
+			// unmanaged OS Disks are resized as a part of the Virtual Machine update below
+			if osDisk.ManagedDisk != nil && osDisk.ManagedDisk.ID != nil {
+				if err := updateAzureRmVirtualMachineManagedOsDisk(ctx, d, meta, *osDisk.ManagedDisk.ID); err != nil {
+					// the Virtual Machine is started regardless of whether the update was successful, to avoid leaving it offline
+					if restartRequired {
+						if startErr := startAzureRmVirtualMachine(ctx, meta, resGroup, name); startErr != nil {
+							log.Printf("[DEBUG] Error updating the OS Disk for Virtual Machine %q (Resource Group %q): %+v", name, resGroup, err)
+							return startErr
+						}
+					}
+
+					return err
+				}
+			}
+		}
+	}
+
 	future, err := client.CreateOrUpdate(ctx, resGroup, name, vm)
-	if err != nil {
-		return err
+	if err == nil {
+		err = polling.WaitForCompletion(ctx, &future.Future, client.Client)
+	}
+
+	if restartRequired {
+		if startErr := startAzureRmVirtualMachine(ctx, meta, resGroup, name); startErr != nil {
+			if err != nil {
+				log.Printf("[DEBUG] Error updating Virtual Machine %q (Resource Group %q): %+v", name, resGroup, err)
+			}
+			return startErr
+		}
 	}
 
-	err = future.WaitForCompletionRef(ctx, client.Client)
 	if err != nil {
 		return err
 	}
@@ -695,7 +775,8 @@ func resourceArmVirtualMachineCreate(d *schema.ResourceData, meta interface{}) e
 
 func resourceArmVirtualMachineRead(d *schema.ResourceData, meta interface{}) error {
 	vmClient := meta.(*ArmClient).vmClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
@@ -741,7 +822,7 @@ func resourceArmVirtualMachineRead(d *schema.ResourceData, meta interface{}) err
 	}
 
 	if osDisk := resp.VirtualMachineProperties.StorageProfile.OsDisk; osDisk != nil {
-		diskInfo, err := resourceArmVirtualMachineGetManagedDiskInfo(osDisk.ManagedDisk, meta)
+		diskInfo, err := resourceArmVirtualMachineGetManagedDiskInfo(ctx, osDisk.ManagedDisk, meta)
 		if err != nil {
 			return fmt.Errorf("[DEBUG] Error getting managed OS disk detailed information: %#v", err)
 		}
@@ -750,10 +831,15 @@ func resourceArmVirtualMachineRead(d *schema.ResourceData, meta interface{}) err
 		}
 	}
 
-	if dataDisks := resp.VirtualMachineProperties.StorageProfile.DataDisks; dataDisks != nil {
+	if d.Get("manage_data_disks_externally").(bool) {
+		// the Data Disks are managed via the `azurerm_virtual_machine_data_disk_attachment` resource
+		if err := d.Set("storage_data_disk", make([]interface{}, 0)); err != nil {
+			return fmt.Errorf("[DEBUG] Error setting Virtual Machine Storage Data Disks error: %#v", err)
+		}
+	} else if dataDisks := resp.VirtualMachineProperties.StorageProfile.DataDisks; dataDisks != nil {
 		disksInfo := make([]*compute.Disk, len(*dataDisks))
 		for i, dataDisk := range *dataDisks {
-			diskInfo, err := resourceArmVirtualMachineGetManagedDiskInfo(dataDisk.ManagedDisk, meta)
+			diskInfo, err := resourceArmVirtualMachineGetManagedDiskInfo(ctx, dataDisk.ManagedDisk, meta)
 			if err != nil {
 				return fmt.Errorf("[DEBUG] Error getting managed data disk detailed information: %#v", err)
 			}
@@ -811,14 +897,15 @@ func resourceArmVirtualMachineRead(d *schema.ResourceData, meta interface{}) err
 		}
 	}
 
-	flattenAndSetTags(d, resp.Tags)
+	flattenAndSetResourceTags(d, resp.Tags, meta)
 
 	return nil
 }
 
 func resourceArmVirtualMachineDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).vmClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
@@ -835,7 +922,7 @@ func resourceArmVirtualMachineDelete(d *schema.ResourceData, meta interface{}) e
 		return err
 	}
 
-	err = future.WaitForCompletionRef(ctx, client.Client)
+	err = polling.WaitForCompletion(ctx, &future.Future, client.Client)
 	if err != nil {
 		return err
 	}
@@ -851,13 +938,13 @@ func resourceArmVirtualMachineDelete(d *schema.ResourceData, meta interface{}) e
 
 		if osDisk.Vhd != nil {
 			if osDisk.Vhd.URI != nil {
-				if err = resourceArmVirtualMachineDeleteVhd(*osDisk.Vhd.URI, meta); err != nil {
+				if err = resourceArmVirtualMachineDeleteVhd(ctx, *osDisk.Vhd.URI, meta); err != nil {
 					return fmt.Errorf("Error deleting OS Disk VHD: %+v", err)
 				}
 			}
 		} else if osDisk.ManagedDisk != nil {
 			if osDisk.ManagedDisk.ID != nil {
-				if err = resourceArmVirtualMachineDeleteManagedDisk(*osDisk.ManagedDisk.ID, meta); err != nil {
+				if err = resourceArmVirtualMachineDeleteManagedDisk(ctx, *osDisk.ManagedDisk.ID, meta); err != nil {
 					return fmt.Errorf("Error deleting OS Managed Disk: %+v", err)
 				}
 			}
@@ -877,11 +964,11 @@ func resourceArmVirtualMachineDelete(d *schema.ResourceData, meta interface{}) e
 
 		for _, disk := range disks {
 			if disk.Vhd != nil {
-				if err = resourceArmVirtualMachineDeleteVhd(*disk.Vhd.URI, meta); err != nil {
+				if err = resourceArmVirtualMachineDeleteVhd(ctx, *disk.Vhd.URI, meta); err != nil {
 					return fmt.Errorf("Error deleting Data Disk VHD: %+v", err)
 				}
 			} else if disk.ManagedDisk != nil {
-				if err = resourceArmVirtualMachineDeleteManagedDisk(*disk.ManagedDisk.ID, meta); err != nil {
+				if err = resourceArmVirtualMachineDeleteManagedDisk(ctx, *disk.ManagedDisk.ID, meta); err != nil {
 					return fmt.Errorf("Error deleting Data Managed Disk: %+v", err)
 				}
 			} else {
@@ -893,9 +980,8 @@ func resourceArmVirtualMachineDelete(d *schema.ResourceData, meta interface{}) e
 	return nil
 }
 
-func resourceArmVirtualMachineDeleteVhd(uri string, meta interface{}) error {
+func resourceArmVirtualMachineDeleteVhd(ctx context.Context, uri string, meta interface{}) error {
 	armClient := meta.(*ArmClient)
-	ctx := armClient.StopContext
 	environment := armClient.environment
 
 	vhdURL, err := url.Parse(uri)
@@ -914,7 +1000,7 @@ func resourceArmVirtualMachineDeleteVhd(uri string, meta interface{}) error {
 	containerName := path[0]
 	blobName := path[1]
 
-	resourceGroupName, err := findStorageAccountResourceGroup(meta, storageAccountName)
+	resourceGroupName, err := findStorageAccountResourceGroup(ctx, meta, storageAccountName)
 	if err != nil {
 		return fmt.Errorf("Error finding resource group for storage account %s: %+v", storageAccountName, err)
 	}
@@ -941,9 +1027,8 @@ func resourceArmVirtualMachineDeleteVhd(uri string, meta interface{}) error {
 	return nil
 }
 
-func resourceArmVirtualMachineDeleteManagedDisk(managedDiskID string, meta interface{}) error {
+func resourceArmVirtualMachineDeleteManagedDisk(ctx context.Context, managedDiskID string, meta interface{}) error {
 	client := meta.(*ArmClient).diskClient
-	ctx := meta.(*ArmClient).StopContext
 
 	id, err := parseAzureResourceID(managedDiskID)
 	if err != nil {
@@ -957,7 +1042,7 @@ func resourceArmVirtualMachineDeleteManagedDisk(managedDiskID string, meta inter
 		return fmt.Errorf("Error deleting Managed Disk (%s %s) %+v", name, resGroup, err)
 	}
 
-	err = future.WaitForCompletionRef(ctx, client.Client)
+	err = polling.WaitForCompletion(ctx, &future.Future, client.Client)
 	if err != nil {
 		return fmt.Errorf("Error deleting Managed Disk (%s %s) %+v", name, resGroup, err)
 	}
@@ -1689,9 +1774,8 @@ func expandAzureRmVirtualMachineOsDisk(d *schema.ResourceData) (*compute.OSDisk,
 	return osDisk, nil
 }
 
-func findStorageAccountResourceGroup(meta interface{}, storageAccountName string) (string, error) {
+func findStorageAccountResourceGroup(ctx context.Context, meta interface{}, storageAccountName string) (string, error) {
 	client := meta.(*ArmClient).resourcesClient
-	ctx := meta.(*ArmClient).StopContext
 	filter := fmt.Sprintf("name eq '%s' and resourceType eq 'Microsoft.Storage/storageAccounts'", storageAccountName)
 	expand := ""
 	var pager *int32
@@ -1774,9 +1858,8 @@ func resourceArmVirtualMachineStorageImageReferenceHash(v interface{}) int {
 	return hashcode.String(buf.String())
 }
 
-func resourceArmVirtualMachineGetManagedDiskInfo(disk *compute.ManagedDiskParameters, meta interface{}) (*compute.Disk, error) {
+func resourceArmVirtualMachineGetManagedDiskInfo(ctx context.Context, disk *compute.ManagedDiskParameters, meta interface{}) (*compute.Disk, error) {
 	client := meta.(*ArmClient).diskClient
-	ctx := meta.(*ArmClient).StopContext
 
 	if disk == nil || disk.ID == nil {
 		return nil, nil
@@ -1874,3 +1957,103 @@ func determineVirtualMachineIPAddress(ctx context.Context, meta interface{}, pro
 
 	return "", fmt.Errorf("No Public or Private IP Address found on the Primary Network Interface")
 }
+
+// azureRmVirtualMachinePowerState returns the Power State of the Virtual Machine (e.g. `running` or `deallocated`)
+func azureRmVirtualMachinePowerState(ctx context.Context, meta interface{}, resourceGroup, name string) (string, error) {
+	client := meta.(*ArmClient).vmClient
+
+	instanceView, err := client.InstanceView(ctx, resourceGroup, name)
+	if err != nil {
+		return "", fmt.Errorf("Error retrieving the Instance View of Virtual Machine %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if statuses := instanceView.Statuses; statuses != nil {
+		for _, status := range *statuses {
+			if status.Code == nil {
+				continue
+			}
+
+			code := strings.ToLower(*status.Code)
+			if strings.HasPrefix(code, "powerstate/") {
+				return strings.TrimPrefix(code, "powerstate/"), nil
+			}
+		}
+	}
+
+	return "", nil
+}
+
+func deallocateAzureRmVirtualMachine(ctx context.Context, meta interface{}, resourceGroup, name string) error {
+	client := meta.(*ArmClient).vmClient
+
+	log.Printf("[DEBUG] Deallocating Virtual Machine %q (Resource Group %q)..", name, resourceGroup)
+	future, err := client.Deallocate(ctx, resourceGroup, name)
+	if err != nil {
+		return fmt.Errorf("Error deallocating Virtual Machine %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if err := polling.WaitForCompletion(ctx, &future.Future, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for Virtual Machine %q (Resource Group %q) to be deallocated: %+v", name, resourceGroup, err)
+	}
+
+	log.Printf("[DEBUG] Deallocated Virtual Machine %q (Resource Group %q).", name, resourceGroup)
+	return nil
+}
+
+func startAzureRmVirtualMachine(ctx context.Context, meta interface{}, resourceGroup, name string) error {
+	client := meta.(*ArmClient).vmClient
+
+	log.Printf("[DEBUG] Starting Virtual Machine %q (Resource Group %q)..", name, resourceGroup)
+	future, err := client.Start(ctx, resourceGroup, name)
+	if err != nil {
+		return fmt.Errorf("Error starting Virtual Machine %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if err := polling.WaitForCompletion(ctx, &future.Future, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for Virtual Machine %q (Resource Group %q) to start: %+v", name, resourceGroup, err)
+	}
+
+	log.Printf("[DEBUG] Started Virtual Machine %q (Resource Group %q).", name, resourceGroup)
+	return nil
+}
+
+// updateAzureRmVirtualMachineManagedOsDisk updates the size and/or type of the Managed Disk used as the OS Disk,
+// which the Virtual Machine must be deallocated for
+func updateAzureRmVirtualMachineManagedOsDisk(ctx context.Context, d *schema.ResourceData, meta interface{}, managedDiskId string) error {
+	client := meta.(*ArmClient).diskClient
+
+	id, err := azure.ParseManagedDiskID(managedDiskId)
+	if err != nil {
+		return err
+	}
+
+	diskUpdate := compute.DiskUpdate{
+		DiskUpdateProperties: &compute.DiskUpdateProperties{},
+	}
+
+	if d.HasChange("storage_os_disk.0.disk_size_gb") {
+		if v := d.Get("storage_os_disk.0.disk_size_gb").(int); v != 0 {
+			diskUpdate.DiskUpdateProperties.DiskSizeGB = utils.Int32(int32(v))
+		}
+	}
+
+	if d.HasChange("storage_os_disk.0.managed_disk_type") {
+		if v := d.Get("storage_os_disk.0.managed_disk_type").(string); v != "" {
+			diskUpdate.Sku = &compute.DiskSku{
+				Name: expandAzureRmManagedDiskStorageAccountType(v),
+			}
+		}
+	}
+
+	log.Printf("[DEBUG] Updating OS Disk %q (Resource Group %q)..", id.Name, id.ResourceGroup)
+	future, err := client.Update(ctx, id.ResourceGroup, id.Name, diskUpdate)
+	if err != nil {
+		return fmt.Errorf("Error updating OS Disk %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+	}
+
+	if err := polling.WaitForCompletion(ctx, &future.Future, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for OS Disk %q (Resource Group %q) to be updated: %+v", id.Name, id.ResourceGroup, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,920 @@
+package azurerm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2018-06-01/compute"
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/polling"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+const (
+	azureDiskEncryptionPublisher = "Microsoft.Azure.Security"
+
+	// these versions of the Azure Disk Encryption extensions don't require an Azure Active Directory application
+	azureDiskEncryptionLinuxType           = "AzureDiskEncryptionForLinux"
+	azureDiskEncryptionLinuxTypeVersion    = "1.1"
+	azureDiskEncryptionWindowsType         = "AzureDiskEncryption"
+	azureDiskEncryptionWindowsTypeVersion  = "2.2"
+	azureDiskEncryptionVolumeTypeAll       = "All"
+	azureDiskEncryptionVolumeTypeData      = "Data"
+	azureDiskEncryptionVolumeTypeOS        = "OS"
+	azureDiskEncryptionStateEncrypted      = "encrypted"
+	azureDiskEncryptionStateInProgress     = "encryptionInProgress"
+	azureDiskEncryptionOperationEnable     = "EnableEncryption"
+	azureDiskEncryptionOperationDisable    = "DisableEncryption"
+	azureDiskEncryptionDefaultKeyAlgorithm = "RSA-OAEP"
+)
+
+func resourceArmVirtualMachineDiskEncryption() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmVirtualMachineDiskEncryptionCreateUpdate,
+		Read:   resourceArmVirtualMachineDiskEncryptionRead,
+		Update: resourceArmVirtualMachineDiskEncryptionCreateUpdate,
+		Delete: resourceArmVirtualMachineDiskEncryptionDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceArmVirtualMachineDiskEncryptionImport,
+		},
+
+		// encrypting the OS Disk of a Linux Virtual Machine can take a considerable amount of time
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(90 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(90 * time.Minute),
+			Delete: schema.DefaultTimeout(90 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"virtual_machine_id": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: ignoreCaseDiffSuppressFunc,
+				ValidateFunc:     azure.ValidateVirtualMachineID,
+				ConflictsWith:    []string{"virtual_machine_scale_set_id"},
+			},
+
+			"virtual_machine_scale_set_id": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: ignoreCaseDiffSuppressFunc,
+				ValidateFunc:     azure.ValidateVirtualMachineScaleSetID,
+				ConflictsWith:    []string{"virtual_machine_id"},
+			},
+
+			"key_vault_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				DiffSuppressFunc: ignoreCaseDiffSuppressFunc,
+				ValidateFunc:     azure.ValidateKeyVaultID,
+			},
+
+			"key_encryption_key_url": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.URLIsHTTPS,
+			},
+
+			"key_encryption_key_vault_id": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				DiffSuppressFunc: ignoreCaseDiffSuppressFunc,
+				ValidateFunc:     azure.ValidateKeyVaultID,
+			},
+
+			"key_encryption_algorithm": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  azureDiskEncryptionDefaultKeyAlgorithm,
+				ValidateFunc: validation.StringInSlice([]string{
+					"RSA-OAEP",
+					"RSA-OAEP-256",
+					"RSA1_5",
+				}, false),
+			},
+
+			"volume_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  azureDiskEncryptionVolumeTypeAll,
+				ValidateFunc: validation.StringInSlice([]string{
+					azureDiskEncryptionVolumeTypeAll,
+					azureDiskEncryptionVolumeTypeData,
+					azureDiskEncryptionVolumeTypeOS,
+				}, false),
+			},
+
+			"os_disk": azureRmVirtualMachineDiskEncryptionStatusSchema(),
+
+			"data_disk": azureRmVirtualMachineDiskEncryptionStatusSchema(),
+		},
+	}
+}
+
+func azureRmVirtualMachineDiskEncryptionStatusSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+
+				"encryption_state": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+
+				"disk_encryption_key_secret_url": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+
+				"key_encryption_key_url": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+func resourceArmVirtualMachineDiskEncryptionCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	keyVaultClient := meta.(*ArmClient).keyVaultClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	keyVaultId, err := azure.ParseKeyVaultID(d.Get("key_vault_id").(string))
+	if err != nil {
+		return err
+	}
+
+	keyVault, err := keyVaultClient.Get(ctx, keyVaultId.ResourceGroup, keyVaultId.Name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Key Vault %q (Resource Group %q): %+v", keyVaultId.Name, keyVaultId.ResourceGroup, err)
+	}
+
+	if keyVault.Properties == nil || keyVault.Properties.VaultURI == nil {
+		return fmt.Errorf("Error: unable to determine the Vault URI of Key Vault %q (Resource Group %q)", keyVaultId.Name, keyVaultId.ResourceGroup)
+	}
+
+	if props := keyVault.Properties; props.EnabledForDiskEncryption == nil || !*props.EnabledForDiskEncryption {
+		return fmt.Errorf("Error: Key Vault %q (Resource Group %q) must have `enabled_for_disk_encryption` set to `true` to be used for Azure Disk Encryption", keyVaultId.Name, keyVaultId.ResourceGroup)
+	}
+
+	// every time the settings change the extension needs to be re-run to apply them
+	sequenceVersion, err := uuid.GenerateUUID()
+	if err != nil {
+		return fmt.Errorf("Error generating a Sequence Version for Azure Disk Encryption: %+v", err)
+	}
+
+	settings := map[string]interface{}{
+		"EncryptionOperation":    azureDiskEncryptionOperationEnable,
+		"KeyVaultURL":            *keyVault.Properties.VaultURI,
+		"KeyVaultResourceId":     keyVaultId.String(),
+		"KeyEncryptionAlgorithm": d.Get("key_encryption_algorithm").(string),
+		"VolumeType":             d.Get("volume_type").(string),
+		"SequenceVersion":        sequenceVersion,
+	}
+
+	if v := d.Get("key_encryption_key_url").(string); v != "" {
+		settings["KeyEncryptionKeyURL"] = v
+
+		kekVaultId := keyVaultId.String()
+		if v := d.Get("key_encryption_key_vault_id").(string); v != "" {
+			kekVaultId = v
+		}
+		settings["KekVaultResourceId"] = kekVaultId
+	}
+
+	if v, ok := d.GetOk("virtual_machine_id"); ok {
+		id, err := azure.ParseVirtualMachineID(v.(string))
+		if err != nil {
+			return err
+		}
+
+		return enableAzureRmVirtualMachineDiskEncryption(ctx, d, meta, *id, settings)
+	}
+
+	if v, ok := d.GetOk("virtual_machine_scale_set_id"); ok {
+		id, err := azure.ParseVirtualMachineScaleSetID(v.(string))
+		if err != nil {
+			return err
+		}
+
+		return enableAzureRmVirtualMachineScaleSetDiskEncryption(ctx, d, meta, *id, settings)
+	}
+
+	return fmt.Errorf("Error: either `virtual_machine_id` or `virtual_machine_scale_set_id` must be specified")
+}
+
+func enableAzureRmVirtualMachineDiskEncryption(ctx context.Context, d *schema.ResourceData, meta interface{}, id azure.VirtualMachineID, settings map[string]interface{}) error {
+	vmClient := meta.(*ArmClient).vmClient
+	client := meta.(*ArmClient).vmExtensionClient
+
+	azureRMLockByName(id.Name, virtualMachineResourceName)
+	defer azureRMUnlockByName(id.Name, virtualMachineResourceName)
+
+	virtualMachine, err := vmClient.Get(ctx, id.ResourceGroup, id.Name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(virtualMachine.Response) {
+			return fmt.Errorf("Virtual Machine %q (Resource Group %q) was not found", id.Name, id.ResourceGroup)
+		}
+
+		return fmt.Errorf("Error loading Virtual Machine %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+	}
+
+	osType := compute.OperatingSystemTypes("")
+	if props := virtualMachine.VirtualMachineProperties; props != nil && props.StorageProfile != nil && props.StorageProfile.OsDisk != nil {
+		osType = props.StorageProfile.OsDisk.OsType
+	}
+
+	extensionType, typeHandlerVersion, err := azureDiskEncryptionExtensionForOSType(osType)
+	if err != nil {
+		return fmt.Errorf("Error determining the Azure Disk Encryption Extension for Virtual Machine %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+	}
+
+	extension := compute.VirtualMachineExtension{
+		Location: virtualMachine.Location,
+		VirtualMachineExtensionProperties: &compute.VirtualMachineExtensionProperties{
+			Publisher:               utils.String(azureDiskEncryptionPublisher),
+			Type:                    utils.String(extensionType),
+			TypeHandlerVersion:      utils.String(typeHandlerVersion),
+			AutoUpgradeMinorVersion: utils.Bool(true),
+			Settings:                &settings,
+		},
+	}
+
+	log.Printf("[DEBUG] Enabling Azure Disk Encryption on Virtual Machine %q (Resource Group %q)..", id.Name, id.ResourceGroup)
+	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.Name, extensionType, extension)
+	if err != nil {
+		return fmt.Errorf("Error enabling Azure Disk Encryption on Virtual Machine %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+	}
+
+	if err = polling.WaitForCompletion(ctx, &future.Future, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for Azure Disk Encryption to be enabled on Virtual Machine %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+	}
+
+	timeout := d.Timeout(schema.TimeoutUpdate)
+	if d.IsNewResource() {
+		timeout = d.Timeout(schema.TimeoutCreate)
+	}
+
+	log.Printf("[DEBUG] Waiting for the Disks of Virtual Machine %q (Resource Group %q) to be encrypted..", id.Name, id.ResourceGroup)
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"InProgress"},
+		Target:     []string{"Completed"},
+		Refresh:    virtualMachineDiskEncryptionStateRefreshFunc(ctx, meta, id.ResourceGroup, id.Name, extensionType),
+		Timeout:    timeout,
+		MinTimeout: 30 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for the Disks of Virtual Machine %q (Resource Group %q) to be encrypted: %+v", id.Name, id.ResourceGroup, err)
+	}
+
+	volumeType := settings["VolumeType"].(string)
+	if volumeType != azureDiskEncryptionVolumeTypeData {
+		osDisk, _, err := retrieveAzureRmVirtualMachineDiskEncryptionStatus(ctx, meta, id.ResourceGroup, id.Name)
+		if err != nil {
+			return err
+		}
+
+		if osDisk == nil || osDisk["encryption_state"] != azureDiskEncryptionStateEncrypted {
+			return fmt.Errorf("Error: Azure Disk Encryption completed but the OS Disk of Virtual Machine %q (Resource Group %q) was not encrypted", id.Name, id.ResourceGroup)
+		}
+	}
+
+	read, err := client.Get(ctx, id.ResourceGroup, id.Name, extensionType, "")
+	if err != nil {
+		return fmt.Errorf("Error retrieving Azure Disk Encryption Extension for Virtual Machine %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+	}
+
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read Azure Disk Encryption Extension for Virtual Machine %q (Resource Group %q) ID", id.Name, id.ResourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmVirtualMachineDiskEncryptionRead(d, meta)
+}
+
+func enableAzureRmVirtualMachineScaleSetDiskEncryption(ctx context.Context, d *schema.ResourceData, meta interface{}, id azure.VirtualMachineScaleSetID, settings map[string]interface{}) error {
+	vmssClient := meta.(*ArmClient).vmScaleSetClient
+	client := meta.(*ArmClient).vmScaleSetExtensionsClient
+
+	azureRMLockByName(id.Name, virtualMachineScaleSetResourceName)
+	defer azureRMUnlockByName(id.Name, virtualMachineScaleSetResourceName)
+
+	scaleSet, err := vmssClient.Get(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(scaleSet.Response) {
+			return fmt.Errorf("Virtual Machine Scale Set %q (Resource Group %q) was not found", id.Name, id.ResourceGroup)
+		}
+
+		return fmt.Errorf("Error loading Virtual Machine Scale Set %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+	}
+
+	osType := compute.OperatingSystemTypes("")
+	upgradeMode := compute.UpgradeMode("")
+	if props := scaleSet.VirtualMachineScaleSetProperties; props != nil {
+		if profile := props.VirtualMachineProfile; profile != nil && profile.StorageProfile != nil && profile.StorageProfile.OsDisk != nil {
+			osType = profile.StorageProfile.OsDisk.OsType
+		}
+
+		if policy := props.UpgradePolicy; policy != nil {
+			upgradeMode = policy.Mode
+		}
+	}
+
+	extensionType, typeHandlerVersion, err := azureDiskEncryptionExtensionForOSType(osType)
+	if err != nil {
+		return fmt.Errorf("Error determining the Azure Disk Encryption Extension for Virtual Machine Scale Set %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+	}
+
+	extension := compute.VirtualMachineScaleSetExtension{
+		Name: utils.String(extensionType),
+		VirtualMachineScaleSetExtensionProperties: &compute.VirtualMachineScaleSetExtensionProperties{
+			Publisher:               utils.String(azureDiskEncryptionPublisher),
+			Type:                    utils.String(extensionType),
+			TypeHandlerVersion:      utils.String(typeHandlerVersion),
+			AutoUpgradeMinorVersion: utils.Bool(true),
+			Settings:                &settings,
+		},
+	}
+
+	log.Printf("[DEBUG] Enabling Azure Disk Encryption on Virtual Machine Scale Set %q (Resource Group %q)..", id.Name, id.ResourceGroup)
+	future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.Name, extensionType, extension)
+	if err != nil {
+		return fmt.Errorf("Error enabling Azure Disk Encryption on Virtual Machine Scale Set %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+	}
+
+	if err = polling.WaitForCompletion(ctx, &future.Future, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for Azure Disk Encryption to be enabled on Virtual Machine Scale Set %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+	}
+
+	// when the Upgrade Policy is Manual the instances need to be upgraded before they're encrypted
+	if upgradeMode == compute.Manual {
+		if err := upgradeAzureRmVirtualMachineScaleSetInstances(ctx, meta, id.ResourceGroup, id.Name); err != nil {
+			return err
+		}
+	}
+
+	timeout := d.Timeout(schema.TimeoutUpdate)
+	if d.IsNewResource() {
+		timeout = d.Timeout(schema.TimeoutCreate)
+	}
+
+	log.Printf("[DEBUG] Waiting for the instances of Virtual Machine Scale Set %q (Resource Group %q) to be encrypted..", id.Name, id.ResourceGroup)
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"InProgress"},
+		Target:     []string{"Completed"},
+		Refresh:    virtualMachineScaleSetDiskEncryptionStateRefreshFunc(ctx, meta, id.ResourceGroup, id.Name, extensionType),
+		Timeout:    timeout,
+		MinTimeout: 30 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for the instances of Virtual Machine Scale Set %q (Resource Group %q) to be encrypted: %+v", id.Name, id.ResourceGroup, err)
+	}
+
+	volumeType := settings["VolumeType"].(string)
+	if volumeType != azureDiskEncryptionVolumeTypeData {
+		osDisks, _, err := retrieveAzureRmVirtualMachineScaleSetDiskEncryptionStatus(ctx, meta, id.ResourceGroup, id.Name)
+		if err != nil {
+			return err
+		}
+
+		for _, v := range osDisks {
+			osDisk := v.(map[string]interface{})
+			if osDisk["encryption_state"] != azureDiskEncryptionStateEncrypted {
+				return fmt.Errorf("Error: Azure Disk Encryption completed but the OS Disk %q of Virtual Machine Scale Set %q (Resource Group %q) was not encrypted", osDisk["name"], id.Name, id.ResourceGroup)
+			}
+		}
+	}
+
+	read, err := client.Get(ctx, id.ResourceGroup, id.Name, extensionType, "")
+	if err != nil {
+		return fmt.Errorf("Error retrieving Azure Disk Encryption Extension for Virtual Machine Scale Set %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+	}
+
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read Azure Disk Encryption Extension for Virtual Machine Scale Set %q (Resource Group %q) ID", id.Name, id.ResourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmVirtualMachineDiskEncryptionRead(d, meta)
+}
+
+func resourceArmVirtualMachineDiskEncryptionRead(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	var settings interface{}
+
+	if id, err := azure.ParseVirtualMachineExtensionID(d.Id()); err == nil {
+		client := meta.(*ArmClient).vmExtensionClient
+
+		resp, err := client.Get(ctx, id.ResourceGroup, id.VirtualMachine, id.Name, "")
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				log.Printf("[DEBUG] Azure Disk Encryption Extension %q (Virtual Machine %q / Resource Group %q) was not found - removing from state", id.Name, id.VirtualMachine, id.ResourceGroup)
+				d.SetId("")
+				return nil
+			}
+
+			return fmt.Errorf("Error making Read request on Azure Disk Encryption Extension %q (Virtual Machine %q / Resource Group %q): %+v", id.Name, id.VirtualMachine, id.ResourceGroup, err)
+		}
+
+		if props := resp.VirtualMachineExtensionProperties; props != nil {
+			settings = props.Settings
+		}
+
+		d.Set("virtual_machine_id", azure.NewVirtualMachineID(id.Subscription, id.ResourceGroup, id.VirtualMachine).String())
+
+		osDisk, dataDisks, err := retrieveAzureRmVirtualMachineDiskEncryptionStatus(ctx, meta, id.ResourceGroup, id.VirtualMachine)
+		if err != nil {
+			return err
+		}
+
+		osDisks := make([]interface{}, 0)
+		if osDisk != nil {
+			osDisks = append(osDisks, osDisk)
+		}
+		if err := d.Set("os_disk", osDisks); err != nil {
+			return fmt.Errorf("Error setting `os_disk`: %+v", err)
+		}
+
+		if err := d.Set("data_disk", dataDisks); err != nil {
+			return fmt.Errorf("Error setting `data_disk`: %+v", err)
+		}
+	} else {
+		id, err := azure.ParseVirtualMachineScaleSetExtensionID(d.Id())
+		if err != nil {
+			return err
+		}
+
+		client := meta.(*ArmClient).vmScaleSetExtensionsClient
+
+		resp, err := client.Get(ctx, id.ResourceGroup, id.VirtualMachineScaleSet, id.Name, "")
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				log.Printf("[DEBUG] Azure Disk Encryption Extension %q (Virtual Machine Scale Set %q / Resource Group %q) was not found - removing from state", id.Name, id.VirtualMachineScaleSet, id.ResourceGroup)
+				d.SetId("")
+				return nil
+			}
+
+			return fmt.Errorf("Error making Read request on Azure Disk Encryption Extension %q (Virtual Machine Scale Set %q / Resource Group %q): %+v", id.Name, id.VirtualMachineScaleSet, id.ResourceGroup, err)
+		}
+
+		if props := resp.VirtualMachineScaleSetExtensionProperties; props != nil {
+			settings = props.Settings
+		}
+
+		d.Set("virtual_machine_scale_set_id", azure.NewVirtualMachineScaleSetID(id.Subscription, id.ResourceGroup, id.VirtualMachineScaleSet).String())
+
+		osDisks, dataDisks, err := retrieveAzureRmVirtualMachineScaleSetDiskEncryptionStatus(ctx, meta, id.ResourceGroup, id.VirtualMachineScaleSet)
+		if err != nil {
+			return err
+		}
+
+		if err := d.Set("os_disk", osDisks); err != nil {
+			return fmt.Errorf("Error setting `os_disk`: %+v", err)
+		}
+
+		if err := d.Set("data_disk", dataDisks); err != nil {
+			return fmt.Errorf("Error setting `data_disk`: %+v", err)
+		}
+	}
+
+	if v, ok := settings.(map[string]interface{}); ok {
+		if keyVaultId, ok := v["KeyVaultResourceId"].(string); ok {
+			d.Set("key_vault_id", keyVaultId)
+		}
+
+		keyEncryptionKeyUrl, _ := v["KeyEncryptionKeyURL"].(string)
+		d.Set("key_encryption_key_url", keyEncryptionKeyUrl)
+
+		keyEncryptionKeyVaultId, _ := v["KekVaultResourceId"].(string)
+		d.Set("key_encryption_key_vault_id", keyEncryptionKeyVaultId)
+
+		if algorithm, ok := v["KeyEncryptionAlgorithm"].(string); ok {
+			d.Set("key_encryption_algorithm", algorithm)
+		}
+
+		if volumeType, ok := v["VolumeType"].(string); ok {
+			d.Set("volume_type", volumeType)
+		}
+	}
+
+	return nil
+}
+
+func resourceArmVirtualMachineDiskEncryptionDelete(d *schema.ResourceData, meta interface{}) error {
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	volumeType := d.Get("volume_type").(string)
+
+	if id, err := azure.ParseVirtualMachineExtensionID(d.Id()); err == nil {
+		client := meta.(*ArmClient).vmExtensionClient
+
+		azureRMLockByName(id.VirtualMachine, virtualMachineResourceName)
+		defer azureRMUnlockByName(id.VirtualMachine, virtualMachineResourceName)
+
+		existing, err := client.Get(ctx, id.ResourceGroup, id.VirtualMachine, id.Name, "")
+		if err != nil {
+			if utils.ResponseWasNotFound(existing.Response) {
+				return nil
+			}
+
+			return fmt.Errorf("Error retrieving Azure Disk Encryption Extension %q (Virtual Machine %q / Resource Group %q): %+v", id.Name, id.VirtualMachine, id.ResourceGroup, err)
+		}
+
+		if azureDiskEncryptionCanBeDisabled(id.Name, volumeType) && existing.VirtualMachineExtensionProperties != nil {
+			if err := updateAzureDiskEncryptionSettingsForDisable(existing.VirtualMachineExtensionProperties.Settings); err != nil {
+				return err
+			}
+			existing.VirtualMachineExtensionProperties.ProvisioningState = nil
+			existing.VirtualMachineExtensionProperties.InstanceView = nil
+
+			log.Printf("[DEBUG] Disabling Azure Disk Encryption on Virtual Machine %q (Resource Group %q)..", id.VirtualMachine, id.ResourceGroup)
+			future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.VirtualMachine, id.Name, existing)
+			if err != nil {
+				return fmt.Errorf("Error disabling Azure Disk Encryption on Virtual Machine %q (Resource Group %q): %+v", id.VirtualMachine, id.ResourceGroup, err)
+			}
+
+			if err = polling.WaitForCompletion(ctx, &future.Future, client.Client); err != nil {
+				return fmt.Errorf("Error waiting for Azure Disk Encryption to be disabled on Virtual Machine %q (Resource Group %q): %+v", id.VirtualMachine, id.ResourceGroup, err)
+			}
+		} else {
+			log.Printf("[WARN] Azure Disk Encryption can't be disabled for the OS Disk of a Linux Virtual Machine - the Disks of Virtual Machine %q (Resource Group %q) will remain encrypted", id.VirtualMachine, id.ResourceGroup)
+		}
+
+		future, err := client.Delete(ctx, id.ResourceGroup, id.VirtualMachine, id.Name)
+		if err != nil {
+			// deleted outside of Terraform
+			if response.WasNotFound(future.Response()) {
+				return nil
+			}
+			return fmt.Errorf("Error deleting Azure Disk Encryption Extension %q (Virtual Machine %q / Resource Group %q): %+v", id.Name, id.VirtualMachine, id.ResourceGroup, err)
+		}
+
+		if err = polling.WaitForCompletion(ctx, &future.Future, client.Client); err != nil {
+			if !response.WasNotFound(future.Response()) {
+				return fmt.Errorf("Error waiting for the deletion of Azure Disk Encryption Extension %q (Virtual Machine %q / Resource Group %q): %+v", id.Name, id.VirtualMachine, id.ResourceGroup, err)
+			}
+		}
+
+		return nil
+	}
+
+	id, err := azure.ParseVirtualMachineScaleSetExtensionID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*ArmClient).vmScaleSetExtensionsClient
+
+	azureRMLockByName(id.VirtualMachineScaleSet, virtualMachineScaleSetResourceName)
+	defer azureRMUnlockByName(id.VirtualMachineScaleSet, virtualMachineScaleSetResourceName)
+
+	existing, err := client.Get(ctx, id.ResourceGroup, id.VirtualMachineScaleSet, id.Name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(existing.Response) {
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Azure Disk Encryption Extension %q (Virtual Machine Scale Set %q / Resource Group %q): %+v", id.Name, id.VirtualMachineScaleSet, id.ResourceGroup, err)
+	}
+
+	if azureDiskEncryptionCanBeDisabled(id.Name, volumeType) && existing.VirtualMachineScaleSetExtensionProperties != nil {
+		if err := updateAzureDiskEncryptionSettingsForDisable(existing.VirtualMachineScaleSetExtensionProperties.Settings); err != nil {
+			return err
+		}
+		existing.VirtualMachineScaleSetExtensionProperties.ProvisioningState = nil
+
+		log.Printf("[DEBUG] Disabling Azure Disk Encryption on Virtual Machine Scale Set %q (Resource Group %q)..", id.VirtualMachineScaleSet, id.ResourceGroup)
+		future, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.VirtualMachineScaleSet, id.Name, existing)
+		if err != nil {
+			return fmt.Errorf("Error disabling Azure Disk Encryption on Virtual Machine Scale Set %q (Resource Group %q): %+v", id.VirtualMachineScaleSet, id.ResourceGroup, err)
+		}
+
+		if err = polling.WaitForCompletion(ctx, &future.Future, client.Client); err != nil {
+			return fmt.Errorf("Error waiting for Azure Disk Encryption to be disabled on Virtual Machine Scale Set %q (Resource Group %q): %+v", id.VirtualMachineScaleSet, id.ResourceGroup, err)
+		}
+	} else {
+		log.Printf("[WARN] Azure Disk Encryption can't be disabled for the OS Disk of a Linux Virtual Machine Scale Set - the Disks of Virtual Machine Scale Set %q (Resource Group %q) will remain encrypted", id.VirtualMachineScaleSet, id.ResourceGroup)
+	}
+
+	future, err := client.Delete(ctx, id.ResourceGroup, id.VirtualMachineScaleSet, id.Name)
+	if err != nil {
+		// deleted outside of Terraform
+		if response.WasNotFound(future.Response()) {
+			return nil
+		}
+		return fmt.Errorf("Error deleting Azure Disk Encryption Extension %q (Virtual Machine Scale Set %q / Resource Group %q): %+v", id.Name, id.VirtualMachineScaleSet, id.ResourceGroup, err)
+	}
+
+	if err = polling.WaitForCompletion(ctx, &future.Future, client.Client); err != nil {
+		if !response.WasNotFound(future.Response()) {
+			return fmt.Errorf("Error waiting for the deletion of Azure Disk Encryption Extension %q (Virtual Machine Scale Set %q / Resource Group %q): %+v", id.Name, id.VirtualMachineScaleSet, id.ResourceGroup, err)
+		}
+	}
+
+	return nil
+}
+
+func resourceArmVirtualMachineDiskEncryptionImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	if _, err := azure.ParseVirtualMachineExtensionID(d.Id()); err == nil {
+		return []*schema.ResourceData{d}, nil
+	}
+
+	if _, err := azure.ParseVirtualMachineScaleSetExtensionID(d.Id()); err == nil {
+		return []*schema.ResourceData{d}, nil
+	}
+
+	return nil, fmt.Errorf("Error: expected %q to be the ID of an Azure Disk Encryption Extension on a Virtual Machine or a Virtual Machine Scale Set", d.Id())
+}
+
+func azureDiskEncryptionExtensionForOSType(osType compute.OperatingSystemTypes) (string, string, error) {
+	switch osType {
+	case compute.Linux:
+		return azureDiskEncryptionLinuxType, azureDiskEncryptionLinuxTypeVersion, nil
+	case compute.Windows:
+		return azureDiskEncryptionWindowsType, azureDiskEncryptionWindowsTypeVersion, nil
+	}
+
+	return "", "", fmt.Errorf("unsupported Operating System Type %q", string(osType))
+}
+
+// azureDiskEncryptionCanBeDisabled returns whether encryption can be disabled - which is supported for Windows,
+// but only for the Data Disks of Linux machines
+func azureDiskEncryptionCanBeDisabled(extensionType, volumeType string) bool {
+	return extensionType != azureDiskEncryptionLinuxType || volumeType == azureDiskEncryptionVolumeTypeData
+}
+
+func updateAzureDiskEncryptionSettingsForDisable(input interface{}) error {
+	settings, ok := input.(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("Error: unable to parse the settings of the Azure Disk Encryption Extension")
+	}
+
+	sequenceVersion, err := uuid.GenerateUUID()
+	if err != nil {
+		return fmt.Errorf("Error generating a Sequence Version for Azure Disk Encryption: %+v", err)
+	}
+
+	settings["EncryptionOperation"] = azureDiskEncryptionOperationDisable
+	settings["SequenceVersion"] = sequenceVersion
+
+	return nil
+}
+
+// retrieveAzureRmVirtualMachineDiskEncryptionStatus returns the encryption status of the OS Disk and the Data Disks
+// of the Virtual Machine from its Instance View
+func retrieveAzureRmVirtualMachineDiskEncryptionStatus(ctx context.Context, meta interface{}, resourceGroup, name string) (map[string]interface{}, []interface{}, error) {
+	client := meta.(*ArmClient).vmClient
+
+	resp, err := client.Get(ctx, resourceGroup, name, compute.InstanceView)
+	if err != nil {
+		return nil, nil, fmt.Errorf("Error retrieving the Instance View of Virtual Machine %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	props := resp.VirtualMachineProperties
+	if props == nil || props.InstanceView == nil {
+		return nil, make([]interface{}, 0), nil
+	}
+
+	osDisk, dataDisks := flattenAzureRmVirtualMachineDisksEncryptionStatus(props.InstanceView.Disks, props.StorageProfile)
+	return osDisk, dataDisks, nil
+}
+
+// retrieveAzureRmVirtualMachineScaleSetDiskEncryptionStatus returns the encryption status of the OS Disks and the Data
+// Disks of each instance within the Virtual Machine Scale Set, since these are only available from the Instance View
+// of each instance
+func retrieveAzureRmVirtualMachineScaleSetDiskEncryptionStatus(ctx context.Context, meta interface{}, resourceGroup, name string) ([]interface{}, []interface{}, error) {
+	client := meta.(*ArmClient).vmScaleSetVMsClient
+
+	osDisks := make([]interface{}, 0)
+	dataDisks := make([]interface{}, 0)
+
+	iterator, err := client.ListComplete(ctx, resourceGroup, name, "", "", string(compute.InstanceView))
+	if err != nil {
+		return nil, nil, fmt.Errorf("Error listing the instances of Virtual Machine Scale Set %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+	for iterator.NotDone() {
+		instance := iterator.Value()
+		if props := instance.VirtualMachineScaleSetVMProperties; props != nil && props.InstanceView != nil {
+			osDisk, instanceDataDisks := flattenAzureRmVirtualMachineDisksEncryptionStatus(props.InstanceView.Disks, props.StorageProfile)
+			if osDisk != nil {
+				osDisks = append(osDisks, osDisk)
+			}
+			dataDisks = append(dataDisks, instanceDataDisks...)
+		}
+
+		if err := iterator.Next(); err != nil {
+			return nil, nil, fmt.Errorf("Error listing the instances of Virtual Machine Scale Set %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+	}
+
+	return osDisks, dataDisks, nil
+}
+
+// flattenAzureRmVirtualMachineDisksEncryptionStatus returns the encryption status of the OS Disk and the Data Disks
+// of a Virtual Machine (or an instance of a Virtual Machine Scale Set) - where the OS Disk is identified by its name
+func flattenAzureRmVirtualMachineDisksEncryptionStatus(disks *[]compute.DiskInstanceView, storageProfile *compute.StorageProfile) (map[string]interface{}, []interface{}) {
+	var osDisk map[string]interface{}
+	dataDisks := make([]interface{}, 0)
+	if disks == nil {
+		return osDisk, dataDisks
+	}
+
+	osDiskName := ""
+	if storageProfile != nil && storageProfile.OsDisk != nil && storageProfile.OsDisk.Name != nil {
+		osDiskName = *storageProfile.OsDisk.Name
+	}
+
+	for _, disk := range *disks {
+		output := flattenAzureRmVirtualMachineDiskEncryptionStatus(disk)
+
+		if disk.Name != nil && strings.EqualFold(*disk.Name, osDiskName) {
+			osDisk = output
+		} else {
+			dataDisks = append(dataDisks, output)
+		}
+	}
+
+	return osDisk, dataDisks
+}
+
+// azureDiskEncryptionInProgress returns whether any of the Disks are still being encrypted
+func azureDiskEncryptionInProgress(disks []interface{}) bool {
+	for _, v := range disks {
+		disk := v.(map[string]interface{})
+		if strings.EqualFold(disk["encryption_state"].(string), azureDiskEncryptionStateInProgress) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func flattenAzureRmVirtualMachineDiskEncryptionStatus(disk compute.DiskInstanceView) map[string]interface{} {
+	output := map[string]interface{}{
+		"encryption_state": azureDiskEncryptionStateFromStatuses(disk.Statuses),
+	}
+
+	if disk.Name != nil {
+		output["name"] = *disk.Name
+	}
+
+	if disk.EncryptionSettings != nil {
+		for _, settings := range *disk.EncryptionSettings {
+			if key := settings.DiskEncryptionKey; key != nil && key.SecretURL != nil {
+				output["disk_encryption_key_secret_url"] = *key.SecretURL
+			}
+
+			if key := settings.KeyEncryptionKey; key != nil && key.KeyURL != nil {
+				output["key_encryption_key_url"] = *key.KeyURL
+			}
+		}
+	}
+
+	return output
+}
+
+// azureDiskEncryptionStateFromStatuses returns the encryption state from the `EncryptionState/{state}` status of a Disk
+func azureDiskEncryptionStateFromStatuses(input *[]compute.InstanceViewStatus) string {
+	if input != nil {
+		for _, status := range *input {
+			if status.Code == nil {
+				continue
+			}
+
+			segments := strings.Split(*status.Code, "/")
+			if len(segments) == 2 && strings.EqualFold(segments[0], "EncryptionState") {
+				return segments[1]
+			}
+		}
+	}
+
+	return ""
+}
+
+func virtualMachineDiskEncryptionStateRefreshFunc(ctx context.Context, meta interface{}, resourceGroup, name, extensionName string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		client := meta.(*ArmClient).vmExtensionClient
+
+		extension, err := client.Get(ctx, resourceGroup, name, extensionName, "instanceView")
+		if err != nil {
+			return nil, "", fmt.Errorf("Error retrieving Azure Disk Encryption Extension %q (Virtual Machine %q / Resource Group %q): %+v", extensionName, name, resourceGroup, err)
+		}
+
+		if props := extension.VirtualMachineExtensionProperties; props != nil && props.InstanceView != nil && props.InstanceView.Statuses != nil {
+			for _, status := range *props.InstanceView.Statuses {
+				if status.Level == compute.Error {
+					message := ""
+					if status.Message != nil {
+						message = *status.Message
+					}
+					return nil, "", fmt.Errorf("Azure Disk Encryption failed: %s", message)
+				}
+			}
+		}
+
+		osDisk, dataDisks, err := retrieveAzureRmVirtualMachineDiskEncryptionStatus(ctx, meta, resourceGroup, name)
+		if err != nil {
+			return nil, "", err
+		}
+
+		disks := dataDisks
+		if osDisk != nil {
+			disks = append(disks, osDisk)
+		}
+
+		if azureDiskEncryptionInProgress(disks) {
+			return disks, "InProgress", nil
+		}
+
+		return disks, "Completed", nil
+	}
+}
+
+// virtualMachineScaleSetDiskEncryptionStateRefreshFunc waits for the Extension to succeed on each instance of the Virtual
+// Machine Scale Set, and then for the Disks of each instance to finish being encrypted - since the Extension can report
+// success whilst the encryption continues in the background
+func virtualMachineScaleSetDiskEncryptionStateRefreshFunc(ctx context.Context, meta interface{}, resourceGroup, name, extensionName string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		client := meta.(*ArmClient).vmScaleSetClient
+
+		resp, err := client.GetInstanceView(ctx, resourceGroup, name)
+		if err != nil {
+			return nil, "", fmt.Errorf("Error retrieving the Instance View of Virtual Machine Scale Set %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+
+		if resp.Extensions == nil {
+			return resp, "InProgress", nil
+		}
+
+		for _, extension := range *resp.Extensions {
+			if extension.Name == nil || !strings.EqualFold(*extension.Name, extensionName) {
+				continue
+			}
+
+			if extension.StatusesSummary == nil {
+				return resp, "InProgress", nil
+			}
+
+			for _, summary := range *extension.StatusesSummary {
+				if summary.Code == nil {
+					continue
+				}
+
+				code := strings.ToLower(*summary.Code)
+				if strings.HasSuffix(code, "/failed") {
+					count := int32(0)
+					if summary.Count != nil {
+						count = *summary.Count
+					}
+					return nil, "", fmt.Errorf("Azure Disk Encryption failed on %d instance(s)", count)
+				}
+
+				if !strings.HasSuffix(code, "/succeeded") {
+					return resp, "InProgress", nil
+				}
+			}
+
+			osDisks, dataDisks, err := retrieveAzureRmVirtualMachineScaleSetDiskEncryptionStatus(ctx, meta, resourceGroup, name)
+			if err != nil {
+				return nil, "", err
+			}
+
+			disks := append(osDisks, dataDisks...)
+			if azureDiskEncryptionInProgress(disks) {
+				return disks, "InProgress", nil
+			}
+
+			return disks, "Completed", nil
+		}
+
+		return resp, "InProgress", nil
+	}
+}
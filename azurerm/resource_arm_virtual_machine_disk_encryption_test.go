@@ -0,0 +1,346 @@
+package azurerm
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2018-06-01/compute"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMVirtualMachineDiskEncryption_windows(t *testing.T) {
+	resourceName := "azurerm_virtual_machine_disk_encryption.test"
+	ri := acctest.RandInt()
+	rs := acctest.RandString(5)
+	location := testLocation()
+	config := testAccAzureRMVirtualMachineDiskEncryption_windows(ri, rs, location)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMVirtualMachineDiskEncryptionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualMachineDiskEncryptionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "volume_type", "All"),
+					resource.TestCheckResourceAttr(resourceName, "key_encryption_algorithm", "RSA-OAEP"),
+					resource.TestCheckResourceAttr(resourceName, "os_disk.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "os_disk.0.encryption_state", "encrypted"),
+					resource.TestCheckResourceAttrSet(resourceName, "os_disk.0.disk_encryption_key_secret_url"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAzureRMVirtualMachineDiskEncryptionExtensionForOSType(t *testing.T) {
+	testData := []struct {
+		OSType          compute.OperatingSystemTypes
+		ExpectedType    string
+		ExpectedVersion string
+		ExpectError     bool
+	}{
+		{
+			OSType:          compute.Linux,
+			ExpectedType:    "AzureDiskEncryptionForLinux",
+			ExpectedVersion: "1.1",
+		},
+		{
+			OSType:          compute.Windows,
+			ExpectedType:    "AzureDiskEncryption",
+			ExpectedVersion: "2.2",
+		},
+		{
+			OSType:      compute.OperatingSystemTypes(""),
+			ExpectError: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", string(v.OSType))
+
+		extensionType, version, err := azureDiskEncryptionExtensionForOSType(v.OSType)
+		if err != nil {
+			if v.ExpectError {
+				continue
+			}
+
+			t.Fatalf("Expected no error but got: %+v", err)
+		}
+
+		if v.ExpectError {
+			t.Fatalf("Expected an error but didn't get one")
+		}
+
+		if extensionType != v.ExpectedType || version != v.ExpectedVersion {
+			t.Fatalf("Expected %q / %q but got %q / %q", v.ExpectedType, v.ExpectedVersion, extensionType, version)
+		}
+	}
+}
+
+func TestAzureRMVirtualMachineDiskEncryptionCanBeDisabled(t *testing.T) {
+	testData := []struct {
+		ExtensionType string
+		VolumeType    string
+		Expected      bool
+	}{
+		{ExtensionType: "AzureDiskEncryption", VolumeType: "All", Expected: true},
+		{ExtensionType: "AzureDiskEncryption", VolumeType: "OS", Expected: true},
+		{ExtensionType: "AzureDiskEncryptionForLinux", VolumeType: "Data", Expected: true},
+		{ExtensionType: "AzureDiskEncryptionForLinux", VolumeType: "All", Expected: false},
+		{ExtensionType: "AzureDiskEncryptionForLinux", VolumeType: "OS", Expected: false},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q / %q", v.ExtensionType, v.VolumeType)
+
+		if actual := azureDiskEncryptionCanBeDisabled(v.ExtensionType, v.VolumeType); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
+func TestAzureRMVirtualMachineDiskEncryptionState(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Input    *[]compute.InstanceViewStatus
+		Expected string
+	}{
+		{
+			Name:     "Empty",
+			Input:    nil,
+			Expected: "",
+		},
+		{
+			Name: "Encrypted",
+			Input: &[]compute.InstanceViewStatus{
+				{
+					Code: utils.String("ProvisioningState/succeeded"),
+				},
+				{
+					Code: utils.String("EncryptionState/encrypted"),
+				},
+			},
+			Expected: "encrypted",
+		},
+		{
+			Name: "Not Encrypted",
+			Input: &[]compute.InstanceViewStatus{
+				{
+					Code: utils.String("EncryptionState/notEncrypted"),
+				},
+			},
+			Expected: "notEncrypted",
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		if actual := azureDiskEncryptionStateFromStatuses(v.Input); actual != v.Expected {
+			t.Fatalf("Expected %q but got %q", v.Expected, actual)
+		}
+	}
+}
+
+func TestAzureRMVirtualMachineDiskEncryptionDisksStatus(t *testing.T) {
+	disks := &[]compute.DiskInstanceView{
+		{
+			Name: utils.String("vmss_0_OsDisk"),
+			Statuses: &[]compute.InstanceViewStatus{
+				{
+					Code: utils.String("EncryptionState/encrypted"),
+				},
+			},
+		},
+		{
+			Name: utils.String("vmss_0_disk2"),
+			Statuses: &[]compute.InstanceViewStatus{
+				{
+					Code: utils.String("EncryptionState/encryptionInProgress"),
+				},
+			},
+		},
+	}
+	storageProfile := &compute.StorageProfile{
+		OsDisk: &compute.OSDisk{
+			Name: utils.String("VMSS_0_OSDISK"),
+		},
+	}
+
+	osDisk, dataDisks := flattenAzureRmVirtualMachineDisksEncryptionStatus(disks, storageProfile)
+	if osDisk == nil || osDisk["name"] != "vmss_0_OsDisk" || osDisk["encryption_state"] != "encrypted" {
+		t.Fatalf("Expected the OS Disk to be encrypted but got %+v", osDisk)
+	}
+	if len(dataDisks) != 1 || dataDisks[0].(map[string]interface{})["name"] != "vmss_0_disk2" {
+		t.Fatalf("Expected a single Data Disk but got %+v", dataDisks)
+	}
+
+	if azureDiskEncryptionInProgress([]interface{}{osDisk}) {
+		t.Fatalf("Expected the encryption of the OS Disk to have completed")
+	}
+	if !azureDiskEncryptionInProgress(append(dataDisks, osDisk)) {
+		t.Fatalf("Expected the encryption of the Data Disk to be in progress")
+	}
+
+	osDisk, dataDisks = flattenAzureRmVirtualMachineDisksEncryptionStatus(nil, storageProfile)
+	if osDisk != nil || len(dataDisks) != 0 {
+		t.Fatalf("Expected no Disks but got %+v and %+v", osDisk, dataDisks)
+	}
+}
+
+func testCheckAzureRMVirtualMachineDiskEncryptionExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		id, err := azure.ParseVirtualMachineExtensionID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).vmExtensionClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.Get(ctx, id.ResourceGroup, id.VirtualMachine, id.Name, "")
+		if err != nil {
+			return fmt.Errorf("Bad: Get on vmExtensionClient: %+v", err)
+		}
+
+		if resp.StatusCode == http.StatusNotFound {
+			return fmt.Errorf("Bad: Azure Disk Encryption Extension %q (Virtual Machine %q / Resource Group %q) does not exist", id.Name, id.VirtualMachine, id.ResourceGroup)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMVirtualMachineDiskEncryptionDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).vmExtensionClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_virtual_machine_disk_encryption" {
+			continue
+		}
+
+		id, err := azure.ParseVirtualMachineExtensionID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		resp, err := client.Get(ctx, id.ResourceGroup, id.VirtualMachine, id.Name, "")
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Azure Disk Encryption Extension still exists:\n%#v", resp)
+	}
+
+	return nil
+}
+
+func testAccAzureRMVirtualMachineDiskEncryption_windows(rInt int, rString string, location string) string {
+	return fmt.Sprintf(`
+data "azurerm_client_config" "current" {}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[3]s"
+}
+
+resource "azurerm_key_vault" "test" {
+  name                        = "acctestkv%[2]s"
+  location                    = "${azurerm_resource_group.test.location}"
+  resource_group_name         = "${azurerm_resource_group.test.name}"
+  tenant_id                   = "${data.azurerm_client_config.current.tenant_id}"
+  enabled_for_disk_encryption = true
+
+  sku {
+    name = "standard"
+  }
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctvn-%[1]d"
+  address_space       = ["10.0.0.0/16"]
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+
+resource "azurerm_subnet" "test" {
+  name                 = "acctsub-%[1]d"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+  virtual_network_name = "${azurerm_virtual_network.test.name}"
+  address_prefix       = "10.0.2.0/24"
+}
+
+resource "azurerm_network_interface" "test" {
+  name                = "acctni-%[1]d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  ip_configuration {
+    name                          = "testconfiguration1"
+    subnet_id                     = "${azurerm_subnet.test.id}"
+    private_ip_address_allocation = "dynamic"
+  }
+}
+
+resource "azurerm_virtual_machine" "test" {
+  name                          = "acctvm-%[1]d"
+  location                      = "${azurerm_resource_group.test.location}"
+  resource_group_name           = "${azurerm_resource_group.test.name}"
+  network_interface_ids         = ["${azurerm_network_interface.test.id}"]
+  vm_size                       = "Standard_F2"
+  delete_os_disk_on_termination = true
+
+  storage_image_reference {
+    publisher = "MicrosoftWindowsServer"
+    offer     = "WindowsServer"
+    sku       = "2016-Datacenter"
+    version   = "latest"
+  }
+
+  storage_os_disk {
+    name              = "acctestosd-%[1]d"
+    caching           = "ReadWrite"
+    create_option     = "FromImage"
+    managed_disk_type = "Standard_LRS"
+  }
+
+  os_profile {
+    computer_name  = "acctvm%[2]s"
+    admin_username = "testadmin"
+    admin_password = "Password1234!"
+  }
+
+  os_profile_windows_config {
+    provision_vm_agent = true
+  }
+}
+
+resource "azurerm_virtual_machine_disk_encryption" "test" {
+  virtual_machine_id = "${azurerm_virtual_machine.test.id}"
+  key_vault_id       = "${azurerm_key_vault.test.id}"
+}
+`, rInt, rString, location)
+}
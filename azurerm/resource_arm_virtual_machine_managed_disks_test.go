@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/polling"
 )
 
 // NOTE: Test `TestAccAzureRMVirtualMachine_enableAnWithVM` requires a machine of size `D8_v3` which is large/expensive - you may wish to ignore this test"
@@ -526,7 +527,7 @@ func testCheckAndStopAzureRMVirtualMachine(vm *compute.VirtualMachine) resource.
 			return fmt.Errorf("Failed stopping virtual machine %q: %+v", resourceGroup, err)
 		}
 
-		err = future.WaitForCompletionRef(ctx, client.Client)
+		err = polling.WaitForCompletion(ctx, &future.Future, client.Client)
 		if err != nil {
 			return fmt.Errorf("Failed long polling for the stop of virtual machine %q: %+v", resourceGroup, err)
 		}
@@ -535,6 +536,121 @@ func testCheckAndStopAzureRMVirtualMachine(vm *compute.VirtualMachine) resource.
 	}
 }
 
+func TestAccAzureRMVirtualMachine_basicLinuxMachine_managedDisk_updateOsDisk(t *testing.T) {
+	var afterCreate, afterUpdate compute.VirtualMachine
+	resourceName := "azurerm_virtual_machine.test"
+	rInt := acctest.RandInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMVirtualMachineDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMVirtualMachine_basicLinuxMachine_managedDisk_osDisk(rInt, location, "ReadWrite", 50, "Standard_LRS"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualMachineExists(resourceName, &afterCreate),
+					resource.TestCheckResourceAttr(resourceName, "storage_os_disk.0.caching", "ReadWrite"),
+					resource.TestCheckResourceAttr(resourceName, "storage_os_disk.0.disk_size_gb", "50"),
+					resource.TestCheckResourceAttr(resourceName, "storage_os_disk.0.managed_disk_type", "Standard_LRS"),
+				),
+			},
+			{
+				Config: testAccAzureRMVirtualMachine_basicLinuxMachine_managedDisk_osDisk(rInt, location, "ReadOnly", 64, "Premium_LRS"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualMachineExists(resourceName, &afterUpdate),
+					testAccCheckVirtualMachineNotRecreated(t, &afterCreate, &afterUpdate),
+					resource.TestCheckResourceAttr(resourceName, "storage_os_disk.0.caching", "ReadOnly"),
+					resource.TestCheckResourceAttr(resourceName, "storage_os_disk.0.disk_size_gb", "64"),
+					resource.TestCheckResourceAttr(resourceName, "storage_os_disk.0.managed_disk_type", "Premium_LRS"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMVirtualMachine_manageDataDisksExternally(t *testing.T) {
+	var afterCreate, afterUpdate compute.VirtualMachine
+	resourceName := "azurerm_virtual_machine.test"
+	attachmentResourceName := "azurerm_virtual_machine_data_disk_attachment.test"
+	rInt := acctest.RandInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMVirtualMachineDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMVirtualMachine_manageDataDisksExternally(rInt, location, "Production"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualMachineExists(resourceName, &afterCreate),
+					testCheckAzureRMVirtualMachineDataDiskAttachmentExists(attachmentResourceName),
+					resource.TestCheckResourceAttr(resourceName, "manage_data_disks_externally", "true"),
+					resource.TestCheckResourceAttr(resourceName, "storage_data_disk.#", "0"),
+				),
+			},
+			{
+				// the Data Disk attached outside of the Virtual Machine resource shouldn't cause a diff
+				Config:   testAccAzureRMVirtualMachine_manageDataDisksExternally(rInt, location, "Production"),
+				PlanOnly: true,
+			},
+			{
+				Config: testAccAzureRMVirtualMachine_manageDataDisksExternally(rInt, location, "Staging"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualMachineExists(resourceName, &afterUpdate),
+					testAccCheckVirtualMachineNotRecreated(t, &afterCreate, &afterUpdate),
+					testCheckAzureRMVirtualMachineDataDiskAttachmentExists(attachmentResourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.environment", "Staging"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"delete_data_disks_on_termination",
+					"delete_os_disk_on_termination",
+					"manage_data_disks_externally",
+					"os_profile",
+					"storage_data_disk",
+				},
+			},
+		},
+	})
+}
+
+func TestAccAzureRMVirtualMachine_manageDataDisksExternallyConflict(t *testing.T) {
+	rInt := acctest.RandInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMVirtualMachineDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAzureRMVirtualMachine_manageDataDisksExternallyConflict(rInt, location),
+				ExpectError: regexp.MustCompile("conflicts with storage_data_disk"),
+			},
+		},
+	})
+}
+
+func testAccCheckVirtualMachineNotRecreated(t *testing.T, before, after *compute.VirtualMachine) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if before.VMID == nil || after.VMID == nil {
+			return fmt.Errorf("Bad: the VM ID of the Virtual Machine was nil")
+		}
+
+		if *before.VMID != *after.VMID {
+			t.Fatalf("Expected the Virtual Machine to be updated in-place, but the VM ID changed from %q to %q", *before.VMID, *after.VMID)
+		}
+		return nil
+	}
+}
+
 func testAccAzureRMVirtualMachine_basicLinuxMachine_managedDisk_withOsWriteAcceleratorEnabled(rInt int, location, enabled string) string {
 	return fmt.Sprintf(` 
 resource "azurerm_resource_group" "test" { 
@@ -2447,3 +2563,187 @@ resource "azurerm_virtual_machine" "test" {
 }
 `, rInt, location, rInt, rInt, rInt, rInt, rInt, rInt)
 }
+
+func testAccAzureRMVirtualMachine_managedDisk_template(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctvn-%[1]d"
+  address_space       = ["10.0.0.0/16"]
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+
+resource "azurerm_subnet" "test" {
+  name                 = "acctsub-%[1]d"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+  virtual_network_name = "${azurerm_virtual_network.test.name}"
+  address_prefix       = "10.0.2.0/24"
+}
+
+resource "azurerm_network_interface" "test" {
+  name                = "acctni-%[1]d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  ip_configuration {
+    name                          = "testconfiguration1"
+    subnet_id                     = "${azurerm_subnet.test.id}"
+    private_ip_address_allocation = "dynamic"
+  }
+}
+`, rInt, location)
+}
+
+func testAccAzureRMVirtualMachine_basicLinuxMachine_managedDisk_osDisk(rInt int, location, caching string, diskSizeGB int, managedDiskType string) string {
+	template := testAccAzureRMVirtualMachine_managedDisk_template(rInt, location)
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_virtual_machine" "test" {
+  name                  = "acctvm-%[2]d"
+  location              = "${azurerm_resource_group.test.location}"
+  resource_group_name   = "${azurerm_resource_group.test.name}"
+  network_interface_ids = ["${azurerm_network_interface.test.id}"]
+  vm_size               = "Standard_DS1_v2"
+
+  storage_image_reference {
+    publisher = "Canonical"
+    offer     = "UbuntuServer"
+    sku       = "16.04-LTS"
+    version   = "latest"
+  }
+
+  storage_os_disk {
+    name              = "osd-%[2]d"
+    caching           = "%[3]s"
+    create_option     = "FromImage"
+    disk_size_gb      = %[4]d
+    managed_disk_type = "%[5]s"
+  }
+
+  os_profile {
+    computer_name  = "hn%[2]d"
+    admin_username = "testadmin"
+    admin_password = "Password1234!"
+  }
+
+  os_profile_linux_config {
+    disable_password_authentication = false
+  }
+}
+`, template, rInt, caching, diskSizeGB, managedDiskType)
+}
+
+func testAccAzureRMVirtualMachine_manageDataDisksExternally(rInt int, location, environment string) string {
+	template := testAccAzureRMVirtualMachine_managedDisk_template(rInt, location)
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_virtual_machine" "test" {
+  name                          = "acctvm-%[2]d"
+  location                      = "${azurerm_resource_group.test.location}"
+  resource_group_name           = "${azurerm_resource_group.test.name}"
+  network_interface_ids         = ["${azurerm_network_interface.test.id}"]
+  vm_size                       = "Standard_D1_v2"
+  delete_os_disk_on_termination = true
+  manage_data_disks_externally  = true
+
+  storage_image_reference {
+    publisher = "Canonical"
+    offer     = "UbuntuServer"
+    sku       = "16.04-LTS"
+    version   = "latest"
+  }
+
+  storage_os_disk {
+    name              = "osd-%[2]d"
+    caching           = "ReadWrite"
+    create_option     = "FromImage"
+    managed_disk_type = "Standard_LRS"
+  }
+
+  os_profile {
+    computer_name  = "hn%[2]d"
+    admin_username = "testadmin"
+    admin_password = "Password1234!"
+  }
+
+  os_profile_linux_config {
+    disable_password_authentication = false
+  }
+
+  tags {
+    environment = "%[3]s"
+  }
+}
+
+resource "azurerm_managed_disk" "test" {
+  name                 = "acctestdisk-%[2]d"
+  location             = "${azurerm_resource_group.test.location}"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+  storage_account_type = "Standard_LRS"
+  create_option        = "Empty"
+  disk_size_gb         = 10
+}
+
+resource "azurerm_virtual_machine_data_disk_attachment" "test" {
+  managed_disk_id    = "${azurerm_managed_disk.test.id}"
+  virtual_machine_id = "${azurerm_virtual_machine.test.id}"
+  lun                = "0"
+  caching            = "None"
+}
+`, template, rInt, environment)
+}
+
+func testAccAzureRMVirtualMachine_manageDataDisksExternallyConflict(rInt int, location string) string {
+	template := testAccAzureRMVirtualMachine_managedDisk_template(rInt, location)
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_virtual_machine" "test" {
+  name                         = "acctvm-%[2]d"
+  location                     = "${azurerm_resource_group.test.location}"
+  resource_group_name          = "${azurerm_resource_group.test.name}"
+  network_interface_ids        = ["${azurerm_network_interface.test.id}"]
+  vm_size                      = "Standard_D1_v2"
+  manage_data_disks_externally = true
+
+  storage_image_reference {
+    publisher = "Canonical"
+    offer     = "UbuntuServer"
+    sku       = "16.04-LTS"
+    version   = "latest"
+  }
+
+  storage_os_disk {
+    name              = "osd-%[2]d"
+    caching           = "ReadWrite"
+    create_option     = "FromImage"
+    managed_disk_type = "Standard_LRS"
+  }
+
+  storage_data_disk {
+    name              = "dd-%[2]d"
+    create_option     = "Empty"
+    managed_disk_type = "Standard_LRS"
+    lun               = 0
+    disk_size_gb      = 10
+  }
+
+  os_profile {
+    computer_name  = "hn%[2]d"
+    admin_username = "testadmin"
+    admin_password = "Password1234!"
+  }
+
+  os_profile_linux_config {
+    disable_password_authentication = false
+  }
+}
+`, template, rInt)
+}
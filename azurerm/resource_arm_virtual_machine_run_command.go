@@ -0,0 +1,355 @@
+package azurerm
+
+import (
+	"encoding/base64"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2018-06-01/compute"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/polling"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmVirtualMachineRunCommand() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmVirtualMachineRunCommandCreate,
+		Read:   resourceArmVirtualMachineRunCommandRead,
+		Delete: resourceArmVirtualMachineRunCommandDelete,
+
+		// Azure allows a Run Command up to 90 minutes to complete
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(90 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"virtual_machine_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: ignoreCaseDiffSuppressFunc,
+				ValidateFunc:     azure.ValidateVirtualMachineID,
+			},
+
+			"command_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "RunShellScript",
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"script": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"parameters": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"triggers": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"stdout": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"stderr": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceArmVirtualMachineRunCommandCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).vmClient
+	runCommandsClient := meta.(*ArmClient).vmRunCommandsClient
+	ctx, cancel := timeouts.ForCreate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	name := d.Get("name").(string)
+	commandId := d.Get("command_id").(string)
+	virtualMachineId, err := azure.ParseVirtualMachineID(d.Get("virtual_machine_id").(string))
+	if err != nil {
+		return err
+	}
+
+	resourceGroup := virtualMachineId.ResourceGroup
+	virtualMachineName := virtualMachineId.Name
+
+	// only a single Run Command can execute on a Virtual Machine at a time
+	azureRMLockByName(virtualMachineName, virtualMachineResourceName)
+	defer azureRMUnlockByName(virtualMachineName, virtualMachineResourceName)
+
+	virtualMachine, err := client.Get(ctx, resourceGroup, virtualMachineName, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(virtualMachine.Response) {
+			return fmt.Errorf("Virtual Machine %q (Resource Group %q) was not found", virtualMachineName, resourceGroup)
+		}
+
+		return fmt.Errorf("Error loading Virtual Machine %q (Resource Group %q): %+v", virtualMachineName, resourceGroup, err)
+	}
+
+	if virtualMachine.Location == nil {
+		return fmt.Errorf("Error: unable to determine the location of Virtual Machine %q (Resource Group %q)", virtualMachineName, resourceGroup)
+	}
+	location := azureRMNormalizeLocation(*virtualMachine.Location)
+
+	document, err := runCommandsClient.Get(ctx, location, commandId)
+	if err != nil {
+		if utils.ResponseWasNotFound(document.Response) {
+			return fmt.Errorf("Error: Run Command %q is not available in %q", commandId, location)
+		}
+
+		return fmt.Errorf("Error retrieving Run Command %q (Location %q): %+v", commandId, location, err)
+	}
+
+	if props := virtualMachine.VirtualMachineProperties; props != nil && props.StorageProfile != nil && props.StorageProfile.OsDisk != nil {
+		if osType := props.StorageProfile.OsDisk.OsType; osType != "" && document.OsType != "" && osType != document.OsType {
+			return fmt.Errorf("Error: Run Command %q can only be used on %s Virtual Machines but Virtual Machine %q (Resource Group %q) is running %s", commandId, string(document.OsType), virtualMachineName, resourceGroup, string(osType))
+		}
+	}
+
+	parameters := d.Get("parameters").(map[string]interface{})
+	if document.Parameters != nil {
+		for _, definition := range *document.Parameters {
+			if definition.Name == nil || definition.Required == nil || !*definition.Required {
+				continue
+			}
+
+			if _, ok := parameters[*definition.Name]; !ok {
+				return fmt.Errorf("Error: the parameter %q is required by Run Command %q", *definition.Name, commandId)
+			}
+		}
+	}
+
+	input := compute.RunCommandInput{
+		CommandID:  utils.String(commandId),
+		Parameters: expandAzureRmVirtualMachineRunCommandParameters(parameters),
+	}
+
+	if v, ok := d.GetOk("script"); ok {
+		var script []string
+		if strings.EqualFold(commandId, "RunPowerShellScript") {
+			// Windows Virtual Machines don't return the exit code of the script, so this is written to StdOut
+			script = wrapAzureRmVirtualMachineRunCommandPowerShellScript(v.(string))
+		} else {
+			script = strings.Split(v.(string), "\n")
+		}
+		input.Script = &script
+	}
+
+	log.Printf("[DEBUG] Running Command %q (Run Command %q) on Virtual Machine %q (Resource Group %q)..", name, commandId, virtualMachineName, resourceGroup)
+	future, err := client.RunCommand(ctx, resourceGroup, virtualMachineName, input)
+	if err != nil {
+		return fmt.Errorf("Error running Command %q on Virtual Machine %q (Resource Group %q): %+v", name, virtualMachineName, resourceGroup, err)
+	}
+
+	if err = polling.WaitForCompletion(ctx, &future.Future, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for Command %q to run on Virtual Machine %q (Resource Group %q): %+v", name, virtualMachineName, resourceGroup, err)
+	}
+
+	result, err := future.Result(client)
+	if err != nil {
+		return fmt.Errorf("Error retrieving the result of Command %q on Virtual Machine %q (Resource Group %q): %+v", name, virtualMachineName, resourceGroup, err)
+	}
+
+	output := parseAzureRmVirtualMachineRunCommandResult(result.Value)
+	if output.Failed {
+		return fmt.Errorf("Error: Command %q failed on Virtual Machine %q (Resource Group %q): %s\n\nStdOut:\n%s\n\nStdErr:\n%s", name, virtualMachineName, resourceGroup, output.Message, output.StdOut, output.StdErr)
+	}
+
+	d.SetId(azure.NewVirtualMachineRunCommandID(virtualMachineId.Subscription, resourceGroup, virtualMachineName, name).String())
+	d.Set("stdout", output.StdOut)
+	d.Set("stderr", output.StdErr)
+
+	return resourceArmVirtualMachineRunCommandRead(d, meta)
+}
+
+func resourceArmVirtualMachineRunCommandRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).vmClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := azure.ParseVirtualMachineRunCommandID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	// the output of a Run Command isn't retained by Azure - as such all we can check is that the
+	// Virtual Machine the Command was run on still exists
+	virtualMachine, err := client.Get(ctx, id.ResourceGroup, id.VirtualMachine, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(virtualMachine.Response) {
+			log.Printf("[DEBUG] Virtual Machine %q (Resource Group %q) was not found - removing Run Command %q from state", id.VirtualMachine, id.ResourceGroup, id.Name)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error loading Virtual Machine %q (Resource Group %q): %+v", id.VirtualMachine, id.ResourceGroup, err)
+	}
+
+	d.Set("name", id.Name)
+	d.Set("virtual_machine_id", azure.NewVirtualMachineID(id.Subscription, id.ResourceGroup, id.VirtualMachine).String())
+
+	return nil
+}
+
+func resourceArmVirtualMachineRunCommandDelete(d *schema.ResourceData, meta interface{}) error {
+	// a Run Command can't be undone, as such there's nothing to do here other than removing it from the state
+	log.Printf("[DEBUG] Removing Run Command %q from the state", d.Id())
+	return nil
+}
+
+func expandAzureRmVirtualMachineRunCommandParameters(input map[string]interface{}) *[]compute.RunCommandInputParameter {
+	results := make([]compute.RunCommandInputParameter, 0)
+
+	for k, v := range input {
+		results = append(results, compute.RunCommandInputParameter{
+			Name:  utils.String(k),
+			Value: utils.String(v.(string)),
+		})
+	}
+
+	return &results
+}
+
+// wrapAzureRmVirtualMachineRunCommandPowerShellScript returns a script which runs the specified PowerShell script in
+// a child process and then writes its exit code to StdOut. Running the script in a child process means the exit code
+// is also reported when the script calls `exit` (which otherwise ends the script before anything appended to it runs).
+// The script is Base64 encoded so that it doesn't need escaping, and the parameters are passed through to it.
+func wrapAzureRmVirtualMachineRunCommandPowerShellScript(script string) []string {
+	encoded := base64.StdEncoding.EncodeToString([]byte(script))
+	return []string{
+		"$path = Join-Path $env:TEMP ('terraform-' + [guid]::NewGuid().ToString() + '.ps1')",
+		fmt.Sprintf("[System.IO.File]::WriteAllText($path, [System.Text.Encoding]::UTF8.GetString([System.Convert]::FromBase64String('%s')))", encoded),
+		"try {",
+		"  & powershell.exe -NoProfile -NonInteractive -ExecutionPolicy Bypass -File $path @args",
+		"  $exitCode = $LASTEXITCODE",
+		"} finally {",
+		"  Remove-Item -Path $path -Force -ErrorAction SilentlyContinue",
+		"}",
+		fmt.Sprintf("Write-Output \"%s$exitCode\"", azureRmVirtualMachineRunCommandExitCodePrefix),
+	}
+}
+
+// azureRmVirtualMachineRunCommandExitCodePrefix prefixes the line containing the exit code of a PowerShell script,
+// which is appended to StdOut (and removed from it when parsing the result)
+const azureRmVirtualMachineRunCommandExitCodePrefix = "[terraform-exit-code]"
+
+type azureRmVirtualMachineRunCommandOutput struct {
+	Failed  bool
+	Message string
+	StdOut  string
+	StdErr  string
+}
+
+// parseAzureRmVirtualMachineRunCommandResult parses the statuses returned from a Run Command. Windows Virtual Machines
+// return a separate status for StdOut and StdErr, whereas Linux Virtual Machines return a single status containing both
+// in the format `Enable succeeded: \n[stdout]\n...\n[stderr]\n...`
+//
+// Since Windows Virtual Machines report these as succeeded regardless of the outcome of the script, on Windows the
+// script is treated as failed when it writes to StdErr, or when the exit code appended to StdOut isn't zero.
+func parseAzureRmVirtualMachineRunCommandResult(input *[]compute.InstanceViewStatus) azureRmVirtualMachineRunCommandOutput {
+	output := azureRmVirtualMachineRunCommandOutput{}
+	if input == nil {
+		return output
+	}
+
+	for _, status := range *input {
+		code := ""
+		if status.Code != nil {
+			code = strings.ToLower(*status.Code)
+		}
+
+		message := ""
+		if status.Message != nil {
+			message = *status.Message
+		}
+
+		if status.Level == compute.Error || strings.Contains(code, "/failed") {
+			output.Failed = true
+			if status.DisplayStatus != nil {
+				output.Message = *status.DisplayStatus
+			}
+		}
+
+		switch {
+		case strings.HasPrefix(code, "componentstatus/stdout/"):
+			output.StdOut = message
+
+		case strings.HasPrefix(code, "componentstatus/stderr/"):
+			output.StdErr = message
+			if strings.TrimSpace(message) != "" && !output.Failed {
+				output.Failed = true
+				output.Message = "the script wrote to StdErr"
+			}
+
+		default:
+			stdOutIndex := strings.Index(message, "[stdout]\n")
+			stdErrIndex := strings.Index(message, "[stderr]\n")
+			if stdOutIndex == -1 || stdErrIndex == -1 || stdErrIndex < stdOutIndex {
+				output.StdOut = message
+				continue
+			}
+
+			output.StdOut = strings.TrimSpace(message[stdOutIndex+len("[stdout]\n") : stdErrIndex])
+			output.StdErr = strings.TrimSpace(message[stdErrIndex+len("[stderr]\n"):])
+		}
+	}
+
+	stdOut, exitCode := parseAzureRmVirtualMachineRunCommandExitCode(output.StdOut)
+	output.StdOut = stdOut
+	if exitCode != 0 && !output.Failed {
+		output.Failed = true
+		output.Message = fmt.Sprintf("the script exited with code %d", exitCode)
+	}
+
+	return output
+}
+
+// parseAzureRmVirtualMachineRunCommandExitCode removes the exit code appended to the output of a PowerShell script,
+// returning the remaining output and the exit code (which is zero if it's not present)
+func parseAzureRmVirtualMachineRunCommandExitCode(stdOut string) (string, int) {
+	index := strings.LastIndex(stdOut, azureRmVirtualMachineRunCommandExitCodePrefix)
+	if index == -1 {
+		return stdOut, 0
+	}
+
+	line := stdOut[index+len(azureRmVirtualMachineRunCommandExitCodePrefix):]
+	if end := strings.Index(line, "\n"); end != -1 {
+		line = line[:end]
+	}
+
+	// the exit code should always be present, but is treated as zero if it can't be parsed
+	exitCode, err := strconv.Atoi(strings.TrimSpace(line))
+	if err != nil {
+		exitCode = 0
+	}
+
+	return strings.TrimSpace(stdOut[:index]), exitCode
+}
@@ -0,0 +1,342 @@
+package azurerm
+
+import (
+	"encoding/base64"
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2018-06-01/compute"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMVirtualMachineRunCommand_basic(t *testing.T) {
+	resourceName := "azurerm_virtual_machine_run_command.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMVirtualMachineDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMVirtualMachineRunCommand_basic(ri, location, "first"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "command_id", "RunShellScript"),
+					resource.TestCheckResourceAttr(resourceName, "stdout", "hello first"),
+					resource.TestCheckResourceAttr(resourceName, "stderr", ""),
+				),
+			},
+			{
+				Config: testAccAzureRMVirtualMachineRunCommand_basic(ri, location, "second"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "stdout", "hello second"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMVirtualMachineRunCommand_failure(t *testing.T) {
+	ri := acctest.RandInt()
+	location := testLocation()
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMVirtualMachineDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAzureRMVirtualMachineRunCommand_failure(ri, location),
+				ExpectError: regexp.MustCompile("failed on Virtual Machine"),
+			},
+		},
+	})
+}
+
+func TestAzureRMVirtualMachineRunCommandResult(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Input    *[]compute.InstanceViewStatus
+		Expected azureRmVirtualMachineRunCommandOutput
+	}{
+		{
+			Name:     "Empty",
+			Input:    nil,
+			Expected: azureRmVirtualMachineRunCommandOutput{},
+		},
+		{
+			Name: "Linux Succeeded",
+			Input: &[]compute.InstanceViewStatus{
+				{
+					Code:          utils.String("ProvisioningState/succeeded"),
+					Level:         compute.Info,
+					DisplayStatus: utils.String("Provisioning succeeded"),
+					Message:       utils.String("Enable succeeded: \n[stdout]\nhello\n\n[stderr]\nwarning\n"),
+				},
+			},
+			Expected: azureRmVirtualMachineRunCommandOutput{
+				StdOut: "hello",
+				StdErr: "warning",
+			},
+		},
+		{
+			Name: "Linux Failed",
+			Input: &[]compute.InstanceViewStatus{
+				{
+					Code:          utils.String("ProvisioningState/failed/1"),
+					Level:         compute.Error,
+					DisplayStatus: utils.String("Provisioning failed"),
+					Message:       utils.String("Enable failed: failed to execute command: command terminated with exit status=1\n[stdout]\n\n[stderr]\nboom\n"),
+				},
+			},
+			Expected: azureRmVirtualMachineRunCommandOutput{
+				Failed:  true,
+				Message: "Provisioning failed",
+				StdErr:  "boom",
+			},
+		},
+		{
+			Name: "Windows Succeeded",
+			Input: &[]compute.InstanceViewStatus{
+				{
+					Code:          utils.String("ComponentStatus/StdOut/succeeded"),
+					Level:         compute.Info,
+					DisplayStatus: utils.String("Provisioning succeeded"),
+					Message:       utils.String("hello"),
+				},
+				{
+					Code:          utils.String("ComponentStatus/StdErr/succeeded"),
+					Level:         compute.Info,
+					DisplayStatus: utils.String("Provisioning succeeded"),
+					Message:       utils.String(""),
+				},
+			},
+			Expected: azureRmVirtualMachineRunCommandOutput{
+				StdOut: "hello",
+			},
+		},
+		{
+			Name: "Windows Succeeded with an Exit Code",
+			Input: &[]compute.InstanceViewStatus{
+				{
+					Code:          utils.String("ComponentStatus/StdOut/succeeded"),
+					Level:         compute.Info,
+					DisplayStatus: utils.String("Provisioning succeeded"),
+					Message:       utils.String("hello\n[terraform-exit-code]0"),
+				},
+				{
+					Code:          utils.String("ComponentStatus/StdErr/succeeded"),
+					Level:         compute.Info,
+					DisplayStatus: utils.String("Provisioning succeeded"),
+					Message:       utils.String(""),
+				},
+			},
+			Expected: azureRmVirtualMachineRunCommandOutput{
+				StdOut: "hello",
+			},
+		},
+		{
+			Name: "Windows Failed with StdErr",
+			Input: &[]compute.InstanceViewStatus{
+				{
+					Code:          utils.String("ComponentStatus/StdOut/succeeded"),
+					Level:         compute.Info,
+					DisplayStatus: utils.String("Provisioning succeeded"),
+					Message:       utils.String("[terraform-exit-code]"),
+				},
+				{
+					Code:          utils.String("ComponentStatus/StdErr/succeeded"),
+					Level:         compute.Info,
+					DisplayStatus: utils.String("Provisioning succeeded"),
+					Message:       utils.String("Get-Item : Cannot find path 'C:\\missing' because it does not exist."),
+				},
+			},
+			Expected: azureRmVirtualMachineRunCommandOutput{
+				Failed:  true,
+				Message: "the script wrote to StdErr",
+				StdErr:  "Get-Item : Cannot find path 'C:\\missing' because it does not exist.",
+			},
+		},
+		{
+			Name: "Windows Failed with an Exit Code",
+			Input: &[]compute.InstanceViewStatus{
+				{
+					Code:          utils.String("ComponentStatus/StdOut/succeeded"),
+					Level:         compute.Info,
+					DisplayStatus: utils.String("Provisioning succeeded"),
+					Message:       utils.String("installing\n[terraform-exit-code]1603"),
+				},
+				{
+					Code:          utils.String("ComponentStatus/StdErr/succeeded"),
+					Level:         compute.Info,
+					DisplayStatus: utils.String("Provisioning succeeded"),
+					Message:       utils.String(""),
+				},
+			},
+			Expected: azureRmVirtualMachineRunCommandOutput{
+				Failed:  true,
+				Message: "the script exited with code 1603",
+				StdOut:  "installing",
+			},
+		},
+		{
+			Name: "Windows Failed with an Exit Code and no StdErr",
+			Input: &[]compute.InstanceViewStatus{
+				{
+					Code:          utils.String("ComponentStatus/StdOut/succeeded"),
+					Level:         compute.Info,
+					DisplayStatus: utils.String("Provisioning succeeded"),
+					Message:       utils.String("[terraform-exit-code]1"),
+				},
+				{
+					Code:          utils.String("ComponentStatus/StdErr/succeeded"),
+					Level:         compute.Info,
+					DisplayStatus: utils.String("Provisioning succeeded"),
+					Message:       utils.String(""),
+				},
+			},
+			Expected: azureRmVirtualMachineRunCommandOutput{
+				Failed:  true,
+				Message: "the script exited with code 1",
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		actual := parseAzureRmVirtualMachineRunCommandResult(v.Input)
+		if actual != v.Expected {
+			t.Fatalf("Expected %+v but got %+v", v.Expected, actual)
+		}
+	}
+}
+
+func TestAzureRMVirtualMachineRunCommandPowerShellWrapper(t *testing.T) {
+	script := "Write-Output 'it''s done'\nexit 1"
+	encoded := base64.StdEncoding.EncodeToString([]byte(script))
+
+	wrapper := strings.Join(wrapAzureRmVirtualMachineRunCommandPowerShellScript(script), "\n")
+
+	// the script is run in a child process, so that the exit code is reported even when the script calls `exit`
+	if strings.Contains(wrapper, "exit 1") {
+		t.Fatalf("Expected the script to be encoded but got:\n%s", wrapper)
+	}
+	if !strings.Contains(wrapper, encoded) {
+		t.Fatalf("Expected the wrapper to contain the encoded script %q but got:\n%s", encoded, wrapper)
+	}
+	if !strings.Contains(wrapper, "-File $path @args") {
+		t.Fatalf("Expected the script to be run as a file but got:\n%s", wrapper)
+	}
+
+	lines := strings.Split(wrapper, "\n")
+	if last := lines[len(lines)-1]; last != "Write-Output \"[terraform-exit-code]$exitCode\"" {
+		t.Fatalf("Expected the exit code to be written last but got %q", last)
+	}
+}
+
+func testAccAzureRMVirtualMachineRunCommand_basic(rInt int, location, trigger string) string {
+	template := testAccAzureRMVirtualMachineRunCommand_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_machine_run_command" "test" {
+  name               = "acctestrc-%d"
+  virtual_machine_id = "${azurerm_virtual_machine.test.id}"
+  script             = "echo \"hello $arg1\""
+
+  parameters = {
+    "arg1" = "%s"
+  }
+
+  triggers = {
+    "value" = "%s"
+  }
+}
+`, template, rInt, trigger, trigger)
+}
+
+func testAccAzureRMVirtualMachineRunCommand_failure(rInt int, location string) string {
+	template := testAccAzureRMVirtualMachineRunCommand_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_machine_run_command" "test" {
+  name               = "acctestrc-%d"
+  virtual_machine_id = "${azurerm_virtual_machine.test.id}"
+  script             = "exit 1"
+}
+`, template, rInt)
+}
+
+func testAccAzureRMVirtualMachineRunCommand_template(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctvn-%[1]d"
+  address_space       = ["10.0.0.0/16"]
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+
+resource "azurerm_subnet" "test" {
+  name                 = "acctsub-%[1]d"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+  virtual_network_name = "${azurerm_virtual_network.test.name}"
+  address_prefix       = "10.0.2.0/24"
+}
+
+resource "azurerm_network_interface" "test" {
+  name                = "acctni-%[1]d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  ip_configuration {
+    name                          = "testconfiguration1"
+    subnet_id                     = "${azurerm_subnet.test.id}"
+    private_ip_address_allocation = "dynamic"
+  }
+}
+
+resource "azurerm_virtual_machine" "test" {
+  name                  = "acctvm-%[1]d"
+  location              = "${azurerm_resource_group.test.location}"
+  resource_group_name   = "${azurerm_resource_group.test.name}"
+  network_interface_ids = ["${azurerm_network_interface.test.id}"]
+  vm_size               = "Standard_F2"
+
+  delete_os_disk_on_termination = true
+
+  storage_image_reference {
+    publisher = "Canonical"
+    offer     = "UbuntuServer"
+    sku       = "16.04-LTS"
+    version   = "latest"
+  }
+
+  storage_os_disk {
+    name              = "myosdisk1"
+    caching           = "ReadWrite"
+    create_option     = "FromImage"
+    managed_disk_type = "Standard_LRS"
+  }
+
+  os_profile {
+    computer_name  = "hn%[1]d"
+    admin_username = "testadmin"
+    admin_password = "Password1234!"
+  }
+
+  os_profile_linux_config {
+    disable_password_authentication = false
+  }
+}
+`, rInt, location)
+}
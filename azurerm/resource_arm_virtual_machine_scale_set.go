@@ -2,20 +2,30 @@ package azurerm
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"log"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2018-06-01/compute"
 
 	"github.com/hashicorp/terraform/helper/hashcode"
+	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/structure"
 	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/polling"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
+var virtualMachineScaleSetResourceName = "azurerm_virtual_machine_scale_set"
+
 func resourceArmVirtualMachineScaleSet() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceArmVirtualMachineScaleSetCreate,
@@ -23,8 +33,17 @@ func resourceArmVirtualMachineScaleSet() *schema.Resource {
 		Update: resourceArmVirtualMachineScaleSetCreate,
 		Delete: resourceArmVirtualMachineScaleSetDelete,
 
+		// the `extension` block is left empty when importing, since it's not possible to tell which of the Extensions
+		// are managed using the `azurerm_virtual_machine_scale_set_extension` resource
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: azure.ImportVirtualMachineScaleSetID,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -121,6 +140,56 @@ func resourceArmVirtualMachineScaleSet() *schema.Resource {
 				}, true),
 			},
 
+			"health_probe_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: azure.ValidateLoadBalancerProbeID,
+			},
+
+			"rolling_upgrade_policy": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"max_batch_instance_percent": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      20,
+							ValidateFunc: validation.IntBetween(5, 100),
+						},
+
+						"max_unhealthy_instance_percent": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      20,
+							ValidateFunc: validation.IntBetween(5, 100),
+						},
+
+						"max_unhealthy_upgraded_instance_percent": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      20,
+							ValidateFunc: validation.IntBetween(0, 100),
+						},
+
+						"pause_time_between_batches": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "PT0S",
+							ValidateFunc: validateIso8601Duration(),
+						},
+					},
+				},
+			},
+
+			"upgrade_instances_on_update": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
 			"overprovision": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -144,6 +213,28 @@ func resourceArmVirtualMachineScaleSet() *schema.Resource {
 				}, true),
 			},
 
+			"eviction_policy": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(compute.Deallocate),
+					string(compute.Delete),
+				}, false),
+			},
+
+			"scale_in_policy": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  virtualMachineScaleSetScaleInPolicyDefault,
+				ValidateFunc: validation.StringInSlice([]string{
+					virtualMachineScaleSetScaleInPolicyDefault,
+					virtualMachineScaleSetScaleInPolicyNewestVM,
+					virtualMachineScaleSetScaleInPolicyOldestVM,
+				}, false),
+			},
+
 			"os_profile": {
 				Type:     schema.TypeList,
 				Required: true,
@@ -187,7 +278,7 @@ func resourceArmVirtualMachineScaleSet() *schema.Resource {
 						"source_vault_id": {
 							Type:         schema.TypeString,
 							Required:     true,
-							ValidateFunc: azure.ValidateResourceID,
+							ValidateFunc: azure.ValidateKeyVaultID,
 						},
 
 						"vault_certificates": {
@@ -334,7 +425,7 @@ func resourceArmVirtualMachineScaleSet() *schema.Resource {
 						"network_security_group_id": {
 							Type:         schema.TypeString,
 							Optional:     true,
-							ValidateFunc: azure.ValidateResourceID,
+							ValidateFunc: azure.ValidateNetworkSecurityGroupID,
 						},
 
 						"dns_settings": {
@@ -556,8 +647,9 @@ func resourceArmVirtualMachineScaleSet() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"id": {
-							Type:     schema.TypeString,
-							Optional: true,
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: azure.ValidateImageReferenceID,
 						},
 
 						"publisher": {
@@ -664,7 +756,8 @@ func resourceArmVirtualMachineScaleSet() *schema.Resource {
 
 func resourceArmVirtualMachineScaleSetCreate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).vmScaleSetClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for Azure ARM Virtual Machine Scale Set creation.")
 
@@ -674,6 +767,9 @@ func resourceArmVirtualMachineScaleSetCreate(d *schema.ResourceData, meta interf
 	tags := d.Get("tags").(map[string]interface{})
 	zones := expandZones(d.Get("zones").([]interface{}))
 
+	azureRMLockByName(name, virtualMachineScaleSetResourceName)
+	defer azureRMUnlockByName(name, virtualMachineScaleSetResourceName)
+
 	sku, err := expandVirtualMachineScaleSetSku(d)
 	if err != nil {
 		return err
@@ -712,14 +808,27 @@ func resourceArmVirtualMachineScaleSetCreate(d *schema.ResourceData, meta interf
 		return err
 	}
 
+	if !d.IsNewResource() {
+		// Extensions can also be managed using the `azurerm_virtual_machine_scale_set_extension` resource - since the
+		// Protected Settings for these can't be retrieved (and so would be removed if these were sent) the Extension
+		// Profile is omitted when updating the Scale Set, and changes to the `extension` block are applied separately
+		extensions = nil
+	}
+
 	updatePolicy := d.Get("upgrade_policy_mode").(string)
 	overprovision := d.Get("overprovision").(bool)
 	singlePlacementGroup := d.Get("single_placement_group").(bool)
 	priority := d.Get("priority").(string)
+	evictionPolicy := d.Get("eviction_policy").(string)
+
+	if evictionPolicy != "" && !strings.EqualFold(priority, string(compute.Low)) {
+		return fmt.Errorf("Error: `eviction_policy` can only be specified when `priority` is set to `Low`")
+	}
 
 	scaleSetProps := compute.VirtualMachineScaleSetProperties{
 		UpgradePolicy: &compute.UpgradePolicy{
-			Mode: compute.UpgradeMode(updatePolicy),
+			Mode:                 compute.UpgradeMode(updatePolicy),
+			RollingUpgradePolicy: expandAzureRmVirtualMachineScaleSetRollingUpgradePolicy(d),
 		},
 		VirtualMachineProfile: &compute.VirtualMachineScaleSetVMProfile{
 			NetworkProfile:   expandAzureRmVirtualMachineScaleSetNetworkProfile(d),
@@ -727,6 +836,7 @@ func resourceArmVirtualMachineScaleSetCreate(d *schema.ResourceData, meta interf
 			OsProfile:        osProfile,
 			ExtensionProfile: extensions,
 			Priority:         compute.VirtualMachinePriorityTypes(priority),
+			EvictionPolicy:   compute.VirtualMachineEvictionPolicyTypes(evictionPolicy),
 		},
 		Overprovision:        &overprovision,
 		SinglePlacementGroup: &singlePlacementGroup,
@@ -740,7 +850,7 @@ func resourceArmVirtualMachineScaleSetCreate(d *schema.ResourceData, meta interf
 	properties := compute.VirtualMachineScaleSet{
 		Name:     &name,
 		Location: &location,
-		Tags:     expandTags(tags),
+		Tags:     expandTags(tags, meta),
 		Sku:      sku,
 		VirtualMachineScaleSetProperties: &scaleSetProps,
 		Zones: zones,
@@ -763,13 +873,53 @@ func resourceArmVirtualMachineScaleSetCreate(d *schema.ResourceData, meta interf
 		properties.Plan = plan
 	}
 
-	future, err := client.CreateOrUpdate(ctx, resGroup, name, properties)
-	if err != nil {
+	// where a previous run was interrupted whilst updating the Scale Set, wait for that operation rather than failing
+	var future compute.VirtualMachineScaleSetsCreateOrUpdateFuture
+	var updateStarted time.Time
+	start := func() error {
+		// any Rolling Upgrade started before this point belongs to a previous update
+		updateStarted = time.Now()
+		future, err = client.CreateOrUpdate(ctx, resGroup, name, properties)
 		return err
 	}
+	provisioningState := func() (string, error) {
+		existing, err := client.Get(ctx, resGroup, name)
+		if err != nil {
+			return "", err
+		}
+		if props := existing.VirtualMachineScaleSetProperties; props != nil && props.ProvisioningState != nil {
+			return *props.ProvisioningState, nil
+		}
+		return "", nil
+	}
+	if err := polling.StartOrAttach(ctx, start, provisioningState); err != nil {
+		return fmt.Errorf("Error creating/updating Virtual Machine Scale Set %q (Resource Group %q): %+v", name, resGroup, err)
+	}
 
-	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return err
+	if err := polling.WaitForCompletion(ctx, &future.Future, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for the creation/update of Virtual Machine Scale Set %q (Resource Group %q): %+v", name, resGroup, err)
+	}
+
+	if !d.IsNewResource() && d.HasChange("extension") {
+		extensionsClient := meta.(*ArmClient).vmScaleSetExtensionsClient
+		if err := updateAzureRmVirtualMachineScaleSetInlineExtensions(ctx, extensionsClient, d, resGroup, name); err != nil {
+			return err
+		}
+	}
+
+	// the Scale-In Policy isn't available in the API Version used above, so it's updated separately when it changes
+	scaleInPolicy := d.Get("scale_in_policy").(string)
+	if (d.IsNewResource() && scaleInPolicy != virtualMachineScaleSetScaleInPolicyDefault) || (!d.IsNewResource() && d.HasChange("scale_in_policy")) {
+		log.Printf("[DEBUG] Updating the Scale-In Policy for Virtual Machine Scale Set %q (Resource Group %q) to %q..", name, resGroup, scaleInPolicy)
+		if err := updateAzureRmVirtualMachineScaleSetScaleInPolicy(ctx, client, resGroup, name, scaleInPolicy); err != nil {
+			return fmt.Errorf("Error updating the Scale-In Policy for Virtual Machine Scale Set %q (Resource Group %q): %+v", name, resGroup, err)
+		}
+	}
+
+	if !d.IsNewResource() {
+		if err := rolloutAzureRmVirtualMachineScaleSetModel(ctx, d, meta, resGroup, name, updateStarted); err != nil {
+			return err
+		}
 	}
 
 	read, err := client.Get(ctx, resGroup, name)
@@ -787,7 +937,8 @@ func resourceArmVirtualMachineScaleSetCreate(d *schema.ResourceData, meta interf
 
 func resourceArmVirtualMachineScaleSetRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).vmScaleSetClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
@@ -826,6 +977,11 @@ func resourceArmVirtualMachineScaleSetRead(d *schema.ResourceData, meta interfac
 
 		if upgradePolicy := properties.UpgradePolicy; upgradePolicy != nil {
 			d.Set("upgrade_policy_mode", upgradePolicy.Mode)
+
+			rollingUpgradePolicy := flattenAzureRmVirtualMachineScaleSetRollingUpgradePolicy(upgradePolicy.RollingUpgradePolicy)
+			if err := d.Set("rolling_upgrade_policy", rollingUpgradePolicy); err != nil {
+				return fmt.Errorf("[DEBUG] Error setting `rolling_upgrade_policy`: %+v", err)
+			}
 		}
 		d.Set("overprovision", properties.Overprovision)
 		d.Set("single_placement_group", properties.SinglePlacementGroup)
@@ -833,6 +989,7 @@ func resourceArmVirtualMachineScaleSetRead(d *schema.ResourceData, meta interfac
 		if profile := properties.VirtualMachineProfile; profile != nil {
 			d.Set("license_type", profile.LicenseType)
 			d.Set("priority", profile.Priority)
+			d.Set("eviction_policy", string(profile.EvictionPolicy))
 
 			osProfile := flattenAzureRMVirtualMachineScaleSetOsProfile(d, profile.OsProfile)
 			if err := d.Set("os_profile", osProfile); err != nil {
@@ -874,6 +1031,12 @@ func resourceArmVirtualMachineScaleSetRead(d *schema.ResourceData, meta interfac
 			}
 
 			if networkProfile := profile.NetworkProfile; networkProfile != nil {
+				healthProbeId := ""
+				if probe := networkProfile.HealthProbe; probe != nil && probe.ID != nil {
+					healthProbeId = *probe.ID
+				}
+				d.Set("health_probe_id", healthProbeId)
+
 				flattenedNetworkProfile := flattenAzureRmVirtualMachineScaleSetNetworkProfile(networkProfile)
 				if err := d.Set("network_profile", flattenedNetworkProfile); err != nil {
 					return fmt.Errorf("[DEBUG] Error setting `network_profile`: %#v", err)
@@ -904,7 +1067,10 @@ func resourceArmVirtualMachineScaleSetRead(d *schema.ResourceData, meta interfac
 			}
 
 			if extensionProfile := properties.VirtualMachineProfile.ExtensionProfile; extensionProfile != nil {
-				extension, err := flattenAzureRmVirtualMachineScaleSetExtensionProfile(extensionProfile)
+				// only the Extensions defined in the `extension` block are tracked, since others may be managed
+				// by the `azurerm_virtual_machine_scale_set_extension` resource
+				inlineExtensionNames := azureRmVirtualMachineScaleSetInlineExtensionNames(d.Get("extension").(*schema.Set).List())
+				extension, err := flattenAzureRmVirtualMachineScaleSetExtensionProfile(extensionProfile, inlineExtensionNames)
 				if err != nil {
 					return fmt.Errorf("[DEBUG] Error setting Virtual Machine Scale Set Extension Profile error: %#v", err)
 				}
@@ -922,14 +1088,21 @@ func resourceArmVirtualMachineScaleSetRead(d *schema.ResourceData, meta interfac
 		}
 	}
 
-	flattenAndSetTags(d, resp.Tags)
+	scaleInPolicy, err := getAzureRmVirtualMachineScaleSetScaleInPolicy(ctx, client, resGroup, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving the Scale-In Policy for Virtual Machine Scale Set %q (Resource Group %q): %+v", name, resGroup, err)
+	}
+	d.Set("scale_in_policy", scaleInPolicy)
+
+	flattenAndSetResourceTags(d, resp.Tags, meta)
 
 	return nil
 }
 
 func resourceArmVirtualMachineScaleSetDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).vmScaleSetClient
-	ctx := meta.(*ArmClient).StopContext
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
@@ -943,7 +1116,7 @@ func resourceArmVirtualMachineScaleSetDelete(d *schema.ResourceData, meta interf
 		return err
 	}
 
-	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+	if err := polling.WaitForCompletion(ctx, &future.Future, client.Client); err != nil {
 		return err
 	}
 
@@ -1293,13 +1466,21 @@ func flattenAzureRmVirtualMachineScaleSetSku(sku *compute.Sku) []interface{} {
 	return []interface{}{result}
 }
 
-func flattenAzureRmVirtualMachineScaleSetExtensionProfile(profile *compute.VirtualMachineScaleSetExtensionProfile) ([]map[string]interface{}, error) {
+func flattenAzureRmVirtualMachineScaleSetExtensionProfile(profile *compute.VirtualMachineScaleSetExtensionProfile, inlineExtensionNames map[string]struct{}) ([]map[string]interface{}, error) {
 	if profile.Extensions == nil {
 		return nil, nil
 	}
 
 	result := make([]map[string]interface{}, 0, len(*profile.Extensions))
 	for _, extension := range *profile.Extensions {
+		if extension.Name == nil {
+			continue
+		}
+
+		if _, ok := inlineExtensionNames[*extension.Name]; !ok {
+			continue
+		}
+
 		e := make(map[string]interface{})
 		e["name"] = *extension.Name
 		properties := extension.VirtualMachineScaleSetExtensionProperties
@@ -1327,6 +1508,84 @@ func flattenAzureRmVirtualMachineScaleSetExtensionProfile(profile *compute.Virtu
 	return result, nil
 }
 
+// azureRmVirtualMachineScaleSetInlineExtensionNames returns the names of the Extensions defined in the `extension` block
+func azureRmVirtualMachineScaleSetInlineExtensionNames(input []interface{}) map[string]struct{} {
+	names := make(map[string]struct{})
+
+	for _, v := range input {
+		if extension, ok := v.(map[string]interface{}); ok {
+			names[extension["name"].(string)] = struct{}{}
+		}
+	}
+
+	return names
+}
+
+// diffAzureRmVirtualMachineScaleSetInlineExtensions returns the Extensions in the `extension` block which have been
+// added or changed, and the names of the Extensions which have been removed from it
+func diffAzureRmVirtualMachineScaleSetInlineExtensions(old *schema.Set, new *schema.Set) ([]interface{}, []string) {
+	// the Protected Settings aren't part of the hash, so Extensions are compared by name
+	existing := make(map[string]interface{})
+	for _, v := range old.List() {
+		existing[v.(map[string]interface{})["name"].(string)] = v
+	}
+
+	changed := make([]interface{}, 0)
+	for _, v := range new.List() {
+		name := v.(map[string]interface{})["name"].(string)
+		if previous, ok := existing[name]; !ok || !reflect.DeepEqual(previous, v) {
+			changed = append(changed, v)
+		}
+		delete(existing, name)
+	}
+
+	removed := make([]string, 0, len(existing))
+	for name := range existing {
+		removed = append(removed, name)
+	}
+	sort.Strings(removed)
+
+	return changed, removed
+}
+
+// updateAzureRmVirtualMachineScaleSetInlineExtensions applies the changes to the `extension` block to each Extension
+// individually, so that the Extensions which are managed outside of this resource are left as-is
+func updateAzureRmVirtualMachineScaleSetInlineExtensions(ctx context.Context, client compute.VirtualMachineScaleSetExtensionsClient, d *schema.ResourceData, resGroup, name string) error {
+	oldExtensions, newExtensions := d.GetChange("extension")
+	changed, removed := diffAzureRmVirtualMachineScaleSetInlineExtensions(oldExtensions.(*schema.Set), newExtensions.(*schema.Set))
+
+	for _, extensionName := range removed {
+		log.Printf("[DEBUG] Removing Extension %q from Virtual Machine Scale Set %q (Resource Group %q)..", extensionName, name, resGroup)
+		future, err := client.Delete(ctx, resGroup, name, extensionName)
+		if err != nil {
+			return fmt.Errorf("Error removing Extension %q from Virtual Machine Scale Set %q (Resource Group %q): %+v", extensionName, name, resGroup, err)
+		}
+
+		if err := polling.WaitForCompletion(ctx, &future.Future, client.Client); err != nil {
+			return fmt.Errorf("Error waiting for the removal of Extension %q from Virtual Machine Scale Set %q (Resource Group %q): %+v", extensionName, name, resGroup, err)
+		}
+	}
+
+	for _, v := range changed {
+		extension, err := expandAzureRMVirtualMachineScaleSetExtension(v.(map[string]interface{}))
+		if err != nil {
+			return err
+		}
+
+		log.Printf("[DEBUG] Updating Extension %q on Virtual Machine Scale Set %q (Resource Group %q)..", *extension.Name, name, resGroup)
+		future, err := client.CreateOrUpdate(ctx, resGroup, name, *extension.Name, extension)
+		if err != nil {
+			return fmt.Errorf("Error updating Extension %q on Virtual Machine Scale Set %q (Resource Group %q): %+v", *extension.Name, name, resGroup, err)
+		}
+
+		if err := polling.WaitForCompletion(ctx, &future.Future, client.Client); err != nil {
+			return fmt.Errorf("Error waiting for the update of Extension %q on Virtual Machine Scale Set %q (Resource Group %q): %+v", *extension.Name, name, resGroup, err)
+		}
+	}
+
+	return nil
+}
+
 func resourceArmVirtualMachineScaleSetStorageProfileImageReferenceHash(v interface{}) int {
 	var buf bytes.Buffer
 
@@ -1581,9 +1840,17 @@ func expandAzureRmVirtualMachineScaleSetNetworkProfile(d *schema.ResourceData) *
 		networkProfileConfig = append(networkProfileConfig, nProfile)
 	}
 
-	return &compute.VirtualMachineScaleSetNetworkProfile{
+	networkProfile := compute.VirtualMachineScaleSetNetworkProfile{
 		NetworkInterfaceConfigurations: &networkProfileConfig,
 	}
+
+	if v := d.Get("health_probe_id").(string); v != "" {
+		networkProfile.HealthProbe = &compute.APIEntityReference{
+			ID: utils.String(v),
+		}
+	}
+
+	return &networkProfile
 }
 
 func expandAzureRMVirtualMachineScaleSetsOsProfile(d *schema.ResourceData) (*compute.VirtualMachineScaleSetOSProfile, error) {
@@ -1955,40 +2222,9 @@ func expandAzureRMVirtualMachineScaleSetExtensions(d *schema.ResourceData) (*com
 	extensions := d.Get("extension").(*schema.Set).List()
 	resources := make([]compute.VirtualMachineScaleSetExtension, 0, len(extensions))
 	for _, e := range extensions {
-		config := e.(map[string]interface{})
-		name := config["name"].(string)
-		publisher := config["publisher"].(string)
-		t := config["type"].(string)
-		version := config["type_handler_version"].(string)
-
-		extension := compute.VirtualMachineScaleSetExtension{
-			Name: &name,
-			VirtualMachineScaleSetExtensionProperties: &compute.VirtualMachineScaleSetExtensionProperties{
-				Publisher:          &publisher,
-				Type:               &t,
-				TypeHandlerVersion: &version,
-			},
-		}
-
-		if u := config["auto_upgrade_minor_version"]; u != nil {
-			upgrade := u.(bool)
-			extension.VirtualMachineScaleSetExtensionProperties.AutoUpgradeMinorVersion = &upgrade
-		}
-
-		if s := config["settings"].(string); s != "" {
-			settings, err := structure.ExpandJsonFromString(s)
-			if err != nil {
-				return nil, fmt.Errorf("unable to parse settings: %+v", err)
-			}
-			extension.VirtualMachineScaleSetExtensionProperties.Settings = &settings
-		}
-
-		if s := config["protected_settings"].(string); s != "" {
-			protectedSettings, err := structure.ExpandJsonFromString(s)
-			if err != nil {
-				return nil, fmt.Errorf("unable to parse protected_settings: %+v", err)
-			}
-			extension.VirtualMachineScaleSetExtensionProperties.ProtectedSettings = &protectedSettings
+		extension, err := expandAzureRMVirtualMachineScaleSetExtension(e.(map[string]interface{}))
+		if err != nil {
+			return nil, err
 		}
 
 		resources = append(resources, extension)
@@ -1999,6 +2235,45 @@ func expandAzureRMVirtualMachineScaleSetExtensions(d *schema.ResourceData) (*com
 	}, nil
 }
 
+func expandAzureRMVirtualMachineScaleSetExtension(config map[string]interface{}) (compute.VirtualMachineScaleSetExtension, error) {
+	name := config["name"].(string)
+	publisher := config["publisher"].(string)
+	t := config["type"].(string)
+	version := config["type_handler_version"].(string)
+
+	extension := compute.VirtualMachineScaleSetExtension{
+		Name: &name,
+		VirtualMachineScaleSetExtensionProperties: &compute.VirtualMachineScaleSetExtensionProperties{
+			Publisher:          &publisher,
+			Type:               &t,
+			TypeHandlerVersion: &version,
+		},
+	}
+
+	if u := config["auto_upgrade_minor_version"]; u != nil {
+		upgrade := u.(bool)
+		extension.VirtualMachineScaleSetExtensionProperties.AutoUpgradeMinorVersion = &upgrade
+	}
+
+	if s := config["settings"].(string); s != "" {
+		settings, err := structure.ExpandJsonFromString(s)
+		if err != nil {
+			return extension, fmt.Errorf("unable to parse settings: %+v", err)
+		}
+		extension.VirtualMachineScaleSetExtensionProperties.Settings = &settings
+	}
+
+	if s := config["protected_settings"].(string); s != "" {
+		protectedSettings, err := structure.ExpandJsonFromString(s)
+		if err != nil {
+			return extension, fmt.Errorf("unable to parse protected_settings: %+v", err)
+		}
+		extension.VirtualMachineScaleSetExtensionProperties.ProtectedSettings = &protectedSettings
+	}
+
+	return extension, nil
+}
+
 func expandAzureRmVirtualMachineScaleSetPlan(d *schema.ResourceData) (*compute.Plan, error) {
 	planConfigs := d.Get("plan").(*schema.Set).List()
 
@@ -2024,3 +2299,197 @@ func flattenAzureRmVirtualMachineScaleSetPlan(plan *compute.Plan) []interface{}
 
 	return []interface{}{result}
 }
+
+func expandAzureRmVirtualMachineScaleSetRollingUpgradePolicy(d *schema.ResourceData) *compute.RollingUpgradePolicy {
+	// the Rolling Upgrade Policy is only applicable when the Upgrade Mode is Rolling
+	if !strings.EqualFold(d.Get("upgrade_policy_mode").(string), string(compute.Rolling)) {
+		return nil
+	}
+
+	policies := d.Get("rolling_upgrade_policy").([]interface{})
+	if len(policies) == 0 || policies[0] == nil {
+		return nil
+	}
+
+	policy := policies[0].(map[string]interface{})
+	return &compute.RollingUpgradePolicy{
+		MaxBatchInstancePercent:             utils.Int32(int32(policy["max_batch_instance_percent"].(int))),
+		MaxUnhealthyInstancePercent:         utils.Int32(int32(policy["max_unhealthy_instance_percent"].(int))),
+		MaxUnhealthyUpgradedInstancePercent: utils.Int32(int32(policy["max_unhealthy_upgraded_instance_percent"].(int))),
+		PauseTimeBetweenBatches:             utils.String(policy["pause_time_between_batches"].(string)),
+	}
+}
+
+func flattenAzureRmVirtualMachineScaleSetRollingUpgradePolicy(policy *compute.RollingUpgradePolicy) []interface{} {
+	if policy == nil {
+		return []interface{}{}
+	}
+
+	result := make(map[string]interface{})
+	if v := policy.MaxBatchInstancePercent; v != nil {
+		result["max_batch_instance_percent"] = int(*v)
+	}
+	if v := policy.MaxUnhealthyInstancePercent; v != nil {
+		result["max_unhealthy_instance_percent"] = int(*v)
+	}
+	if v := policy.MaxUnhealthyUpgradedInstancePercent; v != nil {
+		result["max_unhealthy_upgraded_instance_percent"] = int(*v)
+	}
+	if v := policy.PauseTimeBetweenBatches; v != nil {
+		result["pause_time_between_batches"] = *v
+	}
+
+	return []interface{}{result}
+}
+
+// rolloutAzureRmVirtualMachineScaleSetModel ensures an updated model is applied to the existing instances in the
+// Scale Set: in Rolling mode Azure rolls this out itself, so we wait for that to complete - whereas in Manual mode
+// the instances are only upgraded when `upgrade_instances_on_update` is enabled
+func rolloutAzureRmVirtualMachineScaleSetModel(ctx context.Context, d *schema.ResourceData, meta interface{}, resGroup, name string, updateStarted time.Time) error {
+	switch mode := d.Get("upgrade_policy_mode").(string); {
+	case strings.EqualFold(mode, string(compute.Rolling)):
+		return waitForAzureRmVirtualMachineScaleSetRollingUpgrade(ctx, d, meta, resGroup, name, updateStarted)
+
+	case strings.EqualFold(mode, string(compute.Manual)) && d.Get("upgrade_instances_on_update").(bool):
+		return upgradeAzureRmVirtualMachineScaleSetInstances(ctx, meta, resGroup, name)
+	}
+
+	return nil
+}
+
+func waitForAzureRmVirtualMachineScaleSetRollingUpgrade(ctx context.Context, d *schema.ResourceData, meta interface{}, resGroup, name string, updateStarted time.Time) error {
+	client := meta.(*ArmClient).vmScaleSetRollingUpgradesClient
+	vmsClient := meta.(*ArmClient).vmScaleSetVMsClient
+
+	log.Printf("[DEBUG] Waiting for the Rolling Upgrade of Virtual Machine Scale Set %q (Resource Group %q) to complete..", name, resGroup)
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"None", string(compute.RollingUpgradeStatusCodeRollingForward)},
+		Target:     []string{"NotRequired", string(compute.RollingUpgradeStatusCodeCompleted), string(compute.RollingUpgradeStatusCodeCancelled), string(compute.RollingUpgradeStatusCodeFaulted)},
+		Refresh:    virtualMachineScaleSetRollingUpgradeStateRefreshFunc(ctx, client, vmsClient, resGroup, name, updateStarted),
+		Timeout:    d.Timeout(schema.TimeoutUpdate),
+		MinTimeout: 15 * time.Second,
+	}
+	result, err := stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("Error waiting for the Rolling Upgrade of Virtual Machine Scale Set %q (Resource Group %q) to complete: %+v", name, resGroup, err)
+	}
+
+	status, ok := result.(compute.RollingUpgradeStatusInfo)
+	if !ok {
+		// no Rolling Upgrade was required for this update, e.g. as only the capacity was changed
+		return nil
+	}
+
+	props := status.RollingUpgradeStatusInfoProperties
+	if props == nil || props.RunningStatus == nil || props.RunningStatus.Code == compute.RollingUpgradeStatusCodeCompleted {
+		return nil
+	}
+
+	message := "no error details were returned"
+	if apiError := props.Error; apiError != nil && apiError.Code != nil && apiError.Message != nil {
+		message = fmt.Sprintf("Code %q / Message %q", *apiError.Code, *apiError.Message)
+	}
+	failedInstances := int32(0)
+	if progress := props.Progress; progress != nil && progress.FailedInstanceCount != nil {
+		failedInstances = *progress.FailedInstanceCount
+	}
+
+	return fmt.Errorf("The Rolling Upgrade of Virtual Machine Scale Set %q (Resource Group %q) finished with the status %q (%d instances failed to upgrade): %s", name, resGroup, string(props.RunningStatus.Code), failedInstances, message)
+}
+
+// virtualMachineScaleSetRollingUpgradeStartTimeout is how long to wait for Azure to start the Rolling Upgrade for an
+// update which changed the model of the instances, before assuming it isn't going to be started
+const virtualMachineScaleSetRollingUpgradeStartTimeout = 10 * time.Minute
+
+// virtualMachineScaleSetRollingUpgradeStateRefreshFunc returns the status of the Rolling Upgrade started by this update.
+// Azure doesn't start this straight away - so until it's started this returns `None` whilst any instances aren't using
+// the latest model, or `NotRequired` where the update didn't change the model of the instances.
+func virtualMachineScaleSetRollingUpgradeStateRefreshFunc(ctx context.Context, client compute.VirtualMachineScaleSetRollingUpgradesClient, vmsClient compute.VirtualMachineScaleSetVMsClient, resGroup, name string, updateStarted time.Time) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := client.GetLatest(ctx, resGroup, name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(resp.Response) {
+				return nil, "", fmt.Errorf("Error retrieving the latest Rolling Upgrade for Virtual Machine Scale Set %q (Resource Group %q): %+v", name, resGroup, err)
+			}
+		} else if props := resp.RollingUpgradeStatusInfoProperties; props != nil && props.RunningStatus != nil {
+			// any Rolling Upgrades which were started prior to this update are ignored
+			if startTime := props.RunningStatus.StartTime; startTime != nil && !startTime.ToTime().Before(updateStarted) {
+				if progress := props.Progress; progress != nil && progress.SuccessfulInstanceCount != nil && progress.PendingInstanceCount != nil {
+					log.Printf("[DEBUG] Rolling Upgrade of Virtual Machine Scale Set %q (Resource Group %q) is %q: %d instances upgraded, %d pending", name, resGroup, string(props.RunningStatus.Code), *progress.SuccessfulInstanceCount, *progress.PendingInstanceCount)
+				}
+
+				return resp, string(props.RunningStatus.Code), nil
+			}
+		}
+
+		instanceIds, err := listAzureRmVirtualMachineScaleSetOutdatedInstanceIds(ctx, vmsClient, resGroup, name)
+		if err != nil {
+			return nil, "", err
+		}
+
+		if len(instanceIds) == 0 {
+			return "None", "NotRequired", nil
+		}
+
+		if time.Since(updateStarted) > virtualMachineScaleSetRollingUpgradeStartTimeout {
+			return nil, "", fmt.Errorf("no Rolling Upgrade was started within %s of updating Virtual Machine Scale Set %q (Resource Group %q), although instances %q aren't using the latest model", virtualMachineScaleSetRollingUpgradeStartTimeout, name, resGroup, strings.Join(instanceIds, ", "))
+		}
+
+		log.Printf("[DEBUG] Waiting for the Rolling Upgrade of Virtual Machine Scale Set %q (Resource Group %q) to start: instances %q aren't using the latest model", name, resGroup, strings.Join(instanceIds, ", "))
+		return "None", "None", nil
+	}
+}
+
+func upgradeAzureRmVirtualMachineScaleSetInstances(ctx context.Context, meta interface{}, resGroup, name string) error {
+	client := meta.(*ArmClient).vmScaleSetClient
+	vmsClient := meta.(*ArmClient).vmScaleSetVMsClient
+
+	instanceIds, err := listAzureRmVirtualMachineScaleSetOutdatedInstanceIds(ctx, vmsClient, resGroup, name)
+	if err != nil {
+		return err
+	}
+
+	if len(instanceIds) == 0 {
+		log.Printf("[DEBUG] The latest model has been applied to all instances of Virtual Machine Scale Set %q (Resource Group %q)", name, resGroup)
+		return nil
+	}
+
+	log.Printf("[DEBUG] Upgrading instances %q of Virtual Machine Scale Set %q (Resource Group %q) to the latest model..", strings.Join(instanceIds, ", "), name, resGroup)
+	ids := compute.VirtualMachineScaleSetVMInstanceRequiredIDs{
+		InstanceIds: &instanceIds,
+	}
+	future, err := client.UpdateInstances(ctx, resGroup, name, ids)
+	if err != nil {
+		return fmt.Errorf("Error upgrading the instances of Virtual Machine Scale Set %q (Resource Group %q): %+v", name, resGroup, err)
+	}
+
+	if err := polling.WaitForCompletion(ctx, &future.Future, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for the instances of Virtual Machine Scale Set %q (Resource Group %q) to be upgraded: %+v", name, resGroup, err)
+	}
+
+	return nil
+}
+
+// listAzureRmVirtualMachineScaleSetOutdatedInstanceIds returns the IDs of the instances in the Scale Set which aren't
+// using the latest model
+func listAzureRmVirtualMachineScaleSetOutdatedInstanceIds(ctx context.Context, vmsClient compute.VirtualMachineScaleSetVMsClient, resGroup, name string) ([]string, error) {
+	instanceIds := make([]string, 0)
+	iterator, err := vmsClient.ListComplete(ctx, resGroup, name, "", "", "")
+	if err != nil {
+		return nil, fmt.Errorf("Error listing the instances of Virtual Machine Scale Set %q (Resource Group %q): %+v", name, resGroup, err)
+	}
+	for iterator.NotDone() {
+		instance := iterator.Value()
+		if props := instance.VirtualMachineScaleSetVMProperties; props != nil && instance.InstanceID != nil {
+			if props.LatestModelApplied == nil || !*props.LatestModelApplied {
+				instanceIds = append(instanceIds, *instance.InstanceID)
+			}
+		}
+
+		if err := iterator.Next(); err != nil {
+			return nil, fmt.Errorf("Error listing the instances of Virtual Machine Scale Set %q (Resource Group %q): %+v", name, resGroup, err)
+		}
+	}
+
+	return instanceIds, nil
+}
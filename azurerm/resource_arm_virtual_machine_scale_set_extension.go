@@ -0,0 +1,309 @@
+package azurerm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2018-06-01/compute"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/structure"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/polling"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmVirtualMachineScaleSetExtension() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmVirtualMachineScaleSetExtensionCreateUpdate,
+		Read:   resourceArmVirtualMachineScaleSetExtensionRead,
+		Update: resourceArmVirtualMachineScaleSetExtensionCreateUpdate,
+		Delete: resourceArmVirtualMachineScaleSetExtensionDelete,
+		Importer: &schema.ResourceImporter{
+			State: azure.ImportVirtualMachineScaleSetExtensionID,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"virtual_machine_scale_set_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: ignoreCaseDiffSuppressFunc,
+				ValidateFunc:     azure.ValidateVirtualMachineScaleSetID,
+			},
+
+			"publisher": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"type_handler_version": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"auto_upgrade_minor_version": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"force_update_tag": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			// the vendored API version doesn't support `provisionAfterExtensions` - so this ordering is enforced
+			// by waiting for these extensions to be provisioned before this extension is created/updated
+			"provision_after_extensions": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.NoZeroValues,
+				},
+			},
+
+			"settings": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validation.ValidateJsonString,
+				DiffSuppressFunc: structure.SuppressJsonDiff,
+			},
+
+			// due to the sensitive nature, these are not returned by the API
+			"protected_settings": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Sensitive:        true,
+				ValidateFunc:     validation.ValidateJsonString,
+				DiffSuppressFunc: structure.SuppressJsonDiff,
+			},
+		},
+	}
+}
+
+func resourceArmVirtualMachineScaleSetExtensionCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).vmScaleSetExtensionsClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	name := d.Get("name").(string)
+	virtualMachineScaleSetId, err := azure.ParseVirtualMachineScaleSetID(d.Get("virtual_machine_scale_set_id").(string))
+	if err != nil {
+		return err
+	}
+	resourceGroup := virtualMachineScaleSetId.ResourceGroup
+	virtualMachineScaleSetName := virtualMachineScaleSetId.Name
+
+	timeout := d.Timeout(schema.TimeoutUpdate)
+	if d.IsNewResource() {
+		timeout = d.Timeout(schema.TimeoutCreate)
+	}
+
+	// this has to happen prior to locking the Scale Set, since the extensions we're waiting on need the lock to be provisioned
+	for _, v := range d.Get("provision_after_extensions").([]interface{}) {
+		extensionName := v.(string)
+		if err := waitForAzureRmVirtualMachineScaleSetExtensionToProvision(ctx, client, resourceGroup, virtualMachineScaleSetName, extensionName, timeout); err != nil {
+			return fmt.Errorf("Error waiting for Extension %q (Virtual Machine Scale Set %q / Resource Group %q) to be provisioned prior to Extension %q: %+v", extensionName, virtualMachineScaleSetName, resourceGroup, name, err)
+		}
+	}
+
+	extension := compute.VirtualMachineScaleSetExtension{
+		Name: utils.String(name),
+		VirtualMachineScaleSetExtensionProperties: &compute.VirtualMachineScaleSetExtensionProperties{
+			Publisher:               utils.String(d.Get("publisher").(string)),
+			Type:                    utils.String(d.Get("type").(string)),
+			TypeHandlerVersion:      utils.String(d.Get("type_handler_version").(string)),
+			AutoUpgradeMinorVersion: utils.Bool(d.Get("auto_upgrade_minor_version").(bool)),
+		},
+	}
+
+	if settingsString := d.Get("settings").(string); settingsString != "" {
+		settings, err := structure.ExpandJsonFromString(settingsString)
+		if err != nil {
+			return fmt.Errorf("unable to parse settings: %+v", err)
+		}
+		extension.VirtualMachineScaleSetExtensionProperties.Settings = &settings
+	}
+
+	if protectedSettingsString := d.Get("protected_settings").(string); protectedSettingsString != "" {
+		protectedSettings, err := structure.ExpandJsonFromString(protectedSettingsString)
+		if err != nil {
+			return fmt.Errorf("unable to parse protected_settings: %+v", err)
+		}
+		extension.VirtualMachineScaleSetExtensionProperties.ProtectedSettings = &protectedSettings
+	}
+
+	if v, ok := d.GetOk("force_update_tag"); ok {
+		extension.VirtualMachineScaleSetExtensionProperties.ForceUpdateTag = utils.String(v.(string))
+	}
+
+	azureRMLockByName(virtualMachineScaleSetName, virtualMachineScaleSetResourceName)
+	defer azureRMUnlockByName(virtualMachineScaleSetName, virtualMachineScaleSetResourceName)
+
+	future, err := client.CreateOrUpdate(ctx, resourceGroup, virtualMachineScaleSetName, name, extension)
+	if err != nil {
+		return fmt.Errorf("Error creating/updating Extension %q (Virtual Machine Scale Set %q / Resource Group %q): %+v", name, virtualMachineScaleSetName, resourceGroup, err)
+	}
+
+	if err = polling.WaitForCompletion(ctx, &future.Future, client.Client); err != nil {
+		return fmt.Errorf("Error waiting for creation/update of Extension %q (Virtual Machine Scale Set %q / Resource Group %q): %+v", name, virtualMachineScaleSetName, resourceGroup, err)
+	}
+
+	read, err := client.Get(ctx, resourceGroup, virtualMachineScaleSetName, name, "")
+	if err != nil {
+		return fmt.Errorf("Error retrieving Extension %q (Virtual Machine Scale Set %q / Resource Group %q): %+v", name, virtualMachineScaleSetName, resourceGroup, err)
+	}
+
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read Extension %q (Virtual Machine Scale Set %q / Resource Group %q) ID", name, virtualMachineScaleSetName, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmVirtualMachineScaleSetExtensionRead(d, meta)
+}
+
+func resourceArmVirtualMachineScaleSetExtensionRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).vmScaleSetExtensionsClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := azure.ParseVirtualMachineScaleSetExtensionID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.VirtualMachineScaleSet, id.Name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Extension %q (Virtual Machine Scale Set %q / Resource Group %q) was not found - removing from state", id.Name, id.VirtualMachineScaleSet, id.ResourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error making Read request on Extension %q (Virtual Machine Scale Set %q / Resource Group %q): %+v", id.Name, id.VirtualMachineScaleSet, id.ResourceGroup, err)
+	}
+
+	d.Set("name", id.Name)
+	d.Set("virtual_machine_scale_set_id", azure.NewVirtualMachineScaleSetID(id.Subscription, id.ResourceGroup, id.VirtualMachineScaleSet).String())
+
+	if props := resp.VirtualMachineScaleSetExtensionProperties; props != nil {
+		d.Set("publisher", props.Publisher)
+		d.Set("type", props.Type)
+		d.Set("type_handler_version", props.TypeHandlerVersion)
+		d.Set("auto_upgrade_minor_version", props.AutoUpgradeMinorVersion)
+		d.Set("force_update_tag", props.ForceUpdateTag)
+
+		if settings := props.Settings; settings != nil {
+			settingsVal := settings.(map[string]interface{})
+			settingsJson, err := structure.FlattenJsonToString(settingsVal)
+			if err != nil {
+				return fmt.Errorf("unable to parse settings from response: %+v", err)
+			}
+			d.Set("settings", settingsJson)
+		}
+	}
+
+	return nil
+}
+
+func resourceArmVirtualMachineScaleSetExtensionDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).vmScaleSetExtensionsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := azure.ParseVirtualMachineScaleSetExtensionID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	azureRMLockByName(id.VirtualMachineScaleSet, virtualMachineScaleSetResourceName)
+	defer azureRMUnlockByName(id.VirtualMachineScaleSet, virtualMachineScaleSetResourceName)
+
+	future, err := client.Delete(ctx, id.ResourceGroup, id.VirtualMachineScaleSet, id.Name)
+	if err != nil {
+		// deleted outside of Terraform
+		if response.WasNotFound(future.Response()) {
+			return nil
+		}
+		return fmt.Errorf("Error deleting Extension %q (Virtual Machine Scale Set %q / Resource Group %q): %+v", id.Name, id.VirtualMachineScaleSet, id.ResourceGroup, err)
+	}
+
+	if err = polling.WaitForCompletion(ctx, &future.Future, client.Client); err != nil {
+		if !response.WasNotFound(future.Response()) {
+			return fmt.Errorf("Error waiting for the deletion of Extension %q (Virtual Machine Scale Set %q / Resource Group %q): %+v", id.Name, id.VirtualMachineScaleSet, id.ResourceGroup, err)
+		}
+	}
+
+	return nil
+}
+
+func waitForAzureRmVirtualMachineScaleSetExtensionToProvision(ctx context.Context, client compute.VirtualMachineScaleSetExtensionsClient, resourceGroup, virtualMachineScaleSetName, name string, timeout time.Duration) error {
+	log.Printf("[DEBUG] Waiting for Extension %q (Virtual Machine Scale Set %q / Resource Group %q) to be provisioned", name, virtualMachineScaleSetName, resourceGroup)
+
+	// the extension may be being created by another resource at the same time, so it not existing yet is fine
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"NotFound", "Creating", "Updating"},
+		Target:     []string{"Succeeded"},
+		Refresh:    virtualMachineScaleSetExtensionProvisioningStateRefreshFunc(ctx, client, resourceGroup, virtualMachineScaleSetName, name),
+		Timeout:    timeout,
+		MinTimeout: 15 * time.Second,
+	}
+
+	_, err := stateConf.WaitForState()
+	return err
+}
+
+func virtualMachineScaleSetExtensionProvisioningStateRefreshFunc(ctx context.Context, client compute.VirtualMachineScaleSetExtensionsClient, resourceGroup, virtualMachineScaleSetName, name string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := client.Get(ctx, resourceGroup, virtualMachineScaleSetName, name, "")
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return resp, "NotFound", nil
+			}
+
+			return nil, "", fmt.Errorf("Error retrieving Extension %q (Virtual Machine Scale Set %q / Resource Group %q): %+v", name, virtualMachineScaleSetName, resourceGroup, err)
+		}
+
+		state := ""
+		if props := resp.VirtualMachineScaleSetExtensionProperties; props != nil && props.ProvisioningState != nil {
+			state = *props.ProvisioningState
+		}
+
+		if strings.EqualFold(state, "Failed") {
+			return resp, state, fmt.Errorf("Extension %q failed to provision", name)
+		}
+
+		return resp, state, nil
+	}
+}
@@ -0,0 +1,368 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMVirtualMachineScaleSetExtension_basic(t *testing.T) {
+	resourceName := "azurerm_virtual_machine_scale_set_extension.test"
+	ri := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMVirtualMachineScaleSetExtensionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMVirtualMachineScaleSetExtension_basic(ri, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualMachineScaleSetExtensionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "publisher", "Microsoft.Azure.Extensions"),
+					resource.TestCheckResourceAttr(resourceName, "type", "CustomScript"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"protected_settings"},
+			},
+		},
+	})
+}
+
+func TestAccAzureRMVirtualMachineScaleSetExtension_update(t *testing.T) {
+	resourceName := "azurerm_virtual_machine_scale_set_extension.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMVirtualMachineScaleSetExtensionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMVirtualMachineScaleSetExtension_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualMachineScaleSetExtensionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "force_update_tag", ""),
+				),
+			},
+			{
+				Config: testAccAzureRMVirtualMachineScaleSetExtension_updated(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualMachineScaleSetExtensionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "force_update_tag", "second"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMVirtualMachineScaleSetExtension_provisionAfterExtensions(t *testing.T) {
+	resourceName := "azurerm_virtual_machine_scale_set_extension.test"
+	ri := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMVirtualMachineScaleSetExtensionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMVirtualMachineScaleSetExtension_provisionAfterExtensions(ri, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualMachineScaleSetExtensionExists(resourceName),
+					testCheckAzureRMVirtualMachineScaleSetExtensionExists("azurerm_virtual_machine_scale_set_extension.first"),
+					resource.TestCheckResourceAttr(resourceName, "provision_after_extensions.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMVirtualMachineScaleSetExtension_inlineExtension(t *testing.T) {
+	resourceName := "azurerm_virtual_machine_scale_set_extension.test"
+	scaleSetResourceName := "azurerm_virtual_machine_scale_set.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMVirtualMachineScaleSetExtensionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMVirtualMachineScaleSetExtension_inlineExtension(ri, location, "first"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualMachineScaleSetExtensionExists(resourceName),
+					resource.TestCheckResourceAttr(scaleSetResourceName, "extension.#", "1"),
+				),
+			},
+			{
+				// updating the Scale Set mustn't remove the Extension managed by the standalone resource
+				Config: testAccAzureRMVirtualMachineScaleSetExtension_inlineExtension(ri, location, "second"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualMachineScaleSetExtensionExists(resourceName),
+					resource.TestCheckResourceAttr(scaleSetResourceName, "extension.#", "1"),
+					resource.TestCheckResourceAttr(scaleSetResourceName, "tags.environment", "second"),
+				),
+			},
+			{
+				// importing the Scale Set mustn't take ownership of the Extension managed by the standalone resource
+				ResourceName:     scaleSetResourceName,
+				ImportState:      true,
+				ImportStateCheck: testCheckAzureRMVirtualMachineScaleSetImportedWithoutExtensions,
+			},
+			{
+				Config: testAccAzureRMVirtualMachineScaleSetExtension_inlineExtension(ri, location, "second"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualMachineScaleSetExtensionExists(resourceName),
+					resource.TestCheckResourceAttr(scaleSetResourceName, "extension.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckAzureRMVirtualMachineScaleSetImportedWithoutExtensions(states []*terraform.InstanceState) error {
+	for _, state := range states {
+		if count := state.Attributes["extension.#"]; count != "" && count != "0" {
+			return fmt.Errorf("Expected no Extensions to be imported into the `extension` block but got %s", count)
+		}
+	}
+
+	return nil
+}
+
+func testCheckAzureRMVirtualMachineScaleSetExtensionExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		id, err := azure.ParseVirtualMachineScaleSetExtensionID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).vmScaleSetExtensionsClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+		resp, err := client.Get(ctx, id.ResourceGroup, id.VirtualMachineScaleSet, id.Name, "")
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Extension %q (Virtual Machine Scale Set %q / Resource Group %q) does not exist", id.Name, id.VirtualMachineScaleSet, id.ResourceGroup)
+			}
+
+			return fmt.Errorf("Bad: Get on vmScaleSetExtensionsClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMVirtualMachineScaleSetExtensionDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).vmScaleSetExtensionsClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_virtual_machine_scale_set_extension" {
+			continue
+		}
+
+		id, err := azure.ParseVirtualMachineScaleSetExtensionID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		resp, err := client.Get(ctx, id.ResourceGroup, id.VirtualMachineScaleSet, id.Name, "")
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil
+			}
+			return err
+		}
+
+		return fmt.Errorf("Virtual Machine Scale Set Extension still exists:\n%+v", resp)
+	}
+
+	return nil
+}
+
+func testAccAzureRMVirtualMachineScaleSetExtension_basic(rInt int, location string) string {
+	template := testAccAzureRMVirtualMachineScaleSet_basicLinux_managedDisk(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_machine_scale_set_extension" "test" {
+  name                         = "CustomScript"
+  virtual_machine_scale_set_id = "${azurerm_virtual_machine_scale_set.test.id}"
+  publisher                    = "Microsoft.Azure.Extensions"
+  type                         = "CustomScript"
+  type_handler_version         = "2.0"
+
+  settings = <<SETTINGS
+		{
+			"commandToExecute": "echo $HOSTNAME"
+		}
+SETTINGS
+
+  protected_settings = <<SETTINGS
+		{
+			"commandToExecute": "echo secret"
+		}
+SETTINGS
+}
+`, template)
+}
+
+func testAccAzureRMVirtualMachineScaleSetExtension_updated(rInt int, location string) string {
+	template := testAccAzureRMVirtualMachineScaleSet_basicLinux_managedDisk(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_machine_scale_set_extension" "test" {
+  name                         = "CustomScript"
+  virtual_machine_scale_set_id = "${azurerm_virtual_machine_scale_set.test.id}"
+  publisher                    = "Microsoft.Azure.Extensions"
+  type                         = "CustomScript"
+  type_handler_version         = "2.0"
+  force_update_tag             = "second"
+
+  settings = <<SETTINGS
+		{
+			"commandToExecute": "echo $HOSTNAME $PWD"
+		}
+SETTINGS
+}
+`, template)
+}
+
+func testAccAzureRMVirtualMachineScaleSetExtension_provisionAfterExtensions(rInt int, location string) string {
+	template := testAccAzureRMVirtualMachineScaleSet_basicLinux_managedDisk(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_machine_scale_set_extension" "first" {
+  name                         = "NetworkWatcherAgentLinux"
+  virtual_machine_scale_set_id = "${azurerm_virtual_machine_scale_set.test.id}"
+  publisher                    = "Microsoft.Azure.NetworkWatcher"
+  type                         = "NetworkWatcherAgentLinux"
+  type_handler_version         = "1.4"
+}
+
+resource "azurerm_virtual_machine_scale_set_extension" "test" {
+  name                         = "CustomScript"
+  virtual_machine_scale_set_id = "${azurerm_virtual_machine_scale_set.test.id}"
+  publisher                    = "Microsoft.Azure.Extensions"
+  type                         = "CustomScript"
+  type_handler_version         = "2.0"
+  provision_after_extensions   = ["NetworkWatcherAgentLinux"]
+
+  settings = <<SETTINGS
+		{
+			"commandToExecute": "echo $HOSTNAME"
+		}
+SETTINGS
+}
+`, template)
+}
+
+func testAccAzureRMVirtualMachineScaleSetExtension_inlineExtension(rInt int, location string, environment string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctvn-%[1]d"
+  address_space       = ["10.0.0.0/16"]
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+
+resource "azurerm_subnet" "test" {
+  name                 = "acctsub-%[1]d"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+  virtual_network_name = "${azurerm_virtual_network.test.name}"
+  address_prefix       = "10.0.2.0/24"
+}
+
+resource "azurerm_virtual_machine_scale_set" "test" {
+  name                = "acctvmss-%[1]d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  upgrade_policy_mode = "Manual"
+
+  sku {
+    name     = "Standard_D1_v2"
+    tier     = "Standard"
+    capacity = 1
+  }
+
+  os_profile {
+    computer_name_prefix = "testvm-%[1]d"
+    admin_username       = "myadmin"
+    admin_password       = "Passwword1234"
+  }
+
+  network_profile {
+    name    = "TestNetworkProfile-%[1]d"
+    primary = true
+
+    ip_configuration {
+      name      = "TestIPConfiguration"
+      subnet_id = "${azurerm_subnet.test.id}"
+    }
+  }
+
+  storage_profile_os_disk {
+    name              = ""
+    caching           = "ReadWrite"
+    create_option     = "FromImage"
+    managed_disk_type = "Standard_LRS"
+  }
+
+  storage_profile_image_reference {
+    publisher = "Canonical"
+    offer     = "UbuntuServer"
+    sku       = "16.04-LTS"
+    version   = "latest"
+  }
+
+  extension {
+    name                 = "NetworkWatcherAgentLinux"
+    publisher            = "Microsoft.Azure.NetworkWatcher"
+    type                 = "NetworkWatcherAgentLinux"
+    type_handler_version = "1.4"
+  }
+
+  tags {
+    environment = "%[3]s"
+  }
+}
+
+resource "azurerm_virtual_machine_scale_set_extension" "test" {
+  name                         = "CustomScript"
+  virtual_machine_scale_set_id = "${azurerm_virtual_machine_scale_set.test.id}"
+  publisher                    = "Microsoft.Azure.Extensions"
+  type                         = "CustomScript"
+  type_handler_version         = "2.0"
+
+  settings = <<SETTINGS
+		{
+			"commandToExecute": "echo $HOSTNAME"
+		}
+SETTINGS
+}
+`, rInt, location, environment)
+}
@@ -0,0 +1,176 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmVirtualMachineScaleSetInstanceProtection() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmVirtualMachineScaleSetInstanceProtectionCreateUpdate,
+		Read:   resourceArmVirtualMachineScaleSetInstanceProtectionRead,
+		Update: resourceArmVirtualMachineScaleSetInstanceProtectionCreateUpdate,
+		Delete: resourceArmVirtualMachineScaleSetInstanceProtectionDelete,
+		Importer: &schema.ResourceImporter{
+			State: azure.ImportVirtualMachineScaleSetInstanceID,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"virtual_machine_scale_set_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: ignoreCaseDiffSuppressFunc,
+				ValidateFunc:     azure.ValidateVirtualMachineScaleSetID,
+			},
+
+			"instance_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"protect_from_scale_in": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"protect_from_scale_set_actions": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+		},
+	}
+}
+
+func resourceArmVirtualMachineScaleSetInstanceProtectionCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).vmScaleSetVMsClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	instanceId := d.Get("instance_id").(string)
+	virtualMachineScaleSetId, err := azure.ParseVirtualMachineScaleSetID(d.Get("virtual_machine_scale_set_id").(string))
+	if err != nil {
+		return err
+	}
+	resourceGroup := virtualMachineScaleSetId.ResourceGroup
+	virtualMachineScaleSetName := virtualMachineScaleSetId.Name
+
+	azureRMLockByName(virtualMachineScaleSetName, virtualMachineScaleSetResourceName)
+	defer azureRMUnlockByName(virtualMachineScaleSetName, virtualMachineScaleSetResourceName)
+
+	// when Overprovisioning is enabled the Scale Set creates additional instances which are removed once the
+	// requested number of instances have been provisioned - as such we check the instance still exists
+	instance, err := client.Get(ctx, resourceGroup, virtualMachineScaleSetName, instanceId)
+	if err != nil {
+		if utils.ResponseWasNotFound(instance.Response) {
+			return fmt.Errorf("Error: Instance %q was not found in Virtual Machine Scale Set %q (Resource Group %q) - if Overprovisioning is enabled this may be an instance which was removed once the Scale Set was provisioned", instanceId, virtualMachineScaleSetName, resourceGroup)
+		}
+
+		return fmt.Errorf("Error retrieving Instance %q (Virtual Machine Scale Set %q / Resource Group %q): %+v", instanceId, virtualMachineScaleSetName, resourceGroup, err)
+	}
+
+	policy := azureRmVirtualMachineScaleSetVMProtectionPolicy{
+		ProtectFromScaleIn:         d.Get("protect_from_scale_in").(bool),
+		ProtectFromScaleSetActions: d.Get("protect_from_scale_set_actions").(bool),
+	}
+
+	log.Printf("[DEBUG] Updating the Protection Policy for Instance %q (Virtual Machine Scale Set %q / Resource Group %q)..", instanceId, virtualMachineScaleSetName, resourceGroup)
+	if err := updateAzureRmVirtualMachineScaleSetVMProtectionPolicy(ctx, client, resourceGroup, virtualMachineScaleSetName, instanceId, policy); err != nil {
+		return fmt.Errorf("Error updating the Protection Policy for Instance %q (Virtual Machine Scale Set %q / Resource Group %q): %+v", instanceId, virtualMachineScaleSetName, resourceGroup, err)
+	}
+
+	d.SetId(azure.NewVirtualMachineScaleSetInstanceID(virtualMachineScaleSetId.Subscription, resourceGroup, virtualMachineScaleSetName, instanceId).String())
+
+	return resourceArmVirtualMachineScaleSetInstanceProtectionRead(d, meta)
+}
+
+func resourceArmVirtualMachineScaleSetInstanceProtectionRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).vmScaleSetVMsClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := azure.ParseVirtualMachineScaleSetInstanceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	policy, resp, err := getAzureRmVirtualMachineScaleSetVMProtectionPolicy(ctx, client, id.ResourceGroup, id.VirtualMachineScaleSet, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(autorest.Response{Response: resp}) {
+			log.Printf("[DEBUG] Instance %q (Virtual Machine Scale Set %q / Resource Group %q) was not found - removing Protection Policy from state", id.Name, id.VirtualMachineScaleSet, id.ResourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving the Protection Policy for Instance %q (Virtual Machine Scale Set %q / Resource Group %q): %+v", id.Name, id.VirtualMachineScaleSet, id.ResourceGroup, err)
+	}
+
+	d.Set("virtual_machine_scale_set_id", azure.NewVirtualMachineScaleSetID(id.Subscription, id.ResourceGroup, id.VirtualMachineScaleSet).String())
+	d.Set("instance_id", id.Name)
+
+	protectFromScaleIn := false
+	protectFromScaleSetActions := false
+	if policy != nil {
+		protectFromScaleIn = policy.ProtectFromScaleIn
+		protectFromScaleSetActions = policy.ProtectFromScaleSetActions
+	}
+	d.Set("protect_from_scale_in", protectFromScaleIn)
+	d.Set("protect_from_scale_set_actions", protectFromScaleSetActions)
+
+	return nil
+}
+
+func resourceArmVirtualMachineScaleSetInstanceProtectionDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).vmScaleSetVMsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := azure.ParseVirtualMachineScaleSetInstanceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	azureRMLockByName(id.VirtualMachineScaleSet, virtualMachineScaleSetResourceName)
+	defer azureRMUnlockByName(id.VirtualMachineScaleSet, virtualMachineScaleSetResourceName)
+
+	instance, err := client.Get(ctx, id.ResourceGroup, id.VirtualMachineScaleSet, id.Name)
+	if err != nil {
+		// the instance has been removed, so there's nothing to protect
+		if utils.ResponseWasNotFound(instance.Response) {
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Instance %q (Virtual Machine Scale Set %q / Resource Group %q): %+v", id.Name, id.VirtualMachineScaleSet, id.ResourceGroup, err)
+	}
+
+	policy := azureRmVirtualMachineScaleSetVMProtectionPolicy{
+		ProtectFromScaleIn:         false,
+		ProtectFromScaleSetActions: false,
+	}
+
+	log.Printf("[DEBUG] Removing the Protection Policy for Instance %q (Virtual Machine Scale Set %q / Resource Group %q)..", id.Name, id.VirtualMachineScaleSet, id.ResourceGroup)
+	if err := updateAzureRmVirtualMachineScaleSetVMProtectionPolicy(ctx, client, id.ResourceGroup, id.VirtualMachineScaleSet, id.Name, policy); err != nil {
+		return fmt.Errorf("Error removing the Protection Policy for Instance %q (Virtual Machine Scale Set %q / Resource Group %q): %+v", id.Name, id.VirtualMachineScaleSet, id.ResourceGroup, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,176 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMVirtualMachineScaleSetInstanceProtection_basic(t *testing.T) {
+	resourceName := "azurerm_virtual_machine_scale_set_instance_protection.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMVirtualMachineScaleSetInstanceProtectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMVirtualMachineScaleSetInstanceProtection_basic(ri, location, false),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualMachineScaleSetInstanceProtectionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "protect_from_scale_in", "true"),
+					resource.TestCheckResourceAttr(resourceName, "protect_from_scale_set_actions", "false"),
+				),
+			},
+			{
+				Config: testAccAzureRMVirtualMachineScaleSetInstanceProtection_basic(ri, location, true),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualMachineScaleSetInstanceProtectionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "protect_from_scale_in", "true"),
+					resource.TestCheckResourceAttr(resourceName, "protect_from_scale_set_actions", "true"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckAzureRMVirtualMachineScaleSetInstanceProtectionExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		// Ensure we have enough information in state to look up in API
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		id, err := azure.ParseVirtualMachineScaleSetInstanceID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).vmScaleSetVMsClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+		policy, _, err := getAzureRmVirtualMachineScaleSetVMProtectionPolicy(ctx, client, id.ResourceGroup, id.VirtualMachineScaleSet, id.Name)
+		if err != nil {
+			return fmt.Errorf("Bad: retrieving the Protection Policy for Instance %q (Virtual Machine Scale Set %q / Resource Group %q): %+v", id.Name, id.VirtualMachineScaleSet, id.ResourceGroup, err)
+		}
+
+		if policy == nil || !(policy.ProtectFromScaleIn || policy.ProtectFromScaleSetActions) {
+			return fmt.Errorf("Bad: Instance %q (Virtual Machine Scale Set %q / Resource Group %q) is not protected", id.Name, id.VirtualMachineScaleSet, id.ResourceGroup)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMVirtualMachineScaleSetInstanceProtectionDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).vmScaleSetVMsClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_virtual_machine_scale_set_instance_protection" {
+			continue
+		}
+
+		id, err := azure.ParseVirtualMachineScaleSetInstanceID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		instance, err := client.Get(ctx, id.ResourceGroup, id.VirtualMachineScaleSet, id.Name)
+		if err != nil {
+			if utils.ResponseWasNotFound(instance.Response) {
+				return nil
+			}
+			return err
+		}
+
+		return fmt.Errorf("Virtual Machine Scale Set Instance still exists:\n%+v", instance)
+	}
+
+	return nil
+}
+
+func testAccAzureRMVirtualMachineScaleSetInstanceProtection_basic(rInt int, location string, protectFromScaleSetActions bool) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctvn-%[1]d"
+  address_space       = ["10.0.0.0/16"]
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+
+resource "azurerm_subnet" "test" {
+  name                 = "acctsub-%[1]d"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+  virtual_network_name = "${azurerm_virtual_network.test.name}"
+  address_prefix       = "10.0.2.0/24"
+}
+
+resource "azurerm_virtual_machine_scale_set" "test" {
+  name                = "acctvmss-%[1]d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  upgrade_policy_mode = "Manual"
+  overprovision       = false
+
+  sku {
+    name     = "Standard_D1_v2"
+    tier     = "Standard"
+    capacity = 2
+  }
+
+  os_profile {
+    computer_name_prefix = "testvm-%[1]d"
+    admin_username       = "myadmin"
+    admin_password       = "Passwword1234"
+  }
+
+  network_profile {
+    name    = "TestNetworkProfile-%[1]d"
+    primary = true
+
+    ip_configuration {
+      name      = "TestIPConfiguration"
+      subnet_id = "${azurerm_subnet.test.id}"
+    }
+  }
+
+  storage_profile_os_disk {
+    name              = ""
+    caching           = "ReadWrite"
+    create_option     = "FromImage"
+    managed_disk_type = "Standard_LRS"
+  }
+
+  storage_profile_image_reference {
+    publisher = "Canonical"
+    offer     = "UbuntuServer"
+    sku       = "16.04-LTS"
+    version   = "latest"
+  }
+}
+
+resource "azurerm_virtual_machine_scale_set_instance_protection" "test" {
+  virtual_machine_scale_set_id   = "${azurerm_virtual_machine_scale_set.test.id}"
+  instance_id                    = "0"
+  protect_from_scale_set_actions = %[3]t
+}
+`, rInt, location, protectFromScaleSetActions)
+}
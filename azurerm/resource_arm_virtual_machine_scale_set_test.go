@@ -1,17 +1,231 @@
 package azurerm
 
 import (
+	"context"
 	"fmt"
 	"net/http"
+	"net/http/httptest"
+	"reflect"
 	"regexp"
+	"sort"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2018-06-01/compute"
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/polling"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
+func TestAzureRMVirtualMachineScaleSetInlineExtensions_diff(t *testing.T) {
+	extension := func(name, settings, protectedSettings string) map[string]interface{} {
+		return map[string]interface{}{
+			"name":                       name,
+			"publisher":                  "Microsoft.Azure.Extensions",
+			"type":                       "CustomScript",
+			"type_handler_version":       "2.0",
+			"auto_upgrade_minor_version": true,
+			"settings":                   settings,
+			"protected_settings":         protectedSettings,
+		}
+	}
+
+	old := schema.NewSet(resourceArmVirtualMachineScaleSetExtensionHash, []interface{}{
+		extension("unchanged", `{"commandToExecute":"hostname"}`, ""),
+		extension("settings", `{"commandToExecute":"hostname"}`, ""),
+		extension("protected", "", `{"commandToExecute":"hostname"}`),
+		extension("removed", "", ""),
+	})
+	new := schema.NewSet(resourceArmVirtualMachineScaleSetExtensionHash, []interface{}{
+		extension("unchanged", `{"commandToExecute":"hostname"}`, ""),
+		extension("settings", `{"commandToExecute":"whoami"}`, ""),
+		extension("protected", "", `{"commandToExecute":"whoami"}`),
+		extension("added", "", ""),
+	})
+
+	changed, removed := diffAzureRmVirtualMachineScaleSetInlineExtensions(old, new)
+
+	changedNames := make([]string, 0)
+	for _, v := range changed {
+		changedNames = append(changedNames, v.(map[string]interface{})["name"].(string))
+	}
+	sort.Strings(changedNames)
+
+	if expected := []string{"added", "protected", "settings"}; !reflect.DeepEqual(expected, changedNames) {
+		t.Fatalf("Expected the changed Extensions to be %+v but got %+v", expected, changedNames)
+	}
+
+	if expected := []string{"removed"}; !reflect.DeepEqual(expected, removed) {
+		t.Fatalf("Expected the removed Extensions to be %+v but got %+v", expected, removed)
+	}
+}
+
+func TestAzureRMVirtualMachineScaleSetRollingUpgrade_refresh(t *testing.T) {
+	updateStarted := time.Now()
+	latestUpgrade := func(code string, started time.Time) string {
+		return fmt.Sprintf(`{"properties":{"runningStatus":{"code":%q,"startTime":%q}}}`, code, started.Format(time.RFC3339))
+	}
+	outdatedInstances := `{"value":[{"instanceId":"0","properties":{"latestModelApplied":true}},{"instanceId":"1","properties":{"latestModelApplied":false}}]}`
+	upToDateInstances := `{"value":[{"instanceId":"0","properties":{"latestModelApplied":true}}]}`
+
+	cases := []struct {
+		Description   string
+		LatestUpgrade string
+		Instances     string
+		UpdateStarted time.Time
+		ExpectedState string
+		ExpectError   bool
+	}{
+		{
+			Description:   "No Rolling Upgrade has started yet for a model change",
+			Instances:     outdatedInstances,
+			UpdateStarted: updateStarted,
+			ExpectedState: "None",
+		},
+		{
+			Description:   "No Rolling Upgrade is required",
+			Instances:     upToDateInstances,
+			UpdateStarted: updateStarted,
+			ExpectedState: "NotRequired",
+		},
+		{
+			Description:   "A Faulted Rolling Upgrade from a previous update is ignored",
+			LatestUpgrade: latestUpgrade("Faulted", updateStarted.Add(-30*time.Second)),
+			Instances:     outdatedInstances,
+			UpdateStarted: updateStarted,
+			ExpectedState: "None",
+		},
+		{
+			Description:   "No Rolling Upgrade was started for a model change",
+			Instances:     outdatedInstances,
+			UpdateStarted: updateStarted.Add(-2 * virtualMachineScaleSetRollingUpgradeStartTimeout),
+			ExpectError:   true,
+		},
+		{
+			Description:   "The Rolling Upgrade for this update is in progress",
+			LatestUpgrade: latestUpgrade("RollingForward", updateStarted.Add(30*time.Second)),
+			Instances:     outdatedInstances,
+			UpdateStarted: updateStarted,
+			ExpectedState: "RollingForward",
+		},
+		{
+			Description:   "The Rolling Upgrade for this update Faulted",
+			LatestUpgrade: latestUpgrade("Faulted", updateStarted.Add(30*time.Second)),
+			Instances:     outdatedInstances,
+			UpdateStarted: updateStarted,
+			ExpectedState: "Faulted",
+		},
+	}
+
+	for _, v := range cases {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+
+			if strings.HasSuffix(r.URL.Path, "/rollingUpgrades/latest") {
+				if v.LatestUpgrade == "" {
+					w.WriteHeader(http.StatusNotFound)
+					fmt.Fprint(w, `{"error":{"code":"NotFound","message":"The entity was not found."}}`)
+					return
+				}
+
+				fmt.Fprint(w, v.LatestUpgrade)
+				return
+			}
+
+			fmt.Fprint(w, v.Instances)
+		}))
+
+		client := compute.NewVirtualMachineScaleSetRollingUpgradesClientWithBaseURI(server.URL, "00000000-0000-0000-0000-000000000000")
+		vmsClient := compute.NewVirtualMachineScaleSetVMsClientWithBaseURI(server.URL, "00000000-0000-0000-0000-000000000000")
+
+		refresh := virtualMachineScaleSetRollingUpgradeStateRefreshFunc(context.Background(), client, vmsClient, "group1", "vmss1", v.UpdateStarted)
+		_, state, err := refresh()
+		server.Close()
+
+		if v.ExpectError {
+			if err == nil {
+				t.Fatalf("Expected an error for %q but got the state %q", v.Description, state)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Fatalf("Expected no error for %q but got: %+v", v.Description, err)
+		}
+
+		if state != v.ExpectedState {
+			t.Fatalf("Expected the state %q for %q but got %q", v.ExpectedState, v.Description, state)
+		}
+	}
+}
+
+func TestAzureRMVirtualMachineScaleSetExtensionProfile_flatten(t *testing.T) {
+	extension := func(name string) compute.VirtualMachineScaleSetExtension {
+		return compute.VirtualMachineScaleSetExtension{
+			Name: utils.String(name),
+			VirtualMachineScaleSetExtensionProperties: &compute.VirtualMachineScaleSetExtensionProperties{
+				Publisher:          utils.String("Microsoft.Azure.Extensions"),
+				Type:               utils.String("CustomScript"),
+				TypeHandlerVersion: utils.String("2.0"),
+			},
+		}
+	}
+	profile := &compute.VirtualMachineScaleSetExtensionProfile{
+		Extensions: &[]compute.VirtualMachineScaleSetExtension{
+			extension("inline"),
+			extension("external"),
+		},
+	}
+
+	cases := []struct {
+		Description   string
+		InlineNames   []string
+		ExpectedNames []string
+	}{
+		{
+			Description:   "Only the Extensions defined in the `extension` block",
+			InlineNames:   []string{"inline"},
+			ExpectedNames: []string{"inline"},
+		},
+		{
+			Description:   "No Extensions defined in the `extension` block",
+			InlineNames:   []string{},
+			ExpectedNames: []string{},
+		},
+		{
+			Description:   "All of the Extensions defined in the `extension` block",
+			InlineNames:   []string{"inline", "external"},
+			ExpectedNames: []string{"external", "inline"},
+		},
+	}
+
+	for _, v := range cases {
+		inlineNames := make(map[string]struct{})
+		for _, name := range v.InlineNames {
+			inlineNames[name] = struct{}{}
+		}
+
+		result, err := flattenAzureRmVirtualMachineScaleSetExtensionProfile(profile, inlineNames)
+		if err != nil {
+			t.Fatalf("Expected no error for %q but got: %+v", v.Description, err)
+		}
+
+		names := make([]string, 0)
+		for _, extension := range result {
+			names = append(names, extension["name"].(string))
+		}
+		sort.Strings(names)
+
+		if !reflect.DeepEqual(v.ExpectedNames, names) {
+			t.Fatalf("Expected the Extensions %+v for %q but got %+v", v.ExpectedNames, v.Description, names)
+		}
+	}
+}
+
 func TestAccAzureRMVirtualMachineScaleSet_basic(t *testing.T) {
 	resourceName := "azurerm_virtual_machine_scale_set.test"
 	ri := acctest.RandInt()
@@ -500,6 +714,70 @@ func TestAccAzureRMVirtualMachineScaleSet_loadBalancer(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMVirtualMachineScaleSet_rollingUpgradePolicy(t *testing.T) {
+	resourceName := "azurerm_virtual_machine_scale_set.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMVirtualMachineScaleSetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMVirtualMachineScaleSetRollingUpgradePolicyTemplate(ri, location, 20, "Standard_F2"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualMachineScaleSetExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "upgrade_policy_mode", "Rolling"),
+					resource.TestCheckResourceAttr(resourceName, "rolling_upgrade_policy.0.max_batch_instance_percent", "20"),
+					resource.TestCheckResourceAttr(resourceName, "rolling_upgrade_policy.0.pause_time_between_batches", "PT30S"),
+					resource.TestCheckResourceAttrSet(resourceName, "health_probe_id"),
+				),
+			},
+			{
+				// changing the SKU triggers a Rolling Upgrade of the existing instances
+				Config: testAccAzureRMVirtualMachineScaleSetRollingUpgradePolicyTemplate(ri, location, 50, "Standard_F4"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualMachineScaleSetExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "rolling_upgrade_policy.0.max_batch_instance_percent", "50"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"os_profile.0.admin_password"},
+			},
+		},
+	})
+}
+
+func TestAccAzureRMVirtualMachineScaleSet_manualUpgradeInstances(t *testing.T) {
+	resourceName := "azurerm_virtual_machine_scale_set.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMVirtualMachineScaleSetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMVirtualMachineScaleSetManualUpgradeInstancesTemplate(ri, location, "Standard_F2"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualMachineScaleSetExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "upgrade_instances_on_update", "true"),
+				),
+			},
+			{
+				Config: testAccAzureRMVirtualMachineScaleSetManualUpgradeInstancesTemplate(ri, location, "Standard_F4"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualMachineScaleSetExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "sku.0.name", "Standard_F4"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAzureRMVirtualMachineScaleSet_loadBalancerManagedDataDisks(t *testing.T) {
 	resourceName := "azurerm_virtual_machine_scale_set.test"
 	ri := acctest.RandInt()
@@ -566,6 +844,89 @@ func TestAccAzureRMVirtualMachineScaleSet_priority(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMVirtualMachineScaleSet_evictionPolicy(t *testing.T) {
+	resourceName := "azurerm_virtual_machine_scale_set.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMVirtualMachineScaleSetEvictionPolicyTemplate(ri, testLocation(), "Low", "Delete")
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMVirtualMachineScaleSetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualMachineScaleSetExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "priority", "Low"),
+					resource.TestCheckResourceAttr(resourceName, "eviction_policy", "Delete"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"os_profile.0.admin_password"},
+			},
+		},
+	})
+}
+
+func TestAccAzureRMVirtualMachineScaleSet_evictionPolicyRegularPriority(t *testing.T) {
+	ri := acctest.RandInt()
+	config := testAccAzureRMVirtualMachineScaleSetEvictionPolicyTemplate(ri, testLocation(), "Regular", "Deallocate")
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMVirtualMachineScaleSetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      config,
+				ExpectError: regexp.MustCompile("`eviction_policy` can only be specified when `priority` is set to `Low`"),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMVirtualMachineScaleSet_scaleInPolicy(t *testing.T) {
+	resourceName := "azurerm_virtual_machine_scale_set.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMVirtualMachineScaleSetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMVirtualMachineScaleSet_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualMachineScaleSetExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "scale_in_policy", "Default"),
+				),
+			},
+			{
+				Config: testAccAzureRMVirtualMachineScaleSet_scaleInPolicy(ri, location, "OldestVM"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualMachineScaleSetExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "scale_in_policy", "OldestVM"),
+				),
+			},
+			{
+				Config: testAccAzureRMVirtualMachineScaleSet_scaleInPolicy(ri, location, "NewestVM"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualMachineScaleSetExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "scale_in_policy", "NewestVM"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"os_profile.0.admin_password"},
+			},
+		},
+	})
+}
+
 func TestAccAzureRMVirtualMachineScaleSet_SystemAssignedMSI(t *testing.T) {
 	resourceName := "azurerm_virtual_machine_scale_set.test"
 	ri := acctest.RandInt()
@@ -628,10 +989,11 @@ func TestAccAzureRMVirtualMachineScaleSet_extension(t *testing.T) {
 				),
 			},
 			{
+				// Extensions aren't imported, since they could be managed by the standalone resource
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"os_profile.0.admin_password"},
+				ImportStateVerifyIgnore: []string{"os_profile.0.admin_password", "extension"},
 			},
 		},
 	})
@@ -683,10 +1045,11 @@ func TestAccAzureRMVirtualMachineScaleSet_multipleExtensions(t *testing.T) {
 				),
 			},
 			{
+				// Extensions aren't imported, since they could be managed by the standalone resource
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"os_profile.0.admin_password"},
+				ImportStateVerifyIgnore: []string{"os_profile.0.admin_password", "extension"},
 			},
 		},
 	})
@@ -861,7 +1224,7 @@ func testCheckAzureRMVirtualMachineScaleSetDisappears(name string) resource.Test
 			return fmt.Errorf("Bad: Delete on vmScaleSetClient: %+v", err)
 		}
 
-		err = future.WaitForCompletionRef(ctx, client.Client)
+		err = polling.WaitForCompletion(ctx, &future.Future, client.Client)
 		if err != nil {
 			return fmt.Errorf("Bad: Delete on vmScaleSetClient: %+v", err)
 		}
@@ -1185,6 +1548,91 @@ resource "azurerm_virtual_machine_scale_set" "test" {
 `, rInt, location)
 }
 
+func testAccAzureRMVirtualMachineScaleSet_scaleInPolicy(rInt int, location, scaleInPolicy string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+    name = "acctestRG-%[1]d"
+    location = "%[2]s"
+}
+
+resource "azurerm_virtual_network" "test" {
+    name = "acctvn-%[1]d"
+    address_space = ["10.0.0.0/16"]
+    location = "${azurerm_resource_group.test.location}"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+}
+
+resource "azurerm_subnet" "test" {
+    name = "acctsub-%[1]d"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+    virtual_network_name = "${azurerm_virtual_network.test.name}"
+    address_prefix = "10.0.2.0/24"
+}
+
+resource "azurerm_storage_account" "test" {
+	name                     = "accsa%[1]d"
+	resource_group_name      = "${azurerm_resource_group.test.name}"
+	location                 = "${azurerm_resource_group.test.location}"
+	account_tier             = "Standard"
+	account_replication_type = "LRS"
+
+    tags {
+        environment = "staging"
+    }
+}
+
+resource "azurerm_storage_container" "test" {
+    name = "vhds"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+    storage_account_name = "${azurerm_storage_account.test.name}"
+    container_access_type = "private"
+}
+
+resource "azurerm_virtual_machine_scale_set" "test" {
+  name = "acctvmss-%[1]d"
+  location = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  upgrade_policy_mode = "Manual"
+  scale_in_policy = "%[3]s"
+
+  sku {
+    name = "Standard_D1_v2"
+    tier = "Standard"
+    capacity = 2
+  }
+
+  os_profile {
+    computer_name_prefix = "testvm-%[1]d"
+    admin_username = "myadmin"
+    admin_password = "Passwword1234"
+  }
+
+  network_profile {
+      name = "TestNetworkProfile-%[1]d"
+      primary = true
+      ip_configuration {
+        name = "TestIPConfiguration"
+        subnet_id = "${azurerm_subnet.test.id}"
+      }
+  }
+
+  storage_profile_os_disk {
+    name = "osDiskProfile"
+    caching       = "ReadWrite"
+    create_option = "FromImage"
+    vhd_containers = ["${azurerm_storage_account.test.primary_blob_endpoint}${azurerm_storage_container.test.name}"]
+  }
+
+  storage_profile_image_reference {
+    publisher = "Canonical"
+    offer     = "UbuntuServer"
+    sku       = "16.04-LTS"
+    version   = "latest"
+  }
+}
+`, rInt, location, scaleInPolicy)
+}
+
 func testAccAzureRMVirtualMachineScaleSet_standardSSD(rInt int, location string) string {
 	return fmt.Sprintf(`
 resource "azurerm_resource_group" "test" {
@@ -2911,6 +3359,191 @@ resource "azurerm_virtual_machine_scale_set" "test" {
 `, rInt, location)
 }
 
+func testAccAzureRMVirtualMachineScaleSetRollingUpgradePolicyTemplate(rInt int, location string, maxBatchInstancePercent int, vmSize string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctvn-%[1]d"
+  address_space       = ["10.0.0.0/16"]
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+
+resource "azurerm_subnet" "test" {
+  name                 = "acctsub-%[1]d"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+  virtual_network_name = "${azurerm_virtual_network.test.name}"
+  address_prefix       = "10.0.2.0/24"
+}
+
+resource "azurerm_public_ip" "test" {
+  name                         = "acctestpip-%[1]d"
+  location                     = "${azurerm_resource_group.test.location}"
+  resource_group_name          = "${azurerm_resource_group.test.name}"
+  public_ip_address_allocation = "Static"
+}
+
+resource "azurerm_lb" "test" {
+  name                = "acctestlb-%[1]d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  frontend_ip_configuration {
+    name                 = "default"
+    public_ip_address_id = "${azurerm_public_ip.test.id}"
+  }
+}
+
+resource "azurerm_lb_backend_address_pool" "test" {
+  name                = "test"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  loadbalancer_id     = "${azurerm_lb.test.id}"
+}
+
+resource "azurerm_lb_probe" "test" {
+  name                = "ssh-running-probe"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  loadbalancer_id     = "${azurerm_lb.test.id}"
+  port                = 22
+  protocol            = "Tcp"
+}
+
+resource "azurerm_lb_rule" "test" {
+  name                           = "LBRule"
+  resource_group_name            = "${azurerm_resource_group.test.name}"
+  loadbalancer_id                = "${azurerm_lb.test.id}"
+  probe_id                       = "${azurerm_lb_probe.test.id}"
+  backend_address_pool_id        = "${azurerm_lb_backend_address_pool.test.id}"
+  frontend_ip_configuration_name = "default"
+  protocol                       = "Tcp"
+  frontend_port                  = 22
+  backend_port                   = 22
+}
+
+resource "azurerm_virtual_machine_scale_set" "test" {
+  name                = "acctvmss-%[1]d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  upgrade_policy_mode = "Rolling"
+  health_probe_id     = "${azurerm_lb_probe.test.id}"
+  depends_on          = ["azurerm_lb_rule.test"]
+
+  rolling_upgrade_policy {
+    max_batch_instance_percent              = %[3]d
+    max_unhealthy_instance_percent          = 20
+    max_unhealthy_upgraded_instance_percent = 20
+    pause_time_between_batches              = "PT30S"
+  }
+
+  sku {
+    name     = "%[4]s"
+    tier     = "Standard"
+    capacity = 2
+  }
+
+  os_profile {
+    computer_name_prefix = "testvm-%[1]d"
+    admin_username       = "myadmin"
+    admin_password       = "Passwword1234"
+  }
+
+  network_profile {
+    name    = "TestNetworkProfile"
+    primary = true
+
+    ip_configuration {
+      name                                   = "TestIPConfiguration"
+      subnet_id                              = "${azurerm_subnet.test.id}"
+      load_balancer_backend_address_pool_ids = ["${azurerm_lb_backend_address_pool.test.id}"]
+    }
+  }
+
+  storage_profile_os_disk {
+    caching           = "ReadWrite"
+    create_option     = "FromImage"
+    managed_disk_type = "Standard_LRS"
+  }
+
+  storage_profile_image_reference {
+    publisher = "Canonical"
+    offer     = "UbuntuServer"
+    sku       = "16.04-LTS"
+    version   = "latest"
+  }
+}
+`, rInt, location, maxBatchInstancePercent, vmSize)
+}
+
+func testAccAzureRMVirtualMachineScaleSetManualUpgradeInstancesTemplate(rInt int, location string, vmSize string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctvn-%[1]d"
+  address_space       = ["10.0.0.0/16"]
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+
+resource "azurerm_subnet" "test" {
+  name                 = "acctsub-%[1]d"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+  virtual_network_name = "${azurerm_virtual_network.test.name}"
+  address_prefix       = "10.0.2.0/24"
+}
+
+resource "azurerm_virtual_machine_scale_set" "test" {
+  name                        = "acctvmss-%[1]d"
+  location                    = "${azurerm_resource_group.test.location}"
+  resource_group_name         = "${azurerm_resource_group.test.name}"
+  upgrade_policy_mode         = "Manual"
+  upgrade_instances_on_update = true
+
+  sku {
+    name     = "%[3]s"
+    tier     = "Standard"
+    capacity = 2
+  }
+
+  os_profile {
+    computer_name_prefix = "testvm-%[1]d"
+    admin_username       = "myadmin"
+    admin_password       = "Passwword1234"
+  }
+
+  network_profile {
+    name    = "TestNetworkProfile"
+    primary = true
+
+    ip_configuration {
+      name      = "TestIPConfiguration"
+      subnet_id = "${azurerm_subnet.test.id}"
+    }
+  }
+
+  storage_profile_os_disk {
+    caching           = "ReadWrite"
+    create_option     = "FromImage"
+    managed_disk_type = "Standard_LRS"
+  }
+
+  storage_profile_image_reference {
+    publisher = "Canonical"
+    offer     = "UbuntuServer"
+    sku       = "16.04-LTS"
+    version   = "latest"
+  }
+}
+`, rInt, location, vmSize)
+}
+
 func testAccAzureRMVirtualMachineScaleSetOverProvisionTemplate(rInt int, location string) string {
 	return fmt.Sprintf(`
 resource "azurerm_resource_group" "test" {
@@ -3078,6 +3711,91 @@ resource "azurerm_virtual_machine_scale_set" "test" {
 `, rInt, location)
 }
 
+func testAccAzureRMVirtualMachineScaleSetEvictionPolicyTemplate(rInt int, location, priority, evictionPolicy string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctvn-%[1]d"
+  address_space       = ["10.0.0.0/16"]
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+
+resource "azurerm_subnet" "test" {
+  name                 = "acctsub-%[1]d"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+  virtual_network_name = "${azurerm_virtual_network.test.name}"
+  address_prefix       = "10.0.2.0/24"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "accsa%[1]d"
+  resource_group_name      = "${azurerm_resource_group.test.name}"
+  location                 = "${azurerm_resource_group.test.location}"
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_storage_container" "test" {
+  name                  = "vhds"
+  resource_group_name   = "${azurerm_resource_group.test.name}"
+  storage_account_name  = "${azurerm_storage_account.test.name}"
+  container_access_type = "private"
+}
+
+resource "azurerm_virtual_machine_scale_set" "test" {
+  name                = "acctvmss-%[1]d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  upgrade_policy_mode = "Manual"
+  overprovision       = false
+  priority            = "%[3]s"
+  eviction_policy     = "%[4]s"
+
+  sku {
+    name     = "Standard_D1_v2"
+    tier     = "Standard"
+    capacity = 1
+  }
+
+  os_profile {
+    computer_name_prefix = "testvm-%[1]d"
+    admin_username       = "myadmin"
+    admin_password       = "Passwword1234"
+  }
+
+  network_profile {
+    name    = "TestNetworkProfile"
+    primary = true
+
+    ip_configuration {
+      name      = "TestIPConfiguration"
+      subnet_id = "${azurerm_subnet.test.id}"
+    }
+  }
+
+  storage_profile_os_disk {
+    name           = "os-disk"
+    caching        = "ReadWrite"
+    create_option  = "FromImage"
+    vhd_containers = ["${azurerm_storage_account.test.primary_blob_endpoint}${azurerm_storage_container.test.name}"]
+  }
+
+  storage_profile_image_reference {
+    publisher = "Canonical"
+    offer     = "UbuntuServer"
+    sku       = "16.04-LTS"
+    version   = "latest"
+  }
+}
+
+`, rInt, location, priority, evictionPolicy)
+}
+
 func testAccAzureRMVirtualMachineScaleSetSystemAssignedMSI(rInt int, location string) string {
 	return fmt.Sprintf(`
 resource "azurerm_resource_group" "test" {
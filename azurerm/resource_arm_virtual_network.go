@@ -6,10 +6,14 @@ import (
 	"fmt"
 	"log"
 	"net/http"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-04-01/network"
 	"github.com/hashicorp/terraform/helper/hashcode"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/polling"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -22,7 +26,14 @@ func resourceArmVirtualNetwork() *schema.Resource {
 		Update: resourceArmVirtualNetworkCreate,
 		Delete: resourceArmVirtualNetworkDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: azure.ImportVirtualNetworkID,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -34,6 +45,8 @@ func resourceArmVirtualNetwork() *schema.Resource {
 
 			"resource_group_name": resourceGroupNameSchema(),
 
+			"subscription_id": subscriptionIdOverrideSchema(),
+
 			"location": locationSchema(),
 
 			"address_space": {
@@ -52,6 +65,27 @@ func resourceArmVirtualNetwork() *schema.Resource {
 				},
 			},
 
+			"ddos_protection_plan": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:             schema.TypeString,
+							Required:         true,
+							DiffSuppressFunc: ignoreCaseDiffSuppressFunc,
+							ValidateFunc:     azure.ValidateDdosProtectionPlanID,
+						},
+
+						"enable": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+					},
+				},
+			},
+
 			"subnet": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -85,8 +119,13 @@ func resourceArmVirtualNetwork() *schema.Resource {
 }
 
 func resourceArmVirtualNetworkCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).vnetClient
-	ctx := meta.(*ArmClient).StopContext
+	armClient, err := armClientForResource(d, meta)
+	if err != nil {
+		return err
+	}
+	client := armClient.vnetClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for Azure ARM virtual network creation.")
 
@@ -94,7 +133,7 @@ func resourceArmVirtualNetworkCreate(d *schema.ResourceData, meta interface{}) e
 	location := azureRMNormalizeLocation(d.Get("location").(string))
 	resGroup := d.Get("resource_group_name").(string)
 	tags := d.Get("tags").(map[string]interface{})
-	vnetProperties, vnetPropsErr := expandVirtualNetworkProperties(ctx, d, meta)
+	vnetProperties, vnetPropsErr := expandVirtualNetworkProperties(ctx, d, armClient)
 	if vnetPropsErr != nil {
 		return vnetPropsErr
 	}
@@ -103,7 +142,7 @@ func resourceArmVirtualNetworkCreate(d *schema.ResourceData, meta interface{}) e
 		Name:                           &name,
 		Location:                       &location,
 		VirtualNetworkPropertiesFormat: vnetProperties,
-		Tags: expandTags(tags),
+		Tags: expandTags(tags, meta),
 	}
 
 	networkSecurityGroupNames := make([]string, 0)
@@ -123,12 +162,24 @@ func resourceArmVirtualNetworkCreate(d *schema.ResourceData, meta interface{}) e
 	azureRMLockMultipleByName(&networkSecurityGroupNames, networkSecurityGroupResourceName)
 	defer azureRMUnlockMultipleByName(&networkSecurityGroupNames, networkSecurityGroupResourceName)
 
+	// we lock both the previous and the new DDoS Protection Plan, so that neither is updated or deleted whilst this
+	// Virtual Network is being associated with (or dissociated from) it. This only prevents these running at the same
+	// time, it doesn't determine which runs first - the plan can't be deleted whilst it's still associated with a
+	// Virtual Network, which the plan's Delete checks for once it holds the lock
+	ddosProtectionPlanNames, err := expandVirtualNetworkDdosProtectionPlanNames(d)
+	if err != nil {
+		return err
+	}
+
+	azureRMLockMultipleByName(&ddosProtectionPlanNames, ddosProtectionPlanResourceName)
+	defer azureRMUnlockMultipleByName(&ddosProtectionPlanNames, ddosProtectionPlanResourceName)
+
 	future, err := client.CreateOrUpdate(ctx, resGroup, name, vnet)
 	if err != nil {
 		return fmt.Errorf("Error Creating/Updating Virtual Network %q (Resource Group %q): %+v", name, resGroup, err)
 	}
 
-	err = future.WaitForCompletionRef(ctx, client.Client)
+	err = polling.WaitForCompletion(ctx, &future.Future, client.Client)
 	if err != nil {
 		return fmt.Errorf("Error waiting for completion of Virtual Network %q (Resource Group %q): %+v", name, resGroup, err)
 	}
@@ -147,8 +198,13 @@ func resourceArmVirtualNetworkCreate(d *schema.ResourceData, meta interface{}) e
 }
 
 func resourceArmVirtualNetworkRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).vnetClient
-	ctx := meta.(*ArmClient).StopContext
+	armClient, err := armClientForResource(d, meta)
+	if err != nil {
+		return err
+	}
+	client := armClient.vnetClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
@@ -168,6 +224,7 @@ func resourceArmVirtualNetworkRead(d *schema.ResourceData, meta interface{}) err
 
 	d.Set("name", resp.Name)
 	d.Set("resource_group_name", resGroup)
+	d.Set("subscription_id", armClient.subscriptionId)
 	if location := resp.Location; location != nil {
 		d.Set("location", azureRMNormalizeLocation(*location))
 	}
@@ -187,16 +244,26 @@ func resourceArmVirtualNetworkRead(d *schema.ResourceData, meta interface{}) err
 			return fmt.Errorf("Error setting `dns_servers`: %+v", err)
 		}
 
+		ddosProtectionPlan := flattenVirtualNetworkDdosProtectionPlan(props)
+		if err := d.Set("ddos_protection_plan", ddosProtectionPlan); err != nil {
+			return fmt.Errorf("Error setting `ddos_protection_plan`: %+v", err)
+		}
+
 	}
 
-	flattenAndSetTags(d, resp.Tags)
+	flattenAndSetResourceTags(d, resp.Tags, meta)
 
 	return nil
 }
 
 func resourceArmVirtualNetworkDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).vnetClient
-	ctx := meta.(*ArmClient).StopContext
+	armClient, err := armClientForResource(d, meta)
+	if err != nil {
+		return err
+	}
+	client := armClient.vnetClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	id, err := parseAzureResourceID(d.Id())
 	if err != nil {
@@ -218,7 +285,7 @@ func resourceArmVirtualNetworkDelete(d *schema.ResourceData, meta interface{}) e
 		return fmt.Errorf("Error deleting Virtual Network %q (Resource Group %q): %+v", name, resGroup, err)
 	}
 
-	err = future.WaitForCompletionRef(ctx, client.Client)
+	err = polling.WaitForCompletion(ctx, &future.Future, client.Client)
 	if err != nil {
 		return fmt.Errorf("Error waiting for deletion of Virtual Network %q (Resource Group %q): %+v", name, resGroup, err)
 	}
@@ -289,9 +356,69 @@ func expandVirtualNetworkProperties(ctx context.Context, d *schema.ResourceData,
 		},
 		Subnets: &subnets,
 	}
+
+	// then; the ddos protection plan:
+	if plans := d.Get("ddos_protection_plan").([]interface{}); len(plans) > 0 && plans[0] != nil {
+		plan := plans[0].(map[string]interface{})
+		planId := plan["id"].(string)
+		enable := plan["enable"].(bool)
+
+		properties.DdosProtectionPlan = &network.SubResource{
+			ID: utils.String(planId),
+		}
+		properties.EnableDdosProtection = utils.Bool(enable)
+	}
+
 	// finally; return the struct:
 	return properties, nil
 }
+
+func expandVirtualNetworkDdosProtectionPlanNames(d *schema.ResourceData) ([]string, error) {
+	names := make([]string, 0)
+
+	old, new := d.GetChange("ddos_protection_plan")
+	for _, plans := range []interface{}{old, new} {
+		for _, raw := range plans.([]interface{}) {
+			if raw == nil {
+				continue
+			}
+
+			planId := raw.(map[string]interface{})["id"].(string)
+			if planId == "" {
+				continue
+			}
+
+			id, err := azure.ParseDdosProtectionPlanID(planId)
+			if err != nil {
+				return nil, err
+			}
+
+			if !sliceContainsValue(names, id.Name) {
+				names = append(names, id.Name)
+			}
+		}
+	}
+
+	return names, nil
+}
+
+func flattenVirtualNetworkDdosProtectionPlan(input *network.VirtualNetworkPropertiesFormat) []interface{} {
+	if input == nil || input.DdosProtectionPlan == nil || input.DdosProtectionPlan.ID == nil {
+		return []interface{}{}
+	}
+
+	enable := false
+	if input.EnableDdosProtection != nil {
+		enable = *input.EnableDdosProtection
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"id":     *input.DdosProtectionPlan.ID,
+			"enable": enable,
+		},
+	}
+}
 func flattenVirtualNetworkSubnets(input *[]network.Subnet) *schema.Set {
 	results := &schema.Set{
 		F: resourceAzureSubnetHash,
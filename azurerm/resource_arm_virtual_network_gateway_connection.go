@@ -3,12 +3,15 @@ package azurerm
 import (
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-04-01/network"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/polling"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/suppress"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -20,7 +23,14 @@ func resourceArmVirtualNetworkGatewayConnection() *schema.Resource {
 		Delete: resourceArmVirtualNetworkGatewayConnectionDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: azure.ImportVirtualNetworkGatewayConnectionID,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -33,6 +43,8 @@ func resourceArmVirtualNetworkGatewayConnection() *schema.Resource {
 
 			"resource_group_name": resourceGroupNameSchema(),
 
+			"subscription_id": subscriptionIdOverrideSchema(),
+
 			"location": locationSchema(),
 
 			"type": {
@@ -106,117 +118,7 @@ func resourceArmVirtualNetworkGatewayConnection() *schema.Resource {
 				Sensitive: true,
 			},
 
-			"ipsec_policy": {
-				Type:     schema.TypeList,
-				Optional: true,
-				MaxItems: 1,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"dh_group": {
-							Type:             schema.TypeString,
-							Required:         true,
-							DiffSuppressFunc: suppress.CaseDifference,
-							ValidateFunc: validation.StringInSlice([]string{
-								string(network.DHGroup1),
-								string(network.DHGroup14),
-								string(network.DHGroup2),
-								string(network.DHGroup2048),
-								string(network.DHGroup24),
-								string(network.ECP256),
-								string(network.ECP384),
-								string(network.None),
-							}, true),
-						},
-
-						"ike_encryption": {
-							Type:             schema.TypeString,
-							Required:         true,
-							DiffSuppressFunc: suppress.CaseDifference,
-							ValidateFunc: validation.StringInSlice([]string{
-								string(network.AES128),
-								string(network.AES192),
-								string(network.AES256),
-								string(network.DES),
-								string(network.DES3),
-							}, true),
-						},
-
-						"ike_integrity": {
-							Type:             schema.TypeString,
-							Required:         true,
-							DiffSuppressFunc: suppress.CaseDifference,
-							ValidateFunc: validation.StringInSlice([]string{
-								string(network.IkeIntegrityGCMAES128),
-								string(network.IkeIntegrityGCMAES256),
-								string(network.IkeIntegrityMD5),
-								string(network.IkeIntegritySHA1),
-								string(network.IkeIntegritySHA256),
-								string(network.IkeIntegritySHA384),
-							}, true),
-						},
-
-						"ipsec_encryption": {
-							Type:             schema.TypeString,
-							Required:         true,
-							DiffSuppressFunc: suppress.CaseDifference,
-							ValidateFunc: validation.StringInSlice([]string{
-								string(network.IpsecEncryptionAES128),
-								string(network.IpsecEncryptionAES192),
-								string(network.IpsecEncryptionAES256),
-								string(network.IpsecEncryptionDES),
-								string(network.IpsecEncryptionDES3),
-								string(network.IpsecEncryptionGCMAES128),
-								string(network.IpsecEncryptionGCMAES192),
-								string(network.IpsecEncryptionGCMAES256),
-								string(network.IpsecEncryptionNone),
-							}, true),
-						},
-
-						"ipsec_integrity": {
-							Type:             schema.TypeString,
-							Required:         true,
-							DiffSuppressFunc: suppress.CaseDifference,
-							ValidateFunc: validation.StringInSlice([]string{
-								string(network.IpsecIntegrityGCMAES128),
-								string(network.IpsecIntegrityGCMAES192),
-								string(network.IpsecIntegrityGCMAES256),
-								string(network.IpsecIntegrityMD5),
-								string(network.IpsecIntegritySHA1),
-								string(network.IpsecIntegritySHA256),
-							}, true),
-						},
-
-						"pfs_group": {
-							Type:             schema.TypeString,
-							Required:         true,
-							DiffSuppressFunc: suppress.CaseDifference,
-							ValidateFunc: validation.StringInSlice([]string{
-								string(network.PfsGroupECP256),
-								string(network.PfsGroupECP384),
-								string(network.PfsGroupNone),
-								string(network.PfsGroupPFS1),
-								string(network.PfsGroupPFS2),
-								string(network.PfsGroupPFS2048),
-								string(network.PfsGroupPFS24),
-							}, true),
-						},
-
-						"sa_datasize": {
-							Type:         schema.TypeInt,
-							Optional:     true,
-							Computed:     true,
-							ValidateFunc: validation.IntAtLeast(1024),
-						},
-
-						"sa_lifetime": {
-							Type:         schema.TypeInt,
-							Optional:     true,
-							Computed:     true,
-							ValidateFunc: validation.IntAtLeast(300),
-						},
-					},
-				},
-			},
+			"ipsec_policy": virtualNetworkGatewayConnectionIpsecPolicySchema(),
 
 			"tags": tagsSchema(),
 		},
@@ -224,8 +126,13 @@ func resourceArmVirtualNetworkGatewayConnection() *schema.Resource {
 }
 
 func resourceArmVirtualNetworkGatewayConnectionCreateUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).vnetGatewayConnectionsClient
-	ctx := meta.(*ArmClient).StopContext
+	armClient, err := armClientForResource(d, meta)
+	if err != nil {
+		return err
+	}
+	client := armClient.vnetGatewayConnectionsClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	log.Printf("[INFO] preparing arguments for AzureRM Virtual Network Gateway Connection creation.")
 
@@ -242,7 +149,7 @@ func resourceArmVirtualNetworkGatewayConnectionCreateUpdate(d *schema.ResourceDa
 	connection := network.VirtualNetworkGatewayConnection{
 		Name:     &name,
 		Location: &location,
-		Tags:     expandTags(tags),
+		Tags:     expandTags(tags, meta),
 		VirtualNetworkGatewayConnectionPropertiesFormat: properties,
 	}
 
@@ -251,7 +158,7 @@ func resourceArmVirtualNetworkGatewayConnectionCreateUpdate(d *schema.ResourceDa
 		return fmt.Errorf("Error Creating/Updating AzureRM Virtual Network Gateway Connection %q (Resource Group %q): %+v", name, resGroup, err)
 	}
 
-	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+	if err := polling.WaitForCompletion(ctx, &future.Future, client.Client); err != nil {
 		return fmt.Errorf("Error waiting for completion of Virtual Network Gateway Connection %q (Resource Group %q): %+v", name, resGroup, err)
 	}
 
@@ -269,8 +176,13 @@ func resourceArmVirtualNetworkGatewayConnectionCreateUpdate(d *schema.ResourceDa
 }
 
 func resourceArmVirtualNetworkGatewayConnectionRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).vnetGatewayConnectionsClient
-	ctx := meta.(*ArmClient).StopContext
+	armClient, err := armClientForResource(d, meta)
+	if err != nil {
+		return err
+	}
+	client := armClient.vnetGatewayConnectionsClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	resGroup, name, err := resourceGroupAndVirtualNetworkGatewayConnectionFromId(d.Id())
 	if err != nil {
@@ -290,6 +202,7 @@ func resourceArmVirtualNetworkGatewayConnectionRead(d *schema.ResourceData, meta
 
 	d.Set("name", resp.Name)
 	d.Set("resource_group_name", resGroup)
+	d.Set("subscription_id", armClient.subscriptionId)
 	if location := resp.Location; location != nil {
 		d.Set("location", azureRMNormalizeLocation(*location))
 	}
@@ -342,14 +255,19 @@ func resourceArmVirtualNetworkGatewayConnectionRead(d *schema.ResourceData, meta
 		}
 	}
 
-	flattenAndSetTags(d, resp.Tags)
+	flattenAndSetResourceTags(d, resp.Tags, meta)
 
 	return nil
 }
 
 func resourceArmVirtualNetworkGatewayConnectionDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*ArmClient).vnetGatewayConnectionsClient
-	ctx := meta.(*ArmClient).StopContext
+	armClient, err := armClientForResource(d, meta)
+	if err != nil {
+		return err
+	}
+	client := armClient.vnetGatewayConnectionsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
 
 	resGroup, name, err := resourceGroupAndVirtualNetworkGatewayConnectionFromId(d.Id())
 	if err != nil {
@@ -361,7 +279,7 @@ func resourceArmVirtualNetworkGatewayConnectionDelete(d *schema.ResourceData, me
 		return fmt.Errorf("Error Deleting Virtual Network Gateway Connection %q (Resource Group %q): %+v", name, resGroup, err)
 	}
 
-	if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+	if err := polling.WaitForCompletion(ctx, &future.Future, client.Client); err != nil {
 		return fmt.Errorf("Error waiting for deletion of Virtual Network Gateway Connection %q (Resource Group %q): %+v", name, resGroup, err)
 	}
 
@@ -487,6 +405,120 @@ func resourceGroupAndVirtualNetworkGatewayConnectionFromId(virtualNetworkGateway
 	return resGroup, name, nil
 }
 
+func virtualNetworkGatewayConnectionIpsecPolicySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"dh_group": {
+					Type:             schema.TypeString,
+					Required:         true,
+					DiffSuppressFunc: suppress.CaseDifference,
+					ValidateFunc: validation.StringInSlice([]string{
+						string(network.DHGroup1),
+						string(network.DHGroup14),
+						string(network.DHGroup2),
+						string(network.DHGroup2048),
+						string(network.DHGroup24),
+						string(network.ECP256),
+						string(network.ECP384),
+						string(network.None),
+					}, true),
+				},
+
+				"ike_encryption": {
+					Type:             schema.TypeString,
+					Required:         true,
+					DiffSuppressFunc: suppress.CaseDifference,
+					ValidateFunc: validation.StringInSlice([]string{
+						string(network.AES128),
+						string(network.AES192),
+						string(network.AES256),
+						string(network.DES),
+						string(network.DES3),
+					}, true),
+				},
+
+				"ike_integrity": {
+					Type:             schema.TypeString,
+					Required:         true,
+					DiffSuppressFunc: suppress.CaseDifference,
+					ValidateFunc: validation.StringInSlice([]string{
+						string(network.IkeIntegrityGCMAES128),
+						string(network.IkeIntegrityGCMAES256),
+						string(network.IkeIntegrityMD5),
+						string(network.IkeIntegritySHA1),
+						string(network.IkeIntegritySHA256),
+						string(network.IkeIntegritySHA384),
+					}, true),
+				},
+
+				"ipsec_encryption": {
+					Type:             schema.TypeString,
+					Required:         true,
+					DiffSuppressFunc: suppress.CaseDifference,
+					ValidateFunc: validation.StringInSlice([]string{
+						string(network.IpsecEncryptionAES128),
+						string(network.IpsecEncryptionAES192),
+						string(network.IpsecEncryptionAES256),
+						string(network.IpsecEncryptionDES),
+						string(network.IpsecEncryptionDES3),
+						string(network.IpsecEncryptionGCMAES128),
+						string(network.IpsecEncryptionGCMAES192),
+						string(network.IpsecEncryptionGCMAES256),
+						string(network.IpsecEncryptionNone),
+					}, true),
+				},
+
+				"ipsec_integrity": {
+					Type:             schema.TypeString,
+					Required:         true,
+					DiffSuppressFunc: suppress.CaseDifference,
+					ValidateFunc: validation.StringInSlice([]string{
+						string(network.IpsecIntegrityGCMAES128),
+						string(network.IpsecIntegrityGCMAES192),
+						string(network.IpsecIntegrityGCMAES256),
+						string(network.IpsecIntegrityMD5),
+						string(network.IpsecIntegritySHA1),
+						string(network.IpsecIntegritySHA256),
+					}, true),
+				},
+
+				"pfs_group": {
+					Type:             schema.TypeString,
+					Required:         true,
+					DiffSuppressFunc: suppress.CaseDifference,
+					ValidateFunc: validation.StringInSlice([]string{
+						string(network.PfsGroupECP256),
+						string(network.PfsGroupECP384),
+						string(network.PfsGroupNone),
+						string(network.PfsGroupPFS1),
+						string(network.PfsGroupPFS2),
+						string(network.PfsGroupPFS2048),
+						string(network.PfsGroupPFS24),
+					}, true),
+				},
+
+				"sa_datasize": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.IntAtLeast(1024),
+				},
+
+				"sa_lifetime": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.IntAtLeast(300),
+				},
+			},
+		},
+	}
+}
+
 func expandArmVirtualNetworkGatewayConnectionIpsecPolicies(schemaIpsecPolicies []interface{}) *[]network.IpsecPolicy {
 	ipsecPolicies := make([]network.IpsecPolicy, 0, len(schemaIpsecPolicies))
 
@@ -0,0 +1,440 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2018-04-01/network"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/polling"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmVpnGateway() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmVpnGatewayCreateUpdate,
+		Read:   resourceArmVpnGatewayRead,
+		Update: resourceArmVpnGatewayCreateUpdate,
+		Delete: resourceArmVpnGatewayDelete,
+		Importer: &schema.ResourceImporter{
+			State: azure.ImportVpnGatewayID,
+		},
+
+		// provisioning a VPN Gateway also updates the Virtual Hub, both of which can take a considerable time
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(90 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(90 * time.Minute),
+			Delete: schema.DefaultTimeout(90 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			"location": locationSchema(),
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"virtual_hub_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateVirtualHubID,
+			},
+
+			"bgp_settings": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"asn": {
+							Type:     schema.TypeInt,
+							Required: true,
+						},
+
+						"peer_weight": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+						},
+
+						"bgp_peering_address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"allow_branch_to_branch_traffic": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"allow_virtual_network_to_virtual_network_traffic": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"vpn_site_connection": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+
+						"vpn_site_id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: azure.ValidateVpnSiteID,
+						},
+
+						"shared_key": {
+							Type:      schema.TypeString,
+							Optional:  true,
+							Computed:  true,
+							Sensitive: true,
+						},
+
+						"routing_weight": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntBetween(0, 32000),
+						},
+
+						"connection_bandwidth_in_mbps": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+
+						"enable_bgp": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+
+						"ipsec_policy": virtualNetworkGatewayConnectionIpsecPolicySchema(),
+					},
+				},
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceArmVpnGatewayCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).vpnGatewayClient
+	hubsClient := meta.(*ArmClient).virtualHubClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	location := azureRMNormalizeLocation(d.Get("location").(string))
+	tags := d.Get("tags").(map[string]interface{})
+
+	hubId, err := azure.ParseVirtualHubID(d.Get("virtual_hub_id").(string))
+	if err != nil {
+		return err
+	}
+
+	if d.IsNewResource() {
+		existing, err := client.Get(ctx, resourceGroup, name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("Error checking for the presence of an existing VPN Gateway %q (Resource Group %q): %+v", name, resourceGroup, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return fmt.Errorf("A VPN Gateway named %q already exists in Resource Group %q - to be managed via Terraform this resource needs to be imported into the State", name, resourceGroup)
+		}
+	}
+
+	// the VPN Gateway is provisioned within the Virtual Hub, so the hub can't be modified at the same time
+	azureRMLockByName(hubId.Name, virtualHubResourceName)
+	defer azureRMUnlockByName(hubId.Name, virtualHubResourceName)
+
+	gateway := network.VpnGateway{
+		Location: utils.String(location),
+		VpnGatewayProperties: &network.VpnGatewayProperties{
+			VirtualHub: &network.SubResource{
+				ID: utils.String(hubId.String()),
+			},
+			BgpSettings: expandArmVpnGatewayBgpSettings(d.Get("bgp_settings").([]interface{})),
+			Policies: &network.Policies{
+				AllowBranchToBranchTraffic: utils.Bool(d.Get("allow_branch_to_branch_traffic").(bool)),
+				AllowVnetToVnetTraffic:     utils.Bool(d.Get("allow_virtual_network_to_virtual_network_traffic").(bool)),
+			},
+			Connections: expandArmVpnGatewayConnections(d.Get("vpn_site_connection").([]interface{})),
+		},
+		Tags: expandTags(tags, meta),
+	}
+
+	// where the Virtual Hub is still being provisioned, wait for that to complete rather than failing
+	var future network.VpnGatewaysCreateOrUpdateFuture
+	start := func() error {
+		future, err = client.CreateOrUpdate(ctx, resourceGroup, name, gateway)
+		return err
+	}
+	if err := polling.StartOrAttach(ctx, start, virtualHubProvisioningState(ctx, hubsClient, hubId.ResourceGroup, hubId.Name)); err != nil {
+		return fmt.Errorf("Error creating/updating VPN Gateway %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	err = polling.WaitForCompletion(ctx, &future.Future, client.Client)
+	if err != nil {
+		return fmt.Errorf("Error waiting for the creation/update of VPN Gateway %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	read, err := client.Get(ctx, resourceGroup, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving VPN Gateway %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read VPN Gateway %q (Resource Group %q) ID", name, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmVpnGatewayRead(d, meta)
+}
+
+func resourceArmVpnGatewayRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).vpnGatewayClient
+	ctx, cancel := timeouts.ForRead(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := azure.ParseVpnGatewayID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] VPN Gateway %q was not found in Resource Group %q - removing from state!", id.Name, id.ResourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error making Read request on VPN Gateway %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", id.ResourceGroup)
+	if location := resp.Location; location != nil {
+		d.Set("location", azureRMNormalizeLocation(*location))
+	}
+
+	if props := resp.VpnGatewayProperties; props != nil {
+		virtualHubId := ""
+		if hub := props.VirtualHub; hub != nil && hub.ID != nil {
+			virtualHubId = *hub.ID
+		}
+		d.Set("virtual_hub_id", virtualHubId)
+
+		if err := d.Set("bgp_settings", flattenArmVpnGatewayBgpSettings(props.BgpSettings)); err != nil {
+			return fmt.Errorf("Error setting `bgp_settings`: %+v", err)
+		}
+
+		if policies := props.Policies; policies != nil {
+			d.Set("allow_branch_to_branch_traffic", policies.AllowBranchToBranchTraffic)
+			d.Set("allow_virtual_network_to_virtual_network_traffic", policies.AllowVnetToVnetTraffic)
+		}
+
+		if err := d.Set("vpn_site_connection", flattenArmVpnGatewayConnections(props.Connections)); err != nil {
+			return fmt.Errorf("Error setting `vpn_site_connection`: %+v", err)
+		}
+	}
+
+	flattenAndSetResourceTags(d, resp.Tags, meta)
+
+	return nil
+}
+
+func resourceArmVpnGatewayDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).vpnGatewayClient
+	hubsClient := meta.(*ArmClient).virtualHubClient
+	ctx, cancel := timeouts.ForDelete(meta.(*ArmClient).StopContext, d)
+	defer cancel()
+
+	id, err := azure.ParseVpnGatewayID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	hubId, err := azure.ParseVirtualHubID(d.Get("virtual_hub_id").(string))
+	if err != nil {
+		return err
+	}
+
+	azureRMLockByName(hubId.Name, virtualHubResourceName)
+	defer azureRMUnlockByName(hubId.Name, virtualHubResourceName)
+
+	var future network.VpnGatewaysDeleteFuture
+	start := func() error {
+		future, err = client.Delete(ctx, id.ResourceGroup, id.Name)
+		return err
+	}
+	if err := polling.StartOrAttach(ctx, start, virtualHubProvisioningState(ctx, hubsClient, hubId.ResourceGroup, hubId.Name)); err != nil {
+		if response.WasNotFound(future.Response()) {
+			return nil
+		}
+
+		return fmt.Errorf("Error deleting VPN Gateway %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+	}
+
+	err = polling.WaitForCompletion(ctx, &future.Future, client.Client)
+	if err != nil {
+		if response.WasNotFound(future.Response()) {
+			return nil
+		}
+
+		return fmt.Errorf("Error waiting for the deletion of VPN Gateway %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
+	}
+
+	return nil
+}
+
+func expandArmVpnGatewayBgpSettings(input []interface{}) *network.BgpSettings {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+
+	settings := network.BgpSettings{
+		Asn: utils.Int64(int64(v["asn"].(int))),
+	}
+
+	if peerWeight := v["peer_weight"].(int); peerWeight != 0 {
+		settings.PeerWeight = utils.Int32(int32(peerWeight))
+	}
+
+	return &settings
+}
+
+func flattenArmVpnGatewayBgpSettings(input *network.BgpSettings) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	output := make(map[string]interface{})
+
+	if input.Asn != nil {
+		output["asn"] = int(*input.Asn)
+	}
+
+	if input.PeerWeight != nil {
+		output["peer_weight"] = int(*input.PeerWeight)
+	}
+
+	if input.BgpPeeringAddress != nil {
+		output["bgp_peering_address"] = *input.BgpPeeringAddress
+	}
+
+	return []interface{}{output}
+}
+
+func expandArmVpnGatewayConnections(input []interface{}) *[]network.VpnConnection {
+	connections := make([]network.VpnConnection, 0)
+
+	for _, raw := range input {
+		v := raw.(map[string]interface{})
+
+		props := network.VpnConnectionProperties{
+			RemoteVpnSite: &network.SubResource{
+				ID: utils.String(v["vpn_site_id"].(string)),
+			},
+			EnableBgp: utils.Bool(v["enable_bgp"].(bool)),
+		}
+
+		if sharedKey := v["shared_key"].(string); sharedKey != "" {
+			props.SharedKey = utils.String(sharedKey)
+		}
+
+		if routingWeight := v["routing_weight"].(int); routingWeight != 0 {
+			props.RoutingWeight = utils.Int32(int32(routingWeight))
+		}
+
+		if bandwidth := v["connection_bandwidth_in_mbps"].(int); bandwidth != 0 {
+			props.ConnectionBandwidth = utils.Int32(int32(bandwidth))
+		}
+
+		if ipsecPolicies := v["ipsec_policy"].([]interface{}); len(ipsecPolicies) > 0 {
+			props.IpsecPolicies = expandArmVirtualNetworkGatewayConnectionIpsecPolicies(ipsecPolicies)
+		}
+
+		connections = append(connections, network.VpnConnection{
+			Name:                    utils.String(v["name"].(string)),
+			VpnConnectionProperties: &props,
+		})
+	}
+
+	return &connections
+}
+
+func flattenArmVpnGatewayConnections(input *[]network.VpnConnection) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, connection := range *input {
+		output := make(map[string]interface{})
+
+		if connection.Name != nil {
+			output["name"] = *connection.Name
+		}
+
+		if props := connection.VpnConnectionProperties; props != nil {
+			if site := props.RemoteVpnSite; site != nil && site.ID != nil {
+				output["vpn_site_id"] = *site.ID
+			}
+
+			if props.SharedKey != nil {
+				output["shared_key"] = *props.SharedKey
+			}
+
+			if props.RoutingWeight != nil {
+				output["routing_weight"] = int(*props.RoutingWeight)
+			}
+
+			if props.ConnectionBandwidth != nil {
+				output["connection_bandwidth_in_mbps"] = int(*props.ConnectionBandwidth)
+			}
+
+			if props.EnableBgp != nil {
+				output["enable_bgp"] = *props.EnableBgp
+			}
+
+			output["ipsec_policy"] = flattenArmVirtualNetworkGatewayConnectionIpsecPolicies(props.IpsecPolicies)
+		}
+
+		results = append(results, output)
+	}
+
+	return results
+}
@@ -0,0 +1,306 @@
+package azurerm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2017-05-10/resources"
+	"github.com/Azure/go-autorest/autorest"
+	multierror "github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+// defaultResourceProviderRegistrationTimeout is how long we wait for a Resource Provider to become Registered
+// when the Context we've been given has no deadline
+const defaultResourceProviderRegistrationTimeout = 20 * time.Minute
+
+// requiredResourceProviders returns all of the Resource Providers which the Terraform Provider may require
+func requiredResourceProviders() map[string]struct{} {
+	return map[string]struct{}{
+		"Microsoft.Authorization":       {},
+		"Microsoft.Automation":          {},
+		"Microsoft.Cache":               {},
+		"Microsoft.Cdn":                 {},
+		"Microsoft.Compute":             {},
+		"Microsoft.ContainerInstance":   {},
+		"Microsoft.ContainerRegistry":   {},
+		"Microsoft.ContainerService":    {},
+		"Microsoft.DataLakeStore":       {},
+		"Microsoft.DBforMySQL":          {},
+		"Microsoft.DBforPostgreSQL":     {},
+		"Microsoft.Devices":             {},
+		"Microsoft.DevTestLab":          {},
+		"Microsoft.DocumentDB":          {},
+		"Microsoft.EventGrid":           {},
+		"Microsoft.EventHub":            {},
+		"Microsoft.KeyVault":            {},
+		"microsoft.insights":            {},
+		"Microsoft.Logic":               {},
+		"Microsoft.ManagedIdentity":     {},
+		"Microsoft.Management":          {},
+		"Microsoft.Network":             {},
+		"Microsoft.NotificationHubs":    {},
+		"Microsoft.OperationalInsights": {},
+		"Microsoft.Relay":               {},
+		"Microsoft.Resources":           {},
+		"Microsoft.Search":              {},
+		"Microsoft.ServiceBus":          {},
+		"Microsoft.ServiceFabric":       {},
+		"Microsoft.Sql":                 {},
+		"Microsoft.Storage":             {},
+	}
+}
+
+// resourceProvidersAllowedForRegistration returns the Resource Providers which can be registered - which is the
+// list specified in the Provider block when set, otherwise all of the Resource Providers which may be required
+func resourceProvidersAllowedForRegistration(allowList []string) map[string]struct{} {
+	if len(allowList) == 0 {
+		return requiredResourceProviders()
+	}
+
+	providers := make(map[string]struct{}, len(allowList))
+	for _, v := range allowList {
+		providers[v] = struct{}{}
+	}
+	return providers
+}
+
+func determineAzureResourceProvidersToRegister(providerList []resources.Provider, allowList []string) map[string]struct{} {
+	providers := resourceProvidersAllowedForRegistration(allowList)
+
+	// filter out any providers already registered
+	for _, p := range providerList {
+		if p.Namespace == nil || p.RegistrationState == nil {
+			continue
+		}
+
+		for name := range providers {
+			if !strings.EqualFold(name, *p.Namespace) {
+				continue
+			}
+
+			if strings.EqualFold(*p.RegistrationState, "Registered") {
+				log.Printf("[DEBUG] Skipping provider registration for namespace %s\n", *p.Namespace)
+				delete(providers, name)
+			}
+		}
+	}
+
+	return providers
+}
+
+// registerAzureResourceProvidersWithSubscription uses the providers client to register
+// all Azure resource providers which the Terraform provider may require (regardless of
+// whether they are actually used by the configuration or not). It was confirmed by Microsoft
+// that this is the approach their own internal tools also take.
+func registerAzureResourceProvidersWithSubscription(ctx context.Context, providerList []resources.Provider, allowList []string, client resources.ProvidersClient) error {
+	providers := determineAzureResourceProvidersToRegister(providerList, allowList)
+
+	var errors *multierror.Error
+	var lock sync.Mutex
+	var wg sync.WaitGroup
+	wg.Add(len(providers))
+
+	for providerName := range providers {
+		go func(p string) {
+			defer wg.Done()
+			log.Printf("[DEBUG] Registering provider with namespace %s\n", p)
+			if err := registerProviderWithSubscription(ctx, p, client, resourceProviderRegistrationPollInterval); err != nil {
+				lock.Lock()
+				errors = multierror.Append(errors, err)
+				lock.Unlock()
+			}
+		}(providerName)
+	}
+
+	wg.Wait()
+
+	return errors.ErrorOrNil()
+}
+
+// resourceProviderRegistrationPollInterval is how frequently we check if a Resource Provider has been Registered
+var resourceProviderRegistrationPollInterval = 10 * time.Second
+
+// registerProviderWithSubscription registers the specified Resource Provider and then waits for it to become
+// Registered, since until this happens requests to create resources within this namespace will fail
+func registerProviderWithSubscription(ctx context.Context, providerName string, client resources.ProvidersClient, pollInterval time.Duration) error {
+	if _, err := client.Register(ctx, providerName); err != nil {
+		return fmt.Errorf("Cannot register provider %s with Azure Resource Manager: %s.", providerName, err)
+	}
+
+	timeout := defaultResourceProviderRegistrationTimeout
+	if deadline, ok := ctx.Deadline(); ok {
+		timeout = time.Until(deadline)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{"NotRegistered", "Registering", "Unregistered"},
+		Target:       []string{"Registered"},
+		Refresh:      resourceProviderRegistrationStateRefreshFunc(ctx, client, providerName),
+		Timeout:      timeout,
+		PollInterval: pollInterval,
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for provider %s to be registered with Azure Resource Manager: %+v", providerName, err)
+	}
+
+	return nil
+}
+
+func resourceProviderRegistrationStateRefreshFunc(ctx context.Context, client resources.ProvidersClient, providerName string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := client.Get(ctx, providerName, "")
+		if err != nil {
+			return nil, "", fmt.Errorf("Error retrieving the registration state of provider %s: %+v", providerName, err)
+		}
+
+		if resp.RegistrationState == nil {
+			return resp, "", fmt.Errorf("Error retrieving the registration state of provider %s: `registrationState` was nil", providerName)
+		}
+
+		log.Printf("[DEBUG] Provider %s has the registration state %q", providerName, *resp.RegistrationState)
+		return resp, *resp.RegistrationState, nil
+	}
+}
+
+// resourceProviderRegistrar registers Resource Providers on-demand, the first time a resource
+// within that namespace is created - rather than registering every Resource Provider up front
+type resourceProviderRegistrar struct {
+	client       resources.ProvidersClient
+	allowed      map[string]struct{}
+	pollInterval time.Duration
+
+	lock       sync.Mutex
+	namespaces map[string]*resourceProviderRegistration
+}
+
+type resourceProviderRegistration struct {
+	sync.Mutex
+	registered bool
+}
+
+func newResourceProviderRegistrar(client resources.ProvidersClient, allowList []string) *resourceProviderRegistrar {
+	allowed := make(map[string]struct{})
+	for name := range resourceProvidersAllowedForRegistration(allowList) {
+		allowed[strings.ToLower(name)] = struct{}{}
+	}
+
+	return &resourceProviderRegistrar{
+		client:       client,
+		allowed:      allowed,
+		pollInterval: resourceProviderRegistrationPollInterval,
+		namespaces:   make(map[string]*resourceProviderRegistration),
+	}
+}
+
+// withClient returns a new registrar using the same list of Resource Providers which can be registered,
+// for use with the specified client (e.g. one bound to a different Subscription)
+func (r *resourceProviderRegistrar) withClient(client resources.ProvidersClient) *resourceProviderRegistrar {
+	return &resourceProviderRegistrar{
+		client:       client,
+		allowed:      r.allowed,
+		pollInterval: r.pollInterval,
+		namespaces:   make(map[string]*resourceProviderRegistration),
+	}
+}
+
+// markRegistered records the Resource Providers which are already Registered, to avoid checking these again
+func (r *resourceProviderRegistrar) markRegistered(providerList []resources.Provider) {
+	for _, p := range providerList {
+		if p.Namespace == nil || p.RegistrationState == nil {
+			continue
+		}
+
+		if strings.EqualFold(*p.RegistrationState, "Registered") {
+			registration := r.registration(*p.Namespace)
+			registration.Lock()
+			registration.registered = true
+			registration.Unlock()
+		}
+	}
+}
+
+func (r *resourceProviderRegistrar) registration(namespace string) *resourceProviderRegistration {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	key := strings.ToLower(namespace)
+	registration, ok := r.namespaces[key]
+	if !ok {
+		registration = &resourceProviderRegistration{}
+		r.namespaces[key] = registration
+	}
+	return registration
+}
+
+// ensureRegistered registers the specified Resource Provider if it's not already Registered. Concurrent callers
+// for the same namespace wait for a single registration - and failed registrations are retried on the next call.
+func (r *resourceProviderRegistrar) ensureRegistered(ctx context.Context, namespace string) error {
+	if _, ok := r.allowed[strings.ToLower(namespace)]; !ok {
+		log.Printf("[DEBUG] Provider %s isn't in the list of providers to register - skipping registration", namespace)
+		return nil
+	}
+
+	registration := r.registration(namespace)
+	registration.Lock()
+	defer registration.Unlock()
+
+	if registration.registered {
+		return nil
+	}
+
+	resp, err := r.client.Get(ctx, namespace, "")
+	if err != nil {
+		return fmt.Errorf("Error retrieving the registration state of provider %s: %+v", namespace, err)
+	}
+
+	if resp.RegistrationState == nil || !strings.EqualFold(*resp.RegistrationState, "Registered") {
+		log.Printf("[DEBUG] Registering provider with namespace %s\n", namespace)
+		if err := registerProviderWithSubscription(ctx, namespace, r.client, r.pollInterval); err != nil {
+			return err
+		}
+	}
+
+	registration.registered = true
+	return nil
+}
+
+// withResourceProviderRegistration ensures that the Resource Provider for any resource being created (or updated)
+// is registered prior to the request being sent, when on-demand Resource Provider registration is enabled
+func (c *ArmClient) withResourceProviderRegistration() autorest.SendDecorator {
+	return func(s autorest.Sender) autorest.Sender {
+		return autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+			registrar := c.resourceProviderRegistrar
+			if registrar == nil || r.Method != http.MethodPut {
+				return s.Do(r)
+			}
+
+			if namespace := resourceProviderNamespaceFromPath(r.URL.Path); namespace != "" {
+				if err := registrar.ensureRegistered(r.Context(), namespace); err != nil {
+					return nil, err
+				}
+			}
+
+			return s.Do(r)
+		})
+	}
+}
+
+// resourceProviderNamespaceFromPath returns the namespace of the Resource Provider for the resource at the
+// specified path - which for nested resources (e.g. a Lock on a Virtual Network) is the last Provider segment
+func resourceProviderNamespaceFromPath(path string) string {
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	namespace := ""
+	for i := 0; i < len(segments)-1; i++ {
+		if strings.EqualFold(segments[i], "providers") {
+			namespace = segments[i+1]
+		}
+	}
+	return namespace
+}
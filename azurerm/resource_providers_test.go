@@ -0,0 +1,209 @@
+package azurerm
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2017-05-10/resources"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestResourceProviderNamespaceFromPath(t *testing.T) {
+	testData := []struct {
+		Path     string
+		Expected string
+	}{
+		{
+			Path:     "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example",
+			Expected: "",
+		},
+		{
+			Path:     "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example/providers/Microsoft.Network/virtualNetworks/example",
+			Expected: "Microsoft.Network",
+		},
+		{
+			Path:     "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example/providers/Microsoft.Network/virtualNetworks/example/providers/Microsoft.Authorization/locks/example",
+			Expected: "Microsoft.Authorization",
+		},
+		{
+			Path:     "/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/example/PROVIDERS/microsoft.insights/actionGroups/example/",
+			Expected: "microsoft.insights",
+		},
+		{
+			Path:     "/subscriptions/00000000-0000-0000-0000-000000000000/providers",
+			Expected: "",
+		},
+	}
+
+	for _, v := range testData {
+		actual := resourceProviderNamespaceFromPath(v.Path)
+		if actual != v.Expected {
+			t.Fatalf("Expected %q for %q but got %q", v.Expected, v.Path, actual)
+		}
+	}
+}
+
+func TestDetermineAzureResourceProvidersToRegister(t *testing.T) {
+	providerList := []resources.Provider{
+		{
+			Namespace:         utils.String("Microsoft.Compute"),
+			RegistrationState: utils.String("Registered"),
+		},
+		{
+			Namespace:         utils.String("Microsoft.Network"),
+			RegistrationState: utils.String("NotRegistered"),
+		},
+		{
+			Namespace:         utils.String("Microsoft.Insights"),
+			RegistrationState: utils.String("Registered"),
+		},
+	}
+
+	testData := []struct {
+		AllowList []string
+		Expected  []string
+		Excluded  []string
+	}{
+		{
+			AllowList: []string{},
+			Expected:  []string{"Microsoft.Network", "Microsoft.Storage"},
+			Excluded:  []string{"Microsoft.Compute", "microsoft.insights"},
+		},
+		{
+			AllowList: []string{"Microsoft.Compute", "Microsoft.Network"},
+			Expected:  []string{"Microsoft.Network"},
+			Excluded:  []string{"Microsoft.Compute", "Microsoft.Storage"},
+		},
+	}
+
+	for _, v := range testData {
+		actual := determineAzureResourceProvidersToRegister(providerList, v.AllowList)
+
+		for _, name := range v.Expected {
+			if _, ok := actual[name]; !ok {
+				t.Fatalf("Expected %q to require registration for the allow list %+v", name, v.AllowList)
+			}
+		}
+
+		for _, name := range v.Excluded {
+			if _, ok := actual[name]; ok {
+				t.Fatalf("Expected %q not to require registration for the allow list %+v", name, v.AllowList)
+			}
+		}
+	}
+}
+
+type testResourceProviderServer struct {
+	lock          sync.Mutex
+	state         string
+	registrations int
+	requests      int
+}
+
+func (s *testResourceProviderServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.requests++
+	if r.Method == http.MethodPost && strings.HasSuffix(r.URL.Path, "/register") {
+		s.registrations++
+		s.state = "Registering"
+	} else if s.state == "Registering" {
+		// the second poll returns the Registered state
+		s.state = "Registered"
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	fmt.Fprintf(w, `{"namespace":"Microsoft.Network","registrationState":%q}`, s.state)
+}
+
+func TestResourceProviderRegistrarEnsureRegistered(t *testing.T) {
+	server := &testResourceProviderServer{state: "NotRegistered"}
+	httpServer := httptest.NewServer(server)
+	defer httpServer.Close()
+
+	client := resources.NewProvidersClientWithBaseURI(httpServer.URL, "00000000-0000-0000-0000-000000000000")
+	registrar := newResourceProviderRegistrar(client, []string{"Microsoft.Network"})
+	registrar.pollInterval = 10 * time.Millisecond
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	// concurrent requests should only result in a single registration
+	var wg sync.WaitGroup
+	errors := make(chan error, 5)
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errors <- registrar.ensureRegistered(ctx, "microsoft.network")
+		}()
+	}
+	wg.Wait()
+	close(errors)
+
+	for err := range errors {
+		if err != nil {
+			t.Fatalf("Expected no error but got: %+v", err)
+		}
+	}
+
+	if server.registrations != 1 {
+		t.Fatalf("Expected a single registration but got %d", server.registrations)
+	}
+
+	if server.state != "Registered" {
+		t.Fatalf("Expected the Resource Provider to be Registered but got %q", server.state)
+	}
+
+	// once registered, no further requests should be made
+	requests := server.requests
+	if err := registrar.ensureRegistered(ctx, "Microsoft.Network"); err != nil {
+		t.Fatalf("Expected no error but got: %+v", err)
+	}
+
+	// and Resource Providers not in the allow list should never be registered
+	if err := registrar.ensureRegistered(ctx, "Microsoft.Compute"); err != nil {
+		t.Fatalf("Expected no error but got: %+v", err)
+	}
+
+	if server.requests != requests {
+		t.Fatalf("Expected no further requests but got %d", server.requests-requests)
+	}
+}
+
+func TestResourceProviderRegistrarEnsureRegisteredError(t *testing.T) {
+	httpServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusForbidden)
+			fmt.Fprint(w, `{"error":{"code":"AuthorizationFailed","message":"does not have authorization to perform action 'Microsoft.Network/register/action'"}}`)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"namespace":"Microsoft.Network","registrationState":"NotRegistered"}`)
+	}))
+	defer httpServer.Close()
+
+	client := resources.NewProvidersClientWithBaseURI(httpServer.URL, "00000000-0000-0000-0000-000000000000")
+	registrar := newResourceProviderRegistrar(client, []string{})
+	registrar.pollInterval = 10 * time.Millisecond
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	if err := registrar.ensureRegistered(ctx, "Microsoft.Network"); err == nil {
+		t.Fatalf("Expected an error but didn't get one")
+	}
+
+	if registrar.registration("Microsoft.Network").registered {
+		t.Fatalf("Expected a failed registration not to be recorded as registered")
+	}
+}
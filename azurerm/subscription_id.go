@@ -0,0 +1,35 @@
+package azurerm
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+)
+
+// subscriptionIdOverrideSchema allows a resource to be provisioned in a different Subscription
+// to the one the Provider is configured for
+func subscriptionIdOverrideSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ForceNew:     true,
+		ValidateFunc: validate.UUID,
+	}
+}
+
+// armClientForResource returns the ArmClient for the Subscription this resource is (or will be) provisioned in -
+// which for existing resources is parsed from the Resource ID, otherwise it's the `subscription_id` field (when set)
+func armClientForResource(d *schema.ResourceData, meta interface{}) (*ArmClient, error) {
+	armClient := meta.(*ArmClient)
+
+	if d.Id() != "" {
+		id, err := parseAzureResourceID(d.Id())
+		if err != nil {
+			return nil, err
+		}
+
+		return armClient.forSubscription(id.SubscriptionID), nil
+	}
+
+	return armClient.forSubscription(d.Get("subscription_id").(string)), nil
+}
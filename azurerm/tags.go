@@ -68,8 +68,100 @@ func validateAzureRMTags(v interface{}, _ string) (ws []string, es []error) {
 	return ws, es
 }
 
-func expandTags(tagsMap map[string]interface{}) map[string]*string {
-	output := make(map[string]*string, len(tagsMap))
+// tagsConfig is the Provider-level configuration for Tags, which applies to every resource
+type tagsConfig struct {
+	// defaultTags are the Tags which are applied to every resource, unless overridden by the resource
+	defaultTags map[string]string
+
+	// ignoreKeys and ignoreKeyPrefixes are the Tags which are managed outside of Terraform (for example by
+	// Azure Policy) and so shouldn't show a diff when they're not defined on a resource - and are retained when
+	// updating it
+	ignoreKeys        []string
+	ignoreKeyPrefixes []string
+}
+
+func expandProviderTagsConfig(d *schema.ResourceData) tagsConfig {
+	config := tagsConfig{
+		defaultTags: make(map[string]string),
+	}
+
+	if v, ok := d.GetOk("default_tags"); ok {
+		defaultTags := v.([]interface{})
+		if len(defaultTags) > 0 && defaultTags[0] != nil {
+			block := defaultTags[0].(map[string]interface{})
+			for k, v := range block["tags"].(map[string]interface{}) {
+				// this has already been validated, so we can ignore the error
+				value, _ := tagValueToString(v)
+				config.defaultTags[k] = value
+			}
+		}
+	}
+
+	for _, v := range d.Get("ignore_tag_keys").(*schema.Set).List() {
+		config.ignoreKeys = append(config.ignoreKeys, v.(string))
+	}
+
+	for _, v := range d.Get("ignore_tag_prefixes").(*schema.Set).List() {
+		config.ignoreKeyPrefixes = append(config.ignoreKeyPrefixes, v.(string))
+	}
+
+	return config
+}
+
+func tagsConfigFromMeta(meta interface{}) tagsConfig {
+	if client, ok := meta.(*ArmClient); ok && client != nil {
+		return client.tagsConfig
+	}
+
+	return tagsConfig{}
+}
+
+// isIgnored returns whether the Tag should be ignored - Tag keys are case-insensitive in Azure
+func (c tagsConfig) isIgnored(key string) bool {
+	for _, k := range c.ignoreKeys {
+		if strings.EqualFold(k, key) {
+			return true
+		}
+	}
+
+	for _, prefix := range c.ignoreKeyPrefixes {
+		if strings.HasPrefix(strings.ToLower(key), strings.ToLower(prefix)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (c tagsConfig) hasIgnoredTags() bool {
+	return len(c.ignoreKeys) > 0 || len(c.ignoreKeyPrefixes) > 0
+}
+
+// isDefault returns whether the Tag is one of the Default Tags with the Default value
+func (c tagsConfig) isDefault(key string, value string) bool {
+	for k, v := range c.defaultTags {
+		if strings.EqualFold(k, key) {
+			return v == value
+		}
+	}
+
+	return false
+}
+
+// expandTags returns the Tags for a resource - which are the Default Tags configured in the Provider block
+// merged with the Tags defined on the resource, where the Tags defined on the resource take precedence
+func expandTags(tagsMap map[string]interface{}, meta interface{}) map[string]*string {
+	config := tagsConfigFromMeta(meta)
+	output := make(map[string]*string, len(config.defaultTags)+len(tagsMap))
+
+	for k, v := range config.defaultTags {
+		if tagsMapContainsKey(tagsMap, k) {
+			continue
+		}
+
+		value := v
+		output[k] = &value
+	}
 
 	for i, v := range tagsMap {
 		//Validate should have ignored this error already
@@ -80,6 +172,16 @@ func expandTags(tagsMap map[string]interface{}) map[string]*string {
 	return output
 }
 
+func tagsMapContainsKey(tagsMap map[string]interface{}, key string) bool {
+	for k := range tagsMap {
+		if strings.EqualFold(k, key) {
+			return true
+		}
+	}
+
+	return false
+}
+
 func filterTags(tagsMap map[string]*string, tagNames ...string) map[string]*string {
 	if len(tagNames) == 0 {
 		return tagsMap
@@ -104,6 +206,7 @@ func filterTags(tagsMap map[string]*string, tagNames ...string) map[string]*stri
 	return tagsRet
 }
 
+// flattenAndSetTags sets all of the Tags returned from Azure, for use in Data Sources
 func flattenAndSetTags(d *schema.ResourceData, tagMap map[string]*string) {
 
 	// If tagsMap is nil, len(tagsMap) will be 0.
@@ -115,3 +218,78 @@ func flattenAndSetTags(d *schema.ResourceData, tagMap map[string]*string) {
 
 	d.Set("tags", output)
 }
+
+// flattenAndSetResourceTags sets the Tags returned from Azure for a resource - excluding any Default Tags, unless these
+// are also defined on the resource, to avoid showing a diff for them. Tags which are ignored in the Provider block are
+// kept, so that they can be retained when the resource is updated (see `customizeDiffRetainingIgnoredTags`).
+func flattenAndSetResourceTags(d *schema.ResourceData, tagMap map[string]*string, meta interface{}) {
+	config := tagsConfigFromMeta(meta)
+	configured := d.Get("tags").(map[string]interface{})
+
+	output := make(map[string]interface{}, len(tagMap))
+	for k, v := range tagMap {
+		if v == nil {
+			continue
+		}
+
+		if !tagsMapContainsKey(configured, k) && config.isDefault(k, *v) {
+			continue
+		}
+
+		output[k] = *v
+	}
+
+	d.Set("tags", output)
+}
+
+// customizeDiffRetainingIgnoredTags wraps the CustomizeDiff function of a resource which supports Tags, to ensure
+// that any Tags which are ignored in the Provider block are retained when the resource is updated. The ignored Tags
+// are kept in the State when the resource is read - since the Tags sent when updating a resource replace all of the
+// Tags assigned to it, any of these which aren't defined on the resource are merged into the planned Tags, which both
+// avoids showing a diff for them and ensures they're included in the Tags sent by `expandTags`.
+func customizeDiffRetainingIgnoredTags(customizeDiff schema.CustomizeDiffFunc) schema.CustomizeDiffFunc {
+	return func(diff *schema.ResourceDiff, meta interface{}) error {
+		if err := retainIgnoredTags(diff, meta); err != nil {
+			return err
+		}
+
+		if customizeDiff != nil {
+			return customizeDiff(diff, meta)
+		}
+
+		return nil
+	}
+}
+
+func retainIgnoredTags(diff *schema.ResourceDiff, meta interface{}) error {
+	config := tagsConfigFromMeta(meta)
+	if !config.hasIgnoredTags() || !diff.HasChange("tags") {
+		return nil
+	}
+
+	// where the Tags aren't known yet (e.g. they're interpolated from another resource) they're left as-is
+	old, _ := diff.GetChange("tags")
+	new, ok := diff.GetOk("tags")
+	if !ok {
+		return nil
+	}
+
+	tags := make(map[string]interface{})
+	for k, v := range new.(map[string]interface{}) {
+		tags[k] = v
+	}
+
+	retained := false
+	for k, v := range old.(map[string]interface{}) {
+		if config.isIgnored(k) && !tagsMapContainsKey(tags, k) {
+			tags[k] = v
+			retained = true
+		}
+	}
+
+	if !retained {
+		return nil
+	}
+
+	return diff.SetNew("tags", tags)
+}
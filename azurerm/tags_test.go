@@ -2,8 +2,13 @@ package azurerm
 
 import (
 	"fmt"
+	"reflect"
 	"strings"
 	"testing"
+
+	"github.com/hashicorp/terraform/config"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
 )
 
 func TestValidateMaximumNumberOfARMTags(t *testing.T) {
@@ -74,7 +79,7 @@ func TestExpandARMTags(t *testing.T) {
 	testData["key2"] = 21
 	testData["key3"] = "value3"
 
-	expanded := expandTags(testData)
+	expanded := expandTags(testData, &ArmClient{})
 
 	if len(expanded) != 3 {
 		t.Fatalf("Expected 3 results in expanded tag map, got %d", len(expanded))
@@ -95,6 +100,223 @@ func TestExpandARMTags(t *testing.T) {
 	}
 }
 
+func TestExpandARMTagsWithDefaultTags(t *testing.T) {
+	client := &ArmClient{
+		tagsConfig: tagsConfig{
+			defaultTags: map[string]string{
+				"cost-center": "1234",
+				"Owner":       "platform",
+				"env":         "dev",
+			},
+		},
+	}
+
+	testData := map[string]interface{}{
+		"env":   "prod",
+		"owner": "team-a",
+		"name":  "example",
+	}
+
+	expanded := expandTags(testData, client)
+
+	actual := make(map[string]string, len(expanded))
+	for k, v := range expanded {
+		actual[k] = *v
+	}
+
+	// the Tags defined on the resource take precedence, and Tag keys are case-insensitive
+	expected := map[string]string{
+		"cost-center": "1234",
+		"env":         "prod",
+		"owner":       "team-a",
+		"name":        "example",
+	}
+	if !reflect.DeepEqual(expected, actual) {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
+func TestFlattenAndSetResourceTags(t *testing.T) {
+	client := &ArmClient{
+		tagsConfig: tagsConfig{
+			defaultTags: map[string]string{
+				"cost-center": "1234",
+				"env":         "dev",
+				"owner":       "platform",
+			},
+			ignoreKeys:        []string{"CreatedBy"},
+			ignoreKeyPrefixes: []string{"hidden-"},
+		},
+	}
+
+	resource := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"tags": tagsSchema(),
+		},
+	}
+
+	d := schema.TestResourceDataRaw(t, resource.Schema, map[string]interface{}{
+		"tags": map[string]interface{}{
+			"name":    "example",
+			"owner":   "platform",
+			"hidden-": "configured",
+		},
+	})
+
+	values := map[string]string{
+		"name":           "example",
+		"owner":          "platform",
+		"hidden-":        "configured",
+		"cost-center":    "1234",
+		"env":            "prod",
+		"createdby":      "policy",
+		"hidden-link:id": "value",
+		"other":          "value",
+	}
+	tagMap := make(map[string]*string, len(values))
+	for k, v := range values {
+		value := v
+		tagMap[k] = &value
+	}
+
+	flattenAndSetResourceTags(d, tagMap, client)
+
+	actual := make(map[string]string)
+	for k, v := range d.Get("tags").(map[string]interface{}) {
+		actual[k] = v.(string)
+	}
+
+	// Default Tags with the Default value are excluded unless they're defined on the resource, whereas ignored Tags
+	// are kept so that they can be retained when the resource is updated
+	expected := map[string]string{
+		"name":           "example",
+		"owner":          "platform",
+		"hidden-":        "configured",
+		"env":            "prod",
+		"createdby":      "policy",
+		"hidden-link:id": "value",
+		"other":          "value",
+	}
+	if !reflect.DeepEqual(expected, actual) {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
+func TestIgnoredTagsRetainedOnUpdate(t *testing.T) {
+	cases := []struct {
+		Description  string
+		IgnoreKeys   []string
+		Configured   map[string]interface{}
+		ExpectedDiff map[string]string
+		ExpectedTags map[string]string
+	}{
+		{
+			Description: "Ignored Tags on the existing resource are retained",
+			IgnoreKeys:  []string{"CreatedOnDate"},
+			Configured: map[string]interface{}{
+				"environment": "Production",
+			},
+			ExpectedDiff: map[string]string{
+				"tags.environment": "Production",
+			},
+			ExpectedTags: map[string]string{
+				"environment":   "Production",
+				"createdondate": "2019-01-01",
+			},
+		},
+		{
+			Description: "Ignored Tags on the existing resource don't cause a diff",
+			IgnoreKeys:  []string{"CreatedOnDate"},
+			Configured: map[string]interface{}{
+				"environment": "Development",
+			},
+			ExpectedDiff: map[string]string{},
+		},
+		{
+			Description: "No ignored Tags",
+			Configured: map[string]interface{}{
+				"environment": "Production",
+			},
+			ExpectedDiff: map[string]string{
+				"tags.%":             "1",
+				"tags.environment":   "Production",
+				"tags.createdondate": "",
+			},
+			ExpectedTags: map[string]string{
+				"environment": "Production",
+			},
+		},
+	}
+
+	for _, v := range cases {
+		client := &ArmClient{
+			tagsConfig: tagsConfig{
+				ignoreKeys: v.IgnoreKeys,
+			},
+		}
+
+		var sent map[string]*string
+		resource := &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"tags": tagsSchema(),
+			},
+			CustomizeDiff: customizeDiffRetainingIgnoredTags(nil),
+			Update: func(d *schema.ResourceData, meta interface{}) error {
+				sent = expandTags(d.Get("tags").(map[string]interface{}), meta)
+				return nil
+			},
+		}
+
+		state := &terraform.InstanceState{
+			ID: "example",
+			Attributes: map[string]string{
+				"id":                 "example",
+				"tags.%":             "2",
+				"tags.environment":   "Development",
+				"tags.createdondate": "2019-01-01",
+			},
+		}
+
+		raw, err := config.NewRawConfig(map[string]interface{}{
+			"tags": v.Configured,
+		})
+		if err != nil {
+			t.Fatalf("Error building the configuration for %q: %+v", v.Description, err)
+		}
+
+		diff, err := resource.Diff(state, terraform.NewResourceConfig(raw), client)
+		if err != nil {
+			t.Fatalf("Expected no error diffing %q but got: %+v", v.Description, err)
+		}
+
+		actualDiff := make(map[string]string)
+		if diff != nil {
+			for k, attr := range diff.Attributes {
+				actualDiff[k] = attr.New
+			}
+		}
+		if !reflect.DeepEqual(v.ExpectedDiff, actualDiff) {
+			t.Fatalf("Expected the diff %+v for %q but got %+v", v.ExpectedDiff, v.Description, actualDiff)
+		}
+
+		if len(v.ExpectedDiff) == 0 {
+			continue
+		}
+
+		if _, err := resource.Apply(state, diff, client); err != nil {
+			t.Fatalf("Expected no error applying %q but got: %+v", v.Description, err)
+		}
+
+		actual := make(map[string]string)
+		for k, v := range sent {
+			actual[k] = *v
+		}
+		if !reflect.DeepEqual(v.ExpectedTags, actual) {
+			t.Fatalf("Expected the Tags %+v to be sent for %q but got %+v", v.ExpectedTags, v.Description, actual)
+		}
+	}
+}
+
 func TestFilterARMTags(t *testing.T) {
 	testData := make(map[string]*string)
 	valueData := [3]string{"value1", "value2", "value3"}
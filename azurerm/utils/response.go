@@ -17,6 +17,10 @@ func ResponseWasNotFound(resp autorest.Response) bool {
 
 func ResponseErrorIsRetryable(err error) bool {
 	if arerr, ok := err.(autorest.DetailedError); ok {
+		if statusCode, ok := arerr.StatusCode.(int); ok && ResponseStatusCodeIsRetryable(statusCode) {
+			return true
+		}
+
 		err = arerr.Original
 	}
 
@@ -30,6 +34,21 @@ func ResponseErrorIsRetryable(err error) bool {
 	return false
 }
 
+// ResponseStatusCodeIsRetryable returns whether the HTTP Status Code indicates the request was throttled (HTTP 429)
+// or failed due to a transient server-side error (HTTP 5xx) - and as such can be retried
+func ResponseStatusCodeIsRetryable(statusCode int) bool {
+	switch statusCode {
+	case http.StatusTooManyRequests,
+		http.StatusInternalServerError,
+		http.StatusBadGateway,
+		http.StatusServiceUnavailable,
+		http.StatusGatewayTimeout:
+		return true
+	}
+
+	return false
+}
+
 func responseWasStatusCode(resp autorest.Response, statusCode int) bool {
 	if r := resp.Response; r != nil {
 		if r.StatusCode == statusCode {
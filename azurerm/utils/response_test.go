@@ -64,6 +64,12 @@ func TestResponseErrorIsRetryable(t *testing.T) {
 			Original: testNetError{true, true}}, true},
 		{"Unhandled error nested in autorest.DetailedError is not retryable", autorest.DetailedError{
 			Original: fmt.Errorf("Some other error")}, false},
+		{"Throttled autorest.DetailedError is retryable", autorest.DetailedError{
+			Original: fmt.Errorf("Too Many Requests"), StatusCode: http.StatusTooManyRequests}, true},
+		{"Server-side autorest.DetailedError is retryable", autorest.DetailedError{
+			Original: fmt.Errorf("Service Unavailable"), StatusCode: http.StatusServiceUnavailable}, true},
+		{"Client-side autorest.DetailedError is not retryable", autorest.DetailedError{
+			Original: fmt.Errorf("Bad Request"), StatusCode: http.StatusBadRequest}, false},
 		{"nil is handled as non-retryable", nil, false},
 	}
 
@@ -75,3 +81,29 @@ func TestResponseErrorIsRetryable(t *testing.T) {
 		}
 	}
 }
+
+func TestResponseStatusCodeIsRetryable(t *testing.T) {
+	testCases := []struct {
+		statusCode     int
+		expectedResult bool
+	}{
+		{http.StatusOK, false},
+		{http.StatusBadRequest, false},
+		{http.StatusNotFound, false},
+		{http.StatusConflict, false},
+		{http.StatusTooManyRequests, true},
+		{http.StatusInternalServerError, true},
+		{http.StatusNotImplemented, false},
+		{http.StatusBadGateway, true},
+		{http.StatusServiceUnavailable, true},
+		{http.StatusGatewayTimeout, true},
+	}
+
+	for _, test := range testCases {
+		result := ResponseStatusCodeIsRetryable(test.statusCode)
+		if test.expectedResult != result {
+			t.Fatalf("Expected '%+v' for status code '%d' - got '%+v'",
+				test.expectedResult, test.statusCode, result)
+		}
+	}
+}
@@ -0,0 +1,195 @@
+package azurerm
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2018-06-01/compute"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/polling"
+)
+
+// The Scale-In Policy of a Virtual Machine Scale Set and the Protection Policy of its instances were introduced
+// in API Version `2019-03-01` of the Compute API, which is newer than the version of the Compute SDK we're using.
+// Vendoring the newer Compute SDK means upgrading every Compute resource (Virtual Machines, Scale Sets, Disks,
+// Images etc) to it, since the models differ between API Versions - which is a larger change than these policies
+// warrant. Since both are self-contained properties we instead send these requests directly, using the existing
+// Compute clients for authorization/retries - these can be replaced with the SDK once it's been upgraded.
+const virtualMachineScaleSetPoliciesAPIVersion = "2019-03-01"
+
+const (
+	virtualMachineScaleSetScaleInPolicyDefault  = "Default"
+	virtualMachineScaleSetScaleInPolicyNewestVM = "NewestVM"
+	virtualMachineScaleSetScaleInPolicyOldestVM = "OldestVM"
+)
+
+type azureRmVirtualMachineScaleSetScaleInPolicy struct {
+	Properties struct {
+		ScaleInPolicy *struct {
+			Rules *[]string `json:"rules,omitempty"`
+		} `json:"scaleInPolicy,omitempty"`
+	} `json:"properties"`
+}
+
+type azureRmVirtualMachineScaleSetVMProtectionPolicy struct {
+	ProtectFromScaleIn         bool `json:"protectFromScaleIn"`
+	ProtectFromScaleSetActions bool `json:"protectFromScaleSetActions"`
+}
+
+func getAzureRmVirtualMachineScaleSetScaleInPolicy(ctx context.Context, client compute.VirtualMachineScaleSetsClient, resourceGroup, name string) (string, error) {
+	pathParameters := virtualMachineScaleSetPoliciesPathParameters(client.SubscriptionID, resourceGroup, name)
+
+	var result azureRmVirtualMachineScaleSetScaleInPolicy
+	if _, err := getAzureRmVirtualMachineScaleSetPoliciesResource(ctx, client.Client, client.BaseURI, virtualMachineScaleSetPoliciesPath, pathParameters, &result); err != nil {
+		return "", err
+	}
+
+	if policy := result.Properties.ScaleInPolicy; policy != nil && policy.Rules != nil && len(*policy.Rules) > 0 {
+		return (*policy.Rules)[0], nil
+	}
+
+	return virtualMachineScaleSetScaleInPolicyDefault, nil
+}
+
+func updateAzureRmVirtualMachineScaleSetScaleInPolicy(ctx context.Context, client compute.VirtualMachineScaleSetsClient, resourceGroup, name, rule string) error {
+	pathParameters := virtualMachineScaleSetPoliciesPathParameters(client.SubscriptionID, resourceGroup, name)
+
+	body := map[string]interface{}{
+		"properties": map[string]interface{}{
+			"scaleInPolicy": map[string]interface{}{
+				"rules": []string{rule},
+			},
+		},
+	}
+
+	return sendAzureRmVirtualMachineScaleSetPoliciesUpdate(ctx, client.Client, client.BaseURI, http.MethodPatch, virtualMachineScaleSetPoliciesPath, pathParameters, body)
+}
+
+// getAzureRmVirtualMachineScaleSetVMProtectionPolicy returns the Protection Policy of the specified instance, which
+// is nil when no Protection Policy has been configured
+func getAzureRmVirtualMachineScaleSetVMProtectionPolicy(ctx context.Context, client compute.VirtualMachineScaleSetVMsClient, resourceGroup, name, instanceId string) (*azureRmVirtualMachineScaleSetVMProtectionPolicy, *http.Response, error) {
+	var result struct {
+		Properties struct {
+			ProtectionPolicy *azureRmVirtualMachineScaleSetVMProtectionPolicy `json:"protectionPolicy,omitempty"`
+		} `json:"properties"`
+	}
+
+	resp, err := getAzureRmVirtualMachineScaleSetPoliciesResource(ctx, client.Client, client.BaseURI, virtualMachineScaleSetVMPoliciesPath, virtualMachineScaleSetVMPoliciesPathParameters(client.SubscriptionID, resourceGroup, name, instanceId), &result)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return result.Properties.ProtectionPolicy, resp, nil
+}
+
+func updateAzureRmVirtualMachineScaleSetVMProtectionPolicy(ctx context.Context, client compute.VirtualMachineScaleSetVMsClient, resourceGroup, name, instanceId string, policy azureRmVirtualMachineScaleSetVMProtectionPolicy) error {
+	pathParameters := virtualMachineScaleSetVMPoliciesPathParameters(client.SubscriptionID, resourceGroup, name, instanceId)
+
+	// instances of a Scale Set can only be updated using a PUT - as such we retrieve the existing model
+	// in the newer API Version (so that nothing is lost) and only change the Protection Policy
+	instance := make(map[string]interface{})
+	if _, err := getAzureRmVirtualMachineScaleSetPoliciesResource(ctx, client.Client, client.BaseURI, virtualMachineScaleSetVMPoliciesPath, pathParameters, &instance); err != nil {
+		return err
+	}
+
+	properties, ok := instance["properties"].(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("Error: `properties` was nil for instance %q of Virtual Machine Scale Set %q (Resource Group %q)", instanceId, name, resourceGroup)
+	}
+	properties["protectionPolicy"] = policy
+
+	// the Extensions on the instance are read-only child resources
+	delete(instance, "resources")
+
+	return sendAzureRmVirtualMachineScaleSetPoliciesUpdate(ctx, client.Client, client.BaseURI, http.MethodPut, virtualMachineScaleSetVMPoliciesPath, pathParameters, instance)
+}
+
+const virtualMachineScaleSetPoliciesPath = "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/Microsoft.Compute/virtualMachineScaleSets/{vmScaleSetName}"
+
+func virtualMachineScaleSetPoliciesPathParameters(subscriptionId, resourceGroup, name string) map[string]interface{} {
+	return map[string]interface{}{
+		"resourceGroupName": autorest.Encode("path", resourceGroup),
+		"subscriptionId":    autorest.Encode("path", subscriptionId),
+		"vmScaleSetName":    autorest.Encode("path", name),
+	}
+}
+
+const virtualMachineScaleSetVMPoliciesPath = "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/Microsoft.Compute/virtualMachineScaleSets/{vmScaleSetName}/virtualMachines/{instanceId}"
+
+func virtualMachineScaleSetVMPoliciesPathParameters(subscriptionId, resourceGroup, name, instanceId string) map[string]interface{} {
+	return map[string]interface{}{
+		"instanceId":        autorest.Encode("path", instanceId),
+		"resourceGroupName": autorest.Encode("path", resourceGroup),
+		"subscriptionId":    autorest.Encode("path", subscriptionId),
+		"vmScaleSetName":    autorest.Encode("path", name),
+	}
+}
+
+func getAzureRmVirtualMachineScaleSetPoliciesResource(ctx context.Context, client autorest.Client, baseURI, path string, pathParameters map[string]interface{}, result interface{}) (*http.Response, error) {
+	req, err := autorest.Prepare((&http.Request{}).WithContext(ctx),
+		autorest.AsGet(),
+		autorest.WithBaseURL(baseURI),
+		autorest.WithPathParameters(path, pathParameters),
+		autorest.WithQueryParameters(map[string]interface{}{
+			"api-version": virtualMachineScaleSetPoliciesAPIVersion,
+		}))
+	if err != nil {
+		return nil, fmt.Errorf("Error preparing request: %+v", err)
+	}
+
+	resp, err := autorest.SendWithSender(client, req, azure.DoRetryWithRegistration(client))
+	if err != nil {
+		return resp, fmt.Errorf("Error sending request: %+v", err)
+	}
+
+	err = autorest.Respond(resp,
+		client.ByInspecting(),
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(result),
+		autorest.ByClosing())
+	return resp, err
+}
+
+func sendAzureRmVirtualMachineScaleSetPoliciesUpdate(ctx context.Context, client autorest.Client, baseURI, method, path string, pathParameters map[string]interface{}, body interface{}) error {
+	req, err := autorest.Prepare((&http.Request{}).WithContext(ctx),
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.WithMethod(method),
+		autorest.WithBaseURL(baseURI),
+		autorest.WithPathParameters(path, pathParameters),
+		autorest.WithJSON(body),
+		autorest.WithQueryParameters(map[string]interface{}{
+			"api-version": virtualMachineScaleSetPoliciesAPIVersion,
+		}))
+	if err != nil {
+		return fmt.Errorf("Error preparing request: %+v", err)
+	}
+
+	resp, err := autorest.SendWithSender(client, req, azure.DoRetryWithRegistration(client))
+	if err != nil {
+		return fmt.Errorf("Error sending request: %+v", err)
+	}
+
+	// the response body is retained for the Future (which uses it to determine the status of the operation) and closed
+	var responseBody bytes.Buffer
+	err = autorest.Respond(resp,
+		client.ByInspecting(),
+		azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusAccepted),
+		autorest.ByCopying(&responseBody),
+		autorest.ByDiscardingBody(),
+		autorest.ByClosing())
+	if err != nil {
+		return err
+	}
+	resp.Body = ioutil.NopCloser(&responseBody)
+
+	future, err := azure.NewFutureFromResponse(resp)
+	if err != nil {
+		return err
+	}
+
+	return polling.WaitForCompletion(ctx, &future, client)
+}
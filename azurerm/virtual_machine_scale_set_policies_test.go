@@ -0,0 +1,73 @@
+package azurerm
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2018-06-01/compute"
+	"github.com/Azure/go-autorest/autorest"
+)
+
+type testTrackedBody struct {
+	io.ReadCloser
+	closed bool
+}
+
+func (b *testTrackedBody) Close() error {
+	b.closed = true
+	return b.ReadCloser.Close()
+}
+
+func TestUpdateAzureRmVirtualMachineScaleSetScaleInPolicy(t *testing.T) {
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		// the update is accepted without a body, with the status of the operation available separately
+		if r.Method == http.MethodPatch {
+			if apiVersion := r.URL.Query().Get("api-version"); apiVersion != virtualMachineScaleSetPoliciesAPIVersion {
+				t.Errorf("Expected the API Version %q but got %q", virtualMachineScaleSetPoliciesAPIVersion, apiVersion)
+			}
+
+			w.Header().Set("Azure-AsyncOperation", server.URL+"/operations/1?api-version="+virtualMachineScaleSetPoliciesAPIVersion)
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusAccepted)
+			return
+		}
+
+		fmt.Fprint(w, `{"name":"1","status":"Succeeded"}`)
+	}))
+	defer server.Close()
+
+	bodies := make([]*testTrackedBody, 0)
+	client := compute.NewVirtualMachineScaleSetsClientWithBaseURI(server.URL, "00000000-0000-0000-0000-000000000000")
+	client.Sender = autorest.DecorateSender(autorest.CreateSender(), func(s autorest.Sender) autorest.Sender {
+		return autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+			resp, err := s.Do(r)
+			if err == nil {
+				body := &testTrackedBody{ReadCloser: resp.Body}
+				bodies = append(bodies, body)
+				resp.Body = body
+			}
+			return resp, err
+		})
+	})
+
+	if err := updateAzureRmVirtualMachineScaleSetScaleInPolicy(context.Background(), client, "group1", "vmss1", virtualMachineScaleSetScaleInPolicyNewestVM); err != nil {
+		t.Fatalf("Expected no error but got: %+v", err)
+	}
+
+	if len(bodies) == 0 {
+		t.Fatalf("Expected a request to be sent but none were")
+	}
+
+	for i, body := range bodies {
+		if !body.closed {
+			t.Fatalf("Expected the body of response %d to be closed", i)
+		}
+	}
+}